@@ -0,0 +1,89 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// StorageBackupArgs is arguments that StorageBackup service accepts.
+type StorageBackupArgs struct {
+	PulseNumber uint32
+}
+
+// StorageBackupReply is reply for StorageBackup service requests.
+type StorageBackupReply struct {
+	Path string
+}
+
+// StorageBackupService is a service that provides API for making storage snapshots.
+type StorageBackupService struct {
+	runner *Runner
+}
+
+// NewStorageBackupService creates new StorageBackup service instance.
+func NewStorageBackupService(runner *Runner) *StorageBackupService {
+	return &StorageBackupService{runner: runner}
+}
+
+// Make writes a consistent snapshot of storage data up to provided pulse to the node's backup directory. It's available
+// on heavy nodes only. The node keeps processing pulses while the snapshot is written.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "backup.Make",
+//     "params": {
+//       "PulseNumber": int // last pulse to include into snapshot
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Path": str // path to snapshot file on the node
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *StorageBackupService) Make(r *http.Request, args *StorageBackupArgs, reply *StorageBackupReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ StorageBackupService.Make ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Backup == nil {
+		return errors.New("[ StorageBackupService.Make ] backup is available on heavy nodes only")
+	}
+
+	path, err := s.runner.Backup.Make(ctx, insolar.PulseNumber(args.PulseNumber))
+	if err != nil {
+		return errors.Wrap(err, "[ StorageBackupService.Make ]")
+	}
+
+	reply.Path = path
+
+	return nil
+}
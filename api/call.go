@@ -36,17 +36,27 @@ import (
 
 // Request is a representation of request struct to api
 type Request struct {
-	Reference string  `json:"reference"`
-	Method    string  `json:"method"`
-	Params    []byte  `json:"params"`
-	Seed      []byte  `json:"seed"`
-	Signature []byte  `json:"signature"`
-	LogLevel  *string `json:"logLevel,omitempty"`
+	Reference  string   `json:"reference"`
+	Method     string   `json:"method"`
+	Params     []byte   `json:"params"`
+	Seed       []byte   `json:"seed"`
+	Signature  []byte   `json:"signature"`
+	Signatures [][]byte `json:"signatures,omitempty"`
+	LogLevel   *string  `json:"logLevel,omitempty"`
+	DryRun     bool     `json:"dryRun,omitempty"`
+}
+
+// StateDiff is a change of object memory made by dry-run call
+type StateDiff struct {
+	Reference string `json:"reference"`
+	Before    []byte `json:"before,omitempty"`
+	After     []byte `json:"after,omitempty"`
 }
 
 type answer struct {
 	Error   string      `json:"error,omitempty"`
 	Result  interface{} `json:"result,omitempty"`
+	Diff    []StateDiff `json:"diff,omitempty"`
 	TraceID string      `json:"traceID,omitempty"`
 }
 
@@ -80,37 +90,56 @@ func (ar *Runner) checkSeed(paramsSeed []byte) error {
 	return nil
 }
 
-func (ar *Runner) makeCall(ctx context.Context, params Request) (interface{}, error) {
+func (ar *Runner) makeCall(ctx context.Context, params Request) (interface{}, []StateDiff, error) {
 	ctx, span := instracer.StartSpan(ctx, "SendRequest "+params.Method)
 	defer span.End()
 
 	reference, err := insolar.NewReferenceFromBase58(params.Reference)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ makeCall ] failed to parse params.Reference")
+		return nil, nil, errors.Wrap(err, "[ makeCall ] failed to parse params.Reference")
 	}
 
-	res, err := ar.ContractRequester.SendRequest(
-		ctx,
-		reference,
-		"Call",
-		[]interface{}{*ar.CertificateManager.GetCertificate().GetRootDomainReference(), params.Method, params.Params, params.Seed, params.Signature},
-	)
+	signature := params.Signature
+	if len(params.Signatures) > 0 {
+		signature, err = insolar.Serialize(params.Signatures)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "[ makeCall ] failed to serialize params.Signatures")
+		}
+	}
 
-	if err != nil {
-		return nil, errors.Wrap(err, "[ makeCall ] Can't send request")
+	args := []interface{}{*ar.CertificateManager.GetCertificate().GetRootDomainReference(), params.Method, params.Params, params.Seed, signature}
+
+	var data []byte
+	var diff []StateDiff
+	if params.DryRun {
+		res, err := ar.ContractRequester.DryRun(ctx, reference, "Call", args)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "[ makeCall ] Can't make dry-run call")
+		}
+		dr := res.(*reply.DryRun)
+		data = dr.Result
+		for _, d := range dr.Diff {
+			diff = append(diff, StateDiff{Reference: d.Object.String(), Before: d.Before, After: d.After})
+		}
+	} else {
+		res, err := ar.ContractRequester.SendRequest(ctx, reference, "Call", args)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "[ makeCall ] Can't send request")
+		}
+		data = res.(*reply.CallMethod).Result
 	}
 
-	result, contractErr, err := extractor.CallResponse(res.(*reply.CallMethod).Result)
+	result, contractErr, err := extractor.CallResponse(data)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "[ makeCall ] Can't extract response")
+		return nil, nil, errors.Wrap(err, "[ makeCall ] Can't extract response")
 	}
 
 	if contractErr != nil {
-		return nil, errors.Wrap(errors.New(contractErr.S), "[ makeCall ] Error in called method")
+		return nil, nil, errors.Wrap(errors.New(contractErr.S), "[ makeCall ] Error in called method")
 	}
 
-	return result, nil
+	return result, diff, nil
 }
 
 func processError(err error, extraMsg string, resp *answer, insLog insolar.Logger) {
@@ -176,9 +205,10 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 		}
 
 		var result interface{}
+		var diff []StateDiff
 		ch := make(chan interface{}, 1)
 		go func() {
-			result, err = ar.makeCall(ctx, params)
+			result, diff, err = ar.makeCall(ctx, params)
 			ch <- nil
 		}()
 		select {
@@ -189,6 +219,7 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 				return
 			}
 			resp.Result = result
+			resp.Diff = diff
 
 		case <-time.After(time.Duration(ar.cfg.Timeout) * time.Second):
 			resp.Error = "Messagebus timeout exceeded"
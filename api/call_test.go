@@ -48,6 +48,7 @@ type TimeoutSuite struct {
 type APIresp struct {
 	Result string
 	Error  string
+	Diff   []StateDiff
 }
 
 func (suite *TimeoutSuite) TestRunner_callHandler() {
@@ -93,6 +94,28 @@ func (suite *TimeoutSuite) TestRunner_callHandlerTimeout() {
 	suite.Equal("", result.Result)
 }
 
+func (suite *TimeoutSuite) TestRunner_callHandlerDryRun() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	resp, err := requester.SendWithSeed(
+		suite.ctx,
+		CallUrl,
+		suite.user,
+		&requester.RequestConfigJSON{DryRun: true},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIresp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("", result.Error)
+	suite.Equal("DryRun", result.Result)
+	suite.Equal([]StateDiff{{Reference: suite.user.Caller, Before: []byte{1}, After: []byte{2}}}, result.Diff)
+}
+
 func TestTimeoutSuite(t *testing.T) {
 	timeoutSuite := new(TimeoutSuite)
 	timeoutSuite.ctx, _ = inslogger.WithTraceField(context.Background(), "APItests")
@@ -150,6 +173,16 @@ func TestTimeoutSuite(t *testing.T) {
 		}
 	}
 
+	cr.DryRunFunc = func(p context.Context, ref *insolar.Reference, method string, p3 []interface{}) (insolar.Reply, error) {
+		var result = "DryRun"
+		var contractErr *foundation.Error
+		data, _ := insolar.MarshalArgs(result, contractErr)
+		return &reply.DryRun{
+			Result: data,
+			Diff:   []insolar.StateDiff{{Object: *ref, Before: []byte{1}, After: []byte{2}}},
+		}, nil
+	}
+
 	timeoutSuite.api.ContractRequester = cr
 	timeoutSuite.api.CertificateManager = cm
 	timeoutSuite.api.Start(timeoutSuite.ctx)
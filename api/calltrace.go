@@ -0,0 +1,201 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/calltrace"
+)
+
+// CallTraceArgs is arguments that CallTrace.Tree accepts.
+type CallTraceArgs struct {
+	Request string
+	Object  string
+	TraceID string
+}
+
+// CallTraceOutgoing is a call made by contract.
+type CallTraceOutgoing struct {
+	Object string
+	Method string
+	Error  string
+}
+
+// CallTraceNode is a call with calls made from it in CallTrace.Tree reply.
+type CallTraceNode struct {
+	Request       string
+	ParentRequest string
+	TraceID       string
+	Caller        string
+	Object        string
+	Method        string
+	Start         int64
+	Duration      int64
+	Error         string
+	Outgoing      []CallTraceOutgoing
+	Children      []CallTraceNode
+}
+
+// CallTraceReply is reply that CallTrace.Tree returns.
+type CallTraceReply struct {
+	Trees []CallTraceNode
+}
+
+// CallTraceService is a service that provides trees of contract calls.
+type CallTraceService struct {
+	runner *Runner
+}
+
+// NewCallTraceService creates new CallTrace service instance.
+func NewCallTraceService(runner *Runner) *CallTraceService {
+	return &CallTraceService{runner: runner}
+}
+
+// Tree returns tree of calls started from request or trees of calls made with trace ID. Node keeps limited number
+// of recent calls it executed. Calls executed by other nodes are found in ledger as requests of outgoing call objects
+// by their ParentRequest, their subtrees are asked from the nodes executed them. If executor doesn't keep the call
+// anymore, the call is built from its request record: it has no trace ID, start, duration, error and children.
+// Trace ID trees start from calls executed by the node, request of other node is found if its object is provided.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "calltrace.Tree",
+//     "params": {
+//       "Request": str, // reference of root request
+//       "Object": str, // optional, object of root request, it's used to find request the node didn't execute
+//       "TraceID": str // trace ID, it's used if request is empty, otherwise it must match trace ID of the request
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Trees": [
+// 				{
+// 					"Request": str,
+// 					"ParentRequest": str, // empty for calls from outside
+// 					"TraceID": str,
+// 					"Caller": str,
+// 					"Object": str,
+// 					"Method": str,
+// 					"Start": int, // unix time in nanoseconds, zero if unknown
+// 					"Duration": int, // nanoseconds, zero if call is not finished yet
+// 					"Error": str,
+// 					"Outgoing": [
+// 						{
+// 							"Object": str,
+// 							"Method": str,
+// 							"Error": str
+// 						}
+// 					],
+// 					"Children": [...] // calls made from the call with the same structure
+// 				}
+// 			]
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *CallTraceService) Tree(r *http.Request, args *CallTraceArgs, reply *CallTraceReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ CallTraceService.Tree ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.CallTrees == nil {
+		return errors.New("[ CallTraceService.Tree ] calls are traced on virtual nodes only")
+	}
+
+	if len(args.Request) == 0 && len(args.TraceID) == 0 {
+		return errors.New("params.Request or params.TraceID is missing")
+	}
+
+	if len(args.Request) == 0 {
+		trees, err := s.runner.CallTrees.TraceTrees(ctx, args.TraceID)
+		if err != nil {
+			return errors.Wrap(err, "[ CallTraceService.Tree ] failed to collect trees")
+		}
+		for _, tree := range trees {
+			reply.Trees = append(reply.Trees, callTraceNode(tree))
+		}
+		return nil
+	}
+
+	request, err := insolar.NewReferenceFromBase58(args.Request)
+	if err != nil {
+		return errors.Wrap(err, "[ CallTraceService.Tree ] failed to parse request")
+	}
+	var obj *insolar.Reference
+	if len(args.Object) != 0 {
+		obj, err = insolar.NewReferenceFromBase58(args.Object)
+		if err != nil {
+			return errors.Wrap(err, "[ CallTraceService.Tree ] failed to parse object")
+		}
+	}
+	tree, ok, err := s.runner.CallTrees.Tree(ctx, *request, obj)
+	if err != nil {
+		return errors.Wrap(err, "[ CallTraceService.Tree ] failed to collect tree")
+	}
+	if !ok {
+		return errors.Errorf("[ CallTraceService.Tree ] request %s is not found", args.Request)
+	}
+	if len(args.TraceID) != 0 && tree.TraceID != args.TraceID {
+		return errors.Errorf("[ CallTraceService.Tree ] request %s has another trace ID", args.Request)
+	}
+	reply.Trees = []CallTraceNode{callTraceNode(tree)}
+
+	return nil
+}
+
+func callTraceNode(tree *calltrace.Tree) CallTraceNode {
+	node := CallTraceNode{
+		Request: tree.Request.String(),
+		TraceID: tree.TraceID,
+		Caller:  tree.Caller.String(),
+		Object:  tree.Object.String(),
+		Method:  tree.Method,
+		Error:   tree.Error,
+	}
+	if !tree.Start.IsZero() {
+		node.Start = tree.Start.UnixNano()
+	}
+	if tree.ParentRequest != nil {
+		node.ParentRequest = tree.ParentRequest.String()
+	}
+	if !tree.Finish.IsZero() {
+		node.Duration = int64(tree.Finish.Sub(tree.Start))
+	}
+	for _, o := range tree.Outgoing {
+		node.Outgoing = append(node.Outgoing, CallTraceOutgoing{
+			Object: o.Object.String(),
+			Method: o.Method,
+			Error:  o.Error,
+		})
+	}
+	for _, child := range tree.Children {
+		node.Children = append(node.Children, callTraceNode(child))
+	}
+	return node
+}
@@ -20,6 +20,8 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
 
 	"github.com/insolar/insolar/application/extractor"
@@ -34,6 +36,7 @@ import (
 	"github.com/insolar/insolar/insolar/utils"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/goplugin/goplugintestutils"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 )
 
@@ -51,6 +54,8 @@ func NewContractService(runner *Runner) *ContractService {
 type UploadArgs struct {
 	Code string
 	Name string
+	// MachineType is "go" (default) for sources of Go contract or "wasm" for base64 encoded WebAssembly module
+	MachineType string
 }
 
 // UploadReply is reply that Contract.Upload returns
@@ -60,7 +65,7 @@ type UploadReply struct {
 
 // Upload builds code and return prototype ref
 func (s *ContractService) Upload(r *http.Request, args *UploadArgs, reply *UploadReply) error {
-	_, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
 
 	inslog.Infof("[ ContractService.Upload ] Incoming request: %s", r.RequestURI)
 
@@ -72,6 +77,23 @@ func (s *ContractService) Upload(r *http.Request, args *UploadArgs, reply *Uploa
 		return errors.New("params.code is missing")
 	}
 
+	switch args.MachineType {
+	case "", "go":
+	case "wasm":
+		code, err := base64.StdEncoding.DecodeString(args.Code)
+		if err != nil {
+			return errors.Wrap(err, "can't decode code of wasm module")
+		}
+		protoRef, err := s.deployCode(ctx, code, insolar.MachineTypeWASM)
+		if err != nil {
+			return errors.Wrap(err, "can't deploy contract")
+		}
+		reply.PrototypeRef = *protoRef
+		return nil
+	default:
+		return errors.Errorf("unknown machine type %q", args.MachineType)
+	}
+
 	insgocc, err := goplugintestutils.BuildPreprocessor()
 	if err != nil {
 		return errors.Wrap(err, "can't build preprocessor")
@@ -90,6 +112,208 @@ func (s *ContractService) Upload(r *http.Request, args *UploadArgs, reply *Uploa
 	return nil
 }
 
+// saveCode saves code on ledger
+func (s *ContractService) saveCode(ctx context.Context, code []byte, machineType insolar.MachineType) (*insolar.Reference, error) {
+	am := s.runner.ArtifactManager
+
+	nonce := testutils.RandomRef()
+	codeReq, err := am.RegisterRequest(ctx, record.Request{CallType: record.CTSaveAsChild, Prototype: &nonce})
+	if err != nil {
+		return nil, errors.Wrap(err, "can't register request of code")
+	}
+	codeID, err := am.DeployCode(ctx, insolar.Reference{}, *insolar.NewReference(insolar.ID{}, *codeReq), code, machineType)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't deploy code")
+	}
+	codeRef := insolar.Reference{}
+	codeRef.SetRecord(*codeID)
+	return &codeRef, nil
+}
+
+// deployCode saves code on ledger and activates prototype of the contract
+func (s *ContractService) deployCode(ctx context.Context, code []byte, machineType insolar.MachineType) (*insolar.Reference, error) {
+	am := s.runner.ArtifactManager
+
+	codeRef, err := s.saveCode(ctx, code, machineType)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := testutils.RandomRef()
+	protoID, err := am.RegisterRequest(ctx, record.Request{CallType: record.CTSaveAsChild, Prototype: &nonce})
+	if err != nil {
+		return nil, errors.Wrap(err, "can't register request of prototype")
+	}
+	protoRef := insolar.Reference{}
+	protoRef.SetRecord(*protoID)
+
+	_, err = am.ActivatePrototype(ctx, insolar.Reference{}, protoRef, insolar.GenesisRecord.Ref(), *codeRef, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't activate prototype")
+	}
+	return &protoRef, nil
+}
+
+// UpgradeArgs is arguments that Contract.Upgrade accepts. Upgrade is signed by the owner of the prototype or
+// by the root member like a member call of "Upgrade" method with params returned by UpgradeParams.
+type UpgradeArgs struct {
+	PrototypeRefString string
+	Code               string
+	// Name is the name contract was uploaded with
+	Name string
+	// MachineType is "go" (default) for sources of Go contract or "wasm" for base64 encoded WebAssembly module
+	MachineType string
+
+	// Reference is the member signing the upgrade
+	Reference string
+	Seed      []byte
+	Signature []byte
+}
+
+// UpgradeParams returns params of the upgrade covered by signature
+func UpgradeParams(args *UpgradeArgs) ([]byte, error) {
+	return json.Marshal([]string{args.PrototypeRefString, args.Name, args.MachineType, args.Code})
+}
+
+// UpgradeReply is reply that Contract.Upgrade returns
+type UpgradeReply struct {
+	CodeRef insolar.Reference `json:"CodeRef"`
+}
+
+// Upgrade builds new code of the contract and registers it for existing prototype
+func (s *ContractService) Upgrade(r *http.Request, args *UpgradeArgs, reply *UpgradeReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ContractService.Upgrade ] Incoming request: %s", r.RequestURI)
+
+	if len(args.PrototypeRefString) == 0 {
+		return errors.New("params.PrototypeRefString is missing")
+	}
+
+	if len(args.Code) == 0 {
+		return errors.New("params.code is missing")
+	}
+
+	protoRef, err := insolar.NewReferenceFromBase58(args.PrototypeRefString)
+	if err != nil {
+		return errors.Wrap(err, "can't get protoRef")
+	}
+
+	err = s.checkUpgradeSigner(ctx, args, *protoRef)
+	if err != nil {
+		return errors.Wrap(err, "upgrade isn't authorized")
+	}
+
+	switch args.MachineType {
+	case "", "go":
+	case "wasm":
+		code, err := base64.StdEncoding.DecodeString(args.Code)
+		if err != nil {
+			return errors.Wrap(err, "can't decode code of wasm module")
+		}
+		codeRef, err := s.upgradeCode(ctx, *protoRef, code, insolar.MachineTypeWASM)
+		if err != nil {
+			return errors.Wrap(err, "can't upgrade contract")
+		}
+		reply.CodeRef = *codeRef
+		return nil
+	default:
+		return errors.Errorf("unknown machine type %q", args.MachineType)
+	}
+
+	if len(args.Name) == 0 {
+		return errors.New("params.name is missing")
+	}
+
+	insgocc, err := goplugintestutils.BuildPreprocessor()
+	if err != nil {
+		return errors.Wrap(err, "can't build preprocessor")
+	}
+	cb := goplugintestutils.NewContractBuilder(s.runner.ArtifactManager, insgocc)
+	cb.Prototypes[args.Name] = protoRef
+
+	err = cb.Upgrade(args.Name, args.Code)
+	if err != nil {
+		return errors.Wrap(err, "can't upgrade contract")
+	}
+
+	reply.CodeRef = *cb.Codes[args.Name]
+	return nil
+}
+
+// checkUpgradeSigner verifies signature of the upgrade, it must be signed by the owner (parent) of the prototype
+// or by the root member
+func (s *ContractService) checkUpgradeSigner(ctx context.Context, args *UpgradeArgs, protoRef insolar.Reference) error {
+	err := s.runner.checkSeed(args.Seed)
+	if err != nil {
+		return err
+	}
+
+	memberRef, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "can't parse params.Reference")
+	}
+	params, err := UpgradeParams(args)
+	if err != nil {
+		return errors.Wrap(err, "can't marshal params of upgrade")
+	}
+	data, err := insolar.MarshalArgs(*memberRef, "Upgrade", params, args.Seed)
+	if err != nil {
+		return errors.Wrap(err, "can't marshal signed data")
+	}
+	publicKey, err := s.runner.getMemberPubKey(ctx, args.Reference)
+	if err != nil {
+		return err
+	}
+	verifier := platformpolicy.NewPlatformCryptographyScheme().Verifier(publicKey)
+	if !verifier.Verify(insolar.SignatureFromBytes(args.Signature), data) {
+		return errors.New("incorrect signature")
+	}
+
+	rootMember, err := s.runner.GenesisDataProvider.GetRootMember(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can't get root member")
+	}
+	if rootMember.Equal(*memberRef) {
+		return nil
+	}
+	protoDesc, err := s.runner.ArtifactManager.GetObject(ctx, protoRef)
+	if err != nil {
+		return errors.Wrap(err, "can't get prototype")
+	}
+	if parent := protoDesc.Parent(); parent != nil && parent.Equal(*memberRef) {
+		return nil
+	}
+	return errors.New("only owner of the prototype or root member can upgrade it")
+}
+
+// upgradeCode saves code on ledger and sets it as code of the prototype
+func (s *ContractService) upgradeCode(ctx context.Context, protoRef insolar.Reference, code []byte, machineType insolar.MachineType) (*insolar.Reference, error) {
+	am := s.runner.ArtifactManager
+
+	codeRef, err := s.saveCode(ctx, code, machineType)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDesc, err := am.GetObject(ctx, protoRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get prototype")
+	}
+
+	nonce := testutils.RandomRef()
+	reqID, err := am.RegisterRequest(ctx, record.Request{CallType: record.CTSaveAsChild, Prototype: &nonce})
+	if err != nil {
+		return nil, errors.Wrap(err, "can't register request of prototype update")
+	}
+
+	_, err = am.UpdatePrototype(ctx, insolar.Reference{}, *insolar.NewReference(insolar.ID{}, *reqID), protoDesc, protoDesc.Memory(), codeRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't update prototype")
+	}
+	return codeRef, nil
+}
+
 // CallConstructorArgs is arguments that Contract.CallConstructor accepts.
 type CallConstructorArgs struct {
 	PrototypeRefString string
@@ -0,0 +1,100 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+)
+
+// StorageExporterArgs is arguments that StorageExporter service accepts.
+type StorageExporterArgs struct {
+	PulseNumber uint32
+	Size        int
+}
+
+// StorageExporterReply is reply for StorageExporter service requests.
+type StorageExporterReply = exporter.Result
+
+// StorageExporterService is a service that provides API for exporting storage data.
+type StorageExporterService struct {
+	runner *Runner
+}
+
+// NewStorageExporterService creates new StorageExporter service instance.
+func NewStorageExporterService(runner *Runner) *StorageExporterService {
+	return &StorageExporterService{runner: runner}
+}
+
+// Export returns data view from storage. It's available on heavy nodes only.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.Export",
+//     "params": {
+//       "PulseNumber": int, // pulse to start export from
+//       "Size": int // max number of pulses in reply
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Pulses": [ // exported pulses in pulse order
+// 				{
+// 					"PulseNumber": int,
+// 					"PulseTimestamp": int,
+// 					"Jets": [ str ],
+// 					"Records": [ { "ID": str, "JetID": str, "Type": str, "Payload": { ... } } ],
+// 					"Blobs": [ { "ID": str, "JetID": str, "Value": str } ],
+// 					"Indexes": [ { "ObjID": str, "Lifeline": { ... } } ]
+// 				}
+// 			],
+// 			"NextFrom": int|null, // pulse to request the next page from
+// 			"Size": int // number of exported pulses
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *StorageExporterService) Export(r *http.Request, args *StorageExporterArgs, reply *StorageExporterReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ StorageExporterService.Export ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Exporter == nil {
+		return errors.New("[ StorageExporterService.Export ] exporter is available on heavy nodes only")
+	}
+
+	result, err := s.runner.Exporter.Export(ctx, insolar.PulseNumber(args.PulseNumber), args.Size)
+	if err != nil {
+		return errors.Wrap(err, "[ StorageExporterService.Export ]")
+	}
+
+	*reply = *result
+
+	return nil
+}
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"net"
 	"net/http"
-	"sync"
 	"time"
 
 	"github.com/insolar/insolar/network"
@@ -39,7 +38,12 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/backup"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/calltrace"
+	"github.com/insolar/insolar/logicrunner/goplugin"
 	"github.com/insolar/insolar/platformpolicy"
 )
 
@@ -53,11 +57,14 @@ type Runner struct {
 	ServiceNetwork      insolar.Network             `inject:""`
 	PulseAccessor       pulse.Accessor              `inject:""`
 	ArtifactManager     artifacts.Client            `inject:""`
+	Exporter            exporter.Exporter
+	Backup              backup.Maker
+	TypeIndex           object.TypeIndexAccessor
+	ContractWorkers     *goplugin.Pool
+	CallTrees           *calltrace.Collector
 	server              *http.Server
 	rpcServer           *rpc.Server
 	cfg                 *configuration.APIRunner
-	keyCache            map[string]crypto.PublicKey
-	cacheLock           *sync.RWMutex
 	SeedManager         *seedmanager.SeedManager
 	SeedGenerator       seedmanager.SeedGenerator
 }
@@ -108,6 +115,31 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: contract")
 	}
 
+	err = rpcServer.RegisterService(NewStorageExporterService(ar), "exporter")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: exporter")
+	}
+
+	err = rpcServer.RegisterService(NewStorageBackupService(ar), "backup")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: backup")
+	}
+
+	err = rpcServer.RegisterService(NewObjectService(ar), "object")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: object")
+	}
+
+	err = rpcServer.RegisterService(NewRecordsService(ar), "records")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: records")
+	}
+
+	err = rpcServer.RegisterService(NewCallTraceService(ar), "calltrace")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: calltrace")
+	}
+
 	return nil
 }
 
@@ -124,8 +156,6 @@ func NewRunner(cfg *configuration.APIRunner) (*Runner, error) {
 		server:    &http.Server{Addr: addrStr},
 		rpcServer: rpcServer,
 		cfg:       cfg,
-		keyCache:  make(map[string]crypto.PublicKey),
-		cacheLock: &sync.RWMutex{},
 	}
 
 	rpcServer.RegisterCodec(jsonrpc.NewCodec(), "application/json")
@@ -179,14 +209,8 @@ func (ar *Runner) Stop(ctx context.Context) error {
 	return nil
 }
 
+// getMemberPubKey returns current public key of the member, it isn't cached as members can rotate their keys
 func (ar *Runner) getMemberPubKey(ctx context.Context, ref string) (crypto.PublicKey, error) { //nolint
-	ar.cacheLock.RLock()
-	publicKey, ok := ar.keyCache[ref]
-	ar.cacheLock.RUnlock()
-	if ok {
-		return publicKey, nil
-	}
-
 	reference, err := insolar.NewReferenceFromBase58(ref)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ getMemberPubKey ] Can't parse ref")
@@ -202,13 +226,9 @@ func (ar *Runner) getMemberPubKey(ctx context.Context, ref string) (crypto.Publi
 	}
 
 	kp := platformpolicy.NewKeyProcessor()
-	publicKey, err = kp.ImportPublicKeyPEM([]byte(publicKeyString))
+	publicKey, err := kp.ImportPublicKeyPEM([]byte(publicKeyString))
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert public key")
 	}
-
-	ar.cacheLock.Lock()
-	ar.keyCache[ref] = publicKey
-	ar.cacheLock.Unlock()
 	return publicKey, nil
 }
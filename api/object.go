@@ -0,0 +1,586 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+const defaultObjectHistoryAmount = 100
+
+// ObjectHistoryArgs is arguments that Object.History accepts.
+type ObjectHistoryArgs struct {
+	Reference string
+	FromState string
+	Amount    int
+}
+
+// ObjectHistoryState is a single object state in Object.History reply.
+type ObjectHistoryState struct {
+	ID          string
+	PulseNumber uint32
+	Type        string
+	Prototype   string
+	Memory      []byte
+}
+
+// ObjectHistoryReply is reply that Object.History returns.
+type ObjectHistoryReply struct {
+	States   []ObjectHistoryState
+	NextFrom string
+}
+
+// ObjectService is a service that provides API for reading objects from ledger.
+type ObjectService struct {
+	runner *Runner
+}
+
+// NewObjectService creates new Object service instance.
+func NewObjectService(runner *Runner) *ObjectService {
+	return &ObjectService{runner: runner}
+}
+
+// History returns object states ordered from newer to older with pulse numbers they were registered in.
+// Superseded states removed by retention on heavy nodes are not returned.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "object.History",
+//     "params": {
+//       "Reference": str, // object reference
+//       "FromState": str, // state to start from, latest state if empty
+//       "Amount": int // max number of states to return
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"States": [
+// 				{
+// 					"ID": str,
+// 					"PulseNumber": int,
+// 					"Type": str, // "activate", "amend" or "deactivate"
+// 					"Prototype": str,
+// 					"Memory": str // base64 encoded object memory
+// 				}
+// 			],
+// 			"NextFrom": str // state to request the next page from, empty if there are no more states
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *ObjectService) History(r *http.Request, args *ObjectHistoryArgs, reply *ObjectHistoryReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ObjectService.History ] Incoming request: %s", r.RequestURI)
+
+	if len(args.Reference) == 0 {
+		return errors.New("params.Reference is missing")
+	}
+	head, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.History ] failed to parse reference")
+	}
+
+	var from *insolar.ID
+	if len(args.FromState) != 0 {
+		from, err = insolar.NewIDFromBase58(args.FromState)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.History ] failed to parse state")
+		}
+	}
+
+	amount := args.Amount
+	if amount <= 0 {
+		amount = defaultObjectHistoryAmount
+	}
+
+	states, next, err := s.runner.ArtifactManager.GetObjectHistory(ctx, *head, from, amount)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.History ]")
+	}
+
+	reply.States = make([]ObjectHistoryState, 0, len(states))
+	for _, st := range states {
+		state := ObjectHistoryState{
+			ID:          st.ID.String(),
+			PulseNumber: uint32(st.ID.Pulse()),
+			Type:        stateTypeName(st.State),
+			Memory:      st.Memory,
+		}
+		if st.Prototype != nil {
+			state.Prototype = st.Prototype.String()
+		}
+		reply.States = append(reply.States, state)
+	}
+	if next != nil {
+		reply.NextFrom = next.String()
+	}
+
+	return nil
+}
+
+func stateTypeName(id record.StateID) string {
+	switch id {
+	case record.StateActivation:
+		return "activate"
+	case record.StateAmend:
+		return "amend"
+	case record.StateDeactivation:
+		return "deactivate"
+	default:
+		return "undefined"
+	}
+}
+
+// ObjectRequestsArgs is arguments that Object.Requests accepts.
+type ObjectRequestsArgs struct {
+	Reference   string
+	FromRequest string
+	Amount      int
+}
+
+// ObjectRequest is a single request with its result in Object.Requests reply.
+type ObjectRequest struct {
+	ID            string
+	PulseNumber   uint32
+	Method        string
+	Caller        string
+	ParentRequest string
+	Arguments     []byte
+	ResultID      string
+	Result        []byte
+}
+
+// ObjectRequestsReply is reply that Object.Requests returns.
+type ObjectRequestsReply struct {
+	Requests []ObjectRequest
+	NextFrom string
+}
+
+// Requests returns requests to object ordered from newer to older with their results, requests of the same pulse are
+// ordered by their ids. Requests registered on light nodes are returned along with the ones replicated to heavy node.
+// Requests made by contracts are linked to requests of calls made them, so the whole tree of calls can be built from
+// the ledger.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "object.Requests",
+//     "params": {
+//       "Reference": str, // object reference
+//       "FromRequest": str, // request to start from, latest request if empty
+//       "Amount": int // max number of requests to return
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Requests": [
+// 				{
+// 					"ID": str,
+// 					"PulseNumber": int,
+// 					"Method": str, // empty for constructors
+// 					"Caller": str,
+// 					"ParentRequest": str, // request made the call, empty for calls from outside
+// 					"Arguments": str, // base64 encoded arguments
+// 					"ResultID": str, // empty if request is not processed yet
+// 					"Result": str // base64 encoded result payload
+// 				}
+// 			],
+// 			"NextFrom": str // request to request the next page from, empty if there are no more requests
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *ObjectService) Requests(r *http.Request, args *ObjectRequestsArgs, reply *ObjectRequestsReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ObjectService.Requests ] Incoming request: %s", r.RequestURI)
+
+	if len(args.Reference) == 0 {
+		return errors.New("params.Reference is missing")
+	}
+	obj, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.Requests ] failed to parse reference")
+	}
+
+	var from *insolar.ID
+	if len(args.FromRequest) != 0 {
+		from, err = insolar.NewIDFromBase58(args.FromRequest)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.Requests ] failed to parse request")
+		}
+	}
+
+	amount := args.Amount
+	if amount <= 0 {
+		amount = defaultObjectHistoryAmount
+	}
+
+	requests, next, err := s.runner.ArtifactManager.GetObjectRequests(ctx, *obj, from, amount)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.Requests ]")
+	}
+
+	reply.Requests = make([]ObjectRequest, 0, len(requests))
+	for _, rr := range requests {
+		req := ObjectRequest{
+			ID:          rr.RequestID.String(),
+			PulseNumber: uint32(rr.RequestID.Pulse()),
+			Method:      rr.Request.Method,
+			Caller:      rr.Request.Caller.String(),
+			Arguments:   rr.Request.Arguments,
+		}
+		if rr.Request.ParentRequest != nil {
+			req.ParentRequest = rr.Request.ParentRequest.String()
+		}
+		if rr.ResultID != nil {
+			req.ResultID = rr.ResultID.String()
+			req.Result = rr.Result.Payload
+		}
+		reply.Requests = append(reply.Requests, req)
+	}
+	if next != nil {
+		reply.NextFrom = next.String()
+	}
+
+	return nil
+}
+
+const (
+	defaultWatchEventsTimeout = 30 * time.Second
+	maxWatchEventsTimeout     = 60 * time.Second
+	watchEventsPollInterval   = time.Second
+)
+
+// ObjectEventsArgs is arguments that Object.Events accepts.
+type ObjectEventsArgs struct {
+	Reference   string
+	Method      string
+	FromPulse   uint32
+	ToPulse     uint32
+	FromRequest string
+	Amount      int
+}
+
+// ObjectEvent is a single event in Object.Events and Object.WatchEvents replies.
+type ObjectEvent struct {
+	RequestID   string
+	PulseNumber uint32
+	ResultID    string
+	Method      string
+	Name        string
+	Payload     []byte
+}
+
+// ObjectEventsReply is reply that Object.Events returns.
+type ObjectEventsReply struct {
+	Events   []ObjectEvent
+	NextFrom string
+}
+
+// Events returns events emitted by object ordered from newer to older requests. Events become available
+// after their pulse is replicated to heavy node.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "object.Events",
+//     "params": {
+//       "Reference": str, // object reference
+//       "Method": str, // only events of the method calls, all events if empty
+//       "FromPulse": int, // only events of requests from this pulse and newer, no bound if zero
+//       "ToPulse": int, // only events of requests from this pulse and older, no bound if zero
+//       "FromRequest": str, // request to start from, latest request if empty
+//       "Amount": int // max number of events to return, events of a request are never split between pages
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Events": [
+// 				{
+// 					"RequestID": str,
+// 					"PulseNumber": int, // pulse of the request
+// 					"ResultID": str,
+// 					"Method": str,
+// 					"Name": str,
+// 					"Payload": str // base64 encoded payload
+// 				}
+// 			],
+// 			"NextFrom": str // request to request the next page from, empty if there are no more events
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *ObjectService) Events(r *http.Request, args *ObjectEventsArgs, reply *ObjectEventsReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ObjectService.Events ] Incoming request: %s", r.RequestURI)
+
+	if len(args.Reference) == 0 {
+		return errors.New("params.Reference is missing")
+	}
+	obj, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.Events ] failed to parse reference")
+	}
+
+	var from *insolar.ID
+	if len(args.FromRequest) != 0 {
+		from, err = insolar.NewIDFromBase58(args.FromRequest)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.Events ] failed to parse request")
+		}
+	}
+
+	amount := args.Amount
+	if amount <= 0 {
+		amount = defaultObjectHistoryAmount
+	}
+
+	filter := artifacts.EventsFilter{
+		Method:    args.Method,
+		FromPulse: insolar.PulseNumber(args.FromPulse),
+		ToPulse:   insolar.PulseNumber(args.ToPulse),
+	}
+	events, next, err := s.runner.ArtifactManager.GetObjectEvents(ctx, *obj, filter, from, amount)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.Events ]")
+	}
+
+	reply.Events = make([]ObjectEvent, 0, len(events))
+	for _, e := range events {
+		reply.Events = append(reply.Events, newObjectEvent(e))
+	}
+	if next != nil {
+		reply.NextFrom = next.String()
+	}
+
+	return nil
+}
+
+// ObjectWatchEventsArgs is arguments that Object.WatchEvents accepts.
+type ObjectWatchEventsArgs struct {
+	Reference  string
+	Method     string
+	AfterPulse uint32
+	Delivered  []string
+	Timeout    int
+}
+
+// ObjectWatchEventsReply is reply that Object.WatchEvents returns.
+type ObjectWatchEventsReply struct {
+	Events     []ObjectEvent
+	AfterPulse uint32
+	Delivered  []string
+}
+
+// WatchEvents waits for new events emitted by object and returns them ordered from older to newer requests.
+// Reply is sent as soon as there are new events or when timeout expires. Watching position is a pulse with
+// requests of the pulse events of which are already delivered, since requests of the same pulse are not ordered
+// by time. Call it again with returned AfterPulse and Delivered to continue watching. Events become available
+// after their pulse is replicated to heavy node.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "object.WatchEvents",
+//     "params": {
+//       "Reference": str, // object reference
+//       "Method": str, // only events of the method calls, all events if empty
+//       "AfterPulse": int, // pulse events of older pulses are already received, watch new requests if zero
+//       "Delivered": []str, // requests of AfterPulse events of which are already received
+//       "Timeout": int // max seconds to wait for events, 30 if zero, 60 at most
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Events": [ ... ], // same as in Object.Events
+// 			"AfterPulse": int, // pulse to pass to the next call
+// 			"Delivered": []str // requests to pass to the next call
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *ObjectService) WatchEvents(r *http.Request, args *ObjectWatchEventsArgs, reply *ObjectWatchEventsReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ObjectService.WatchEvents ] Incoming request: %s", r.RequestURI)
+
+	if len(args.Reference) == 0 {
+		return errors.New("params.Reference is missing")
+	}
+	obj, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.WatchEvents ] failed to parse reference")
+	}
+
+	timeout := time.Duration(args.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultWatchEventsTimeout
+	}
+	if timeout > maxWatchEventsTimeout {
+		timeout = maxWatchEventsTimeout
+	}
+
+	cursor := eventsCursor{pulse: insolar.PulseNumber(args.AfterPulse), delivered: map[insolar.ID]struct{}{}}
+	for _, d := range args.Delivered {
+		reqID, err := insolar.NewIDFromBase58(d)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.WatchEvents ] failed to parse request")
+		}
+		cursor.delivered[*reqID] = struct{}{}
+	}
+	if cursor.pulse == 0 {
+		// Events of the latest pulse with requests are treated as already delivered.
+		latest, _, err := s.runner.ArtifactManager.GetObjectRequests(ctx, *obj, nil, 1)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.WatchEvents ] failed to fetch latest request")
+		}
+		if len(latest) != 0 {
+			cursor.pulse = latest[0].RequestID.Pulse()
+			events, err := s.eventsAfter(ctx, *obj, args.Method, cursor)
+			if err != nil {
+				return errors.Wrap(err, "[ ObjectService.WatchEvents ]")
+			}
+			cursor.advance(events)
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		events, err := s.eventsAfter(ctx, *obj, args.Method, cursor)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.WatchEvents ]")
+		}
+		if len(events) != 0 {
+			reply.Events = make([]ObjectEvent, 0, len(events))
+			for i := len(events) - 1; i >= 0; i-- {
+				reply.Events = append(reply.Events, newObjectEvent(events[i]))
+			}
+			cursor.advance(events)
+			break
+		}
+		if time.Now().Add(watchEventsPollInterval).After(deadline) {
+			break
+		}
+		time.Sleep(watchEventsPollInterval)
+	}
+
+	reply.AfterPulse = uint32(cursor.pulse)
+	reply.Delivered = make([]string, 0, len(cursor.delivered))
+	for reqID := range cursor.delivered {
+		reply.Delivered = append(reply.Delivered, reqID.String())
+	}
+	return nil
+}
+
+// eventsCursor is a position of watching events. Events of requests from older pulses and of delivered requests
+// from the pulse are already received.
+type eventsCursor struct {
+	pulse     insolar.PulseNumber
+	delivered map[insolar.ID]struct{}
+}
+
+// received checks if events of the request are already received.
+func (c eventsCursor) received(reqID insolar.ID) bool {
+	if reqID.Pulse() != c.pulse {
+		return reqID.Pulse() < c.pulse
+	}
+	_, ok := c.delivered[reqID]
+	return ok
+}
+
+// advance moves cursor past provided events ordered from newer to older requests.
+func (c *eventsCursor) advance(events []artifacts.ObjectEvent) {
+	for _, e := range events {
+		pn := e.RequestID.Pulse()
+		if pn < c.pulse {
+			continue
+		}
+		if pn > c.pulse {
+			c.pulse = pn
+			c.delivered = map[insolar.ID]struct{}{}
+		}
+		c.delivered[e.RequestID] = struct{}{}
+	}
+}
+
+// eventsAfter returns events of requests, that are not received according to cursor, ordered from newer to older
+// requests.
+func (s *ObjectService) eventsAfter(
+	ctx context.Context, obj insolar.Reference, method string, cursor eventsCursor,
+) ([]artifacts.ObjectEvent, error) {
+	filter := artifacts.EventsFilter{Method: method, FromPulse: cursor.pulse}
+
+	var res []artifacts.ObjectEvent
+	var from *insolar.ID
+	for {
+		events, next, err := s.runner.ArtifactManager.GetObjectEvents(ctx, obj, filter, from, defaultObjectHistoryAmount)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			if cursor.received(e.RequestID) {
+				continue
+			}
+			res = append(res, e)
+		}
+		if next == nil {
+			return res, nil
+		}
+		from = next
+	}
+}
+
+func newObjectEvent(e artifacts.ObjectEvent) ObjectEvent {
+	return ObjectEvent{
+		RequestID:   e.RequestID.String(),
+		PulseNumber: uint32(e.RequestID.Pulse()),
+		ResultID:    e.ResultID.String(),
+		Method:      e.Method,
+		Name:        e.Name,
+		Payload:     e.Payload,
+	}
+}
@@ -0,0 +1,50 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsCursor(t *testing.T) {
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+	first := *insolar.NewID(pn, []byte{2})
+	second := *insolar.NewID(pn, []byte{1})
+	newer := *insolar.NewID(pn+1, []byte{3})
+
+	cursor := eventsCursor{pulse: pn, delivered: map[insolar.ID]struct{}{}}
+	cursor.advance([]artifacts.ObjectEvent{{RequestID: first}})
+	assert.True(t, cursor.received(first))
+	// Request of the same pulse with a lower id is still expected.
+	assert.False(t, cursor.received(second))
+	assert.True(t, cursor.received(*insolar.NewID(pn-1, []byte{5})))
+
+	cursor.advance([]artifacts.ObjectEvent{{RequestID: second}, {RequestID: second}})
+	assert.Equal(t, pn, cursor.pulse)
+	assert.Len(t, cursor.delivered, 2)
+	assert.True(t, cursor.received(second))
+
+	cursor.advance([]artifacts.ObjectEvent{{RequestID: newer}, {RequestID: first}})
+	assert.Equal(t, pn+1, cursor.pulse)
+	assert.Equal(t, map[insolar.ID]struct{}{newer: {}}, cursor.delivered)
+	assert.True(t, cursor.received(second))
+	assert.False(t, cursor.received(*insolar.NewID(pn+1, []byte{4})))
+}
@@ -0,0 +1,148 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"math"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+var recordKinds = map[string]object.RecordKind{
+	"code":      object.KindCode,
+	"prototype": object.KindPrototype,
+	"request":   object.KindRequest,
+}
+
+// RecordsListArgs is arguments that Records.List accepts.
+type RecordsListArgs struct {
+	Kind       string
+	Method     string
+	FromPulse  uint32
+	ToPulse    uint32
+	FromRecord string
+	Limit      int
+}
+
+// RecordsListReply is reply that Records.List returns.
+type RecordsListReply struct {
+	IDs      []string
+	NextFrom string
+}
+
+// defaultRecordsListLimit is how many ids Records.List returns if limit is not provided.
+const defaultRecordsListLimit = 100
+
+// RecordsService is a service that provides API for searching records by type.
+type RecordsService struct {
+	runner *Runner
+}
+
+// NewRecordsService creates new Records service instance.
+func NewRecordsService(runner *Runner) *RecordsService {
+	return &RecordsService{runner: runner}
+}
+
+// List returns ids of records of provided kind registered in provided pulse range. It's available on heavy nodes only
+// and sees records replicated from light nodes.
+//
+// Kinds are "code" for code records, "prototype" for prototypes (ids of prototype heads are returned) and "request"
+// for requests calling provided method.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "records.List",
+//     "params": {
+//       "Kind": str, // one of "code", "prototype", "request"
+//       "Method": str, // called method, for "request" kind only
+//       "FromPulse": int, // first pulse of range
+//       "ToPulse": int, // last pulse of range, latest pulse if omitted
+//       "FromRecord": str, // record to start from, overrides FromPulse
+//       "Limit": int // max number of ids to return
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"IDs": []str, // record ids sorted by pulse
+// 			"NextFrom": str // record to request the next page from, empty if there are no more records
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *RecordsService) List(r *http.Request, args *RecordsListArgs, reply *RecordsListReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ RecordsService.List ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.TypeIndex == nil {
+		return errors.New("[ RecordsService.List ] records search is available on heavy nodes only")
+	}
+
+	kind, ok := recordKinds[args.Kind]
+	if !ok {
+		return errors.Errorf("[ RecordsService.List ] unknown record kind %q", args.Kind)
+	}
+	if kind != object.KindRequest && len(args.Method) != 0 {
+		return errors.New("[ RecordsService.List ] params.Method is allowed for request kind only")
+	}
+
+	to := insolar.PulseNumber(args.ToPulse)
+	if to == 0 {
+		to = math.MaxUint32
+	}
+
+	from := *insolar.NewID(insolar.PulseNumber(args.FromPulse), nil)
+	if len(args.FromRecord) != 0 {
+		id, err := insolar.NewIDFromBase58(args.FromRecord)
+		if err != nil {
+			return errors.Wrap(err, "[ RecordsService.List ] failed to parse record")
+		}
+		from = *id
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = defaultRecordsListLimit
+	}
+
+	ids, next, err := s.runner.TypeIndex.ForKind(ctx, kind, args.Method, from, to, limit)
+	if err != nil {
+		return errors.Wrap(err, "[ RecordsService.List ]")
+	}
+
+	reply.IDs = make([]string, 0, len(ids))
+	for _, id := range ids {
+		reply.IDs = append(reply.IDs, id.String())
+	}
+	if next != nil {
+		reply.NextFrom = next.String()
+	}
+
+	return nil
+}
@@ -30,9 +30,12 @@ import (
 
 // UserConfigJSON holds info about user
 type UserConfigJSON struct {
-	PrivateKey       string `json:"private_key"`
-	Caller           string `json:"caller"`
-	privateKeyObject crypto.PrivateKey
+	PrivateKey string `json:"private_key"`
+	// PrivateKeys are keys of multisig member, each of them signs request
+	PrivateKeys       []string `json:"private_keys,omitempty"`
+	Caller            string   `json:"caller"`
+	privateKeyObject  crypto.PrivateKey
+	privateKeyObjects []crypto.PrivateKey
 }
 
 // RequestConfigJSON holds info about request
@@ -40,6 +43,7 @@ type RequestConfigJSON struct {
 	Params   []interface{} `json:"params"`
 	Method   string        `json:"method"`
 	LogLevel interface{}   `json:"logLevel,omitempty"`
+	DryRun   bool          `json:"dryRun,omitempty"`
 }
 
 func readFile(path string, configType interface{}) error {
@@ -72,6 +76,14 @@ func ReadUserConfigFromFile(file string) (*UserConfigJSON, error) {
 
 	ks := platformpolicy.NewKeyProcessor()
 
+	if len(cfgJSON.PrivateKeys) > 0 {
+		cfgJSON.privateKeyObjects, err = importPrivateKeys(cfgJSON.PrivateKeys)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ readUserConfigFromFile ] Problem with reading private keys")
+		}
+		return cfgJSON, nil
+	}
+
 	if cfgJSON.PrivateKey == "" {
 		privKey, err := ks.GeneratePrivateKey()
 		if err != nil {
@@ -112,3 +124,25 @@ func CreateUserConfig(caller string, privKey string) (*UserConfigJSON, error) {
 	userConfig.privateKeyObject, err = ks.ImportPrivateKeyPEM([]byte(privKey))
 	return &userConfig, err
 }
+
+// CreateMultisigUserConfig creates user config of multisig member from arguments
+func CreateMultisigUserConfig(caller string, privKeys []string) (*UserConfigJSON, error) {
+	userConfig := UserConfigJSON{PrivateKeys: privKeys, Caller: caller}
+	var err error
+
+	userConfig.privateKeyObjects, err = importPrivateKeys(privKeys)
+	return &userConfig, err
+}
+
+func importPrivateKeys(privKeys []string) ([]crypto.PrivateKey, error) {
+	ks := platformpolicy.NewKeyProcessor()
+	keys := make([]crypto.PrivateKey, 0, len(privKeys))
+	for i, privKey := range privKeys {
+		key, err := ks.ImportPrivateKeyPEM([]byte(privKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ importPrivateKeys ] Problem with key #%d", i)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
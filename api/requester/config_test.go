@@ -48,3 +48,11 @@ func TestReadUserConfigFromFile(t *testing.T) {
 	require.Contains(t, conf.PrivateKey, "MHcCAQEEIPOsF3ujjM7jnb7V")
 	require.Equal(t, "4FFB8zfQoGznSmzDxwv4njX1aR9ioL8GHSH17QXH2AFa.4K3NiGuqYGqKPnYp6XeGd2kdN4P9veL6rYcWkLKWXZCu", conf.Caller)
 }
+
+func TestReadUserConfigFromFile_Multisig(t *testing.T) {
+	conf, err := ReadUserConfigFromFile("testdata/multisigUserConfig.json")
+	require.NoError(t, err)
+	require.Len(t, conf.PrivateKeys, 2)
+	require.Len(t, conf.privateKeyObjects, 2)
+	require.Empty(t, conf.PrivateKey)
+}
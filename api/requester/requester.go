@@ -168,20 +168,36 @@ func SendWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, reqC
 		return nil, errors.Wrap(err, "[ Send ] Problem with serializing request")
 	}
 
-	verboseInfo(ctx, "Signing request ...")
-	cs := scheme.Signer(userCfg.privateKeyObject)
-	signature, err := cs.Sign(serRequest)
-	if err != nil {
-		return nil, errors.Wrap(err, "[ Send ] Problem with signing request")
-	}
-	verboseInfo(ctx, "Signing request completed")
-
 	postParams := PostParams{
 		"params":    params,
 		"method":    reqCfg.Method,
 		"reference": userCfg.Caller,
 		"seed":      seed,
-		"signature": signature.Bytes(),
+	}
+
+	verboseInfo(ctx, "Signing request ...")
+	if len(userCfg.privateKeyObjects) > 0 {
+		signatures := make([][]byte, 0, len(userCfg.privateKeyObjects))
+		for _, key := range userCfg.privateKeyObjects {
+			signature, err := scheme.Signer(key).Sign(serRequest)
+			if err != nil {
+				return nil, errors.Wrap(err, "[ Send ] Problem with signing request")
+			}
+			signatures = append(signatures, signature.Bytes())
+		}
+		postParams["signatures"] = signatures
+	} else {
+		cs := scheme.Signer(userCfg.privateKeyObject)
+		signature, err := cs.Sign(serRequest)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Send ] Problem with signing request")
+		}
+		postParams["signature"] = signature.Bytes()
+	}
+	verboseInfo(ctx, "Signing request completed")
+
+	if reqCfg.DryRun {
+		postParams["dryRun"] = true
 	}
 	if reqCfg.LogLevel != nil {
 		postParams["logLevel"] = reqCfg.LogLevel
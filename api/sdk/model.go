@@ -20,6 +20,8 @@ package sdk
 type Member struct {
 	Reference  string
 	PrivateKey string
+	// PrivateKeys of multisig member, PrivateKey is empty for such member
+	PrivateKeys []string
 }
 
 // NewMember creates new Member
@@ -29,3 +31,27 @@ func NewMember(ref string, key string) *Member {
 		PrivateKey: key,
 	}
 }
+
+// NewMultisigMember creates new Member which signs requests with several keys
+func NewMultisigMember(ref string, keys []string) *Member {
+	return &Member{
+		Reference:   ref,
+		PrivateKeys: keys,
+	}
+}
+
+// Allowance model object
+type Allowance struct {
+	Reference  string `json:"reference"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Amount     string `json:"amount"`
+	ExpireTime int64  `json:"expireTime"`
+	Expired    bool   `json:"expired"`
+}
+
+// Allowances holds incoming and outgoing allowances of member
+type Allowances struct {
+	Incoming []Allowance `json:"incoming"`
+	Outgoing []Allowance `json:"outgoing"`
+}
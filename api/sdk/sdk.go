@@ -18,8 +18,10 @@ package sdk
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"io/ioutil"
+	"math/big"
 	"sync"
 
 	"github.com/insolar/insolar/api/requester"
@@ -122,6 +124,34 @@ func (sdk *SDK) sendRequest(ctx context.Context, method string, params []interfa
 	return body, nil
 }
 
+func userConfig(m *Member) (*requester.UserConfigJSON, error) {
+	if len(m.PrivateKeys) > 0 {
+		return requester.CreateMultisigUserConfig(m.Reference, m.PrivateKeys)
+	}
+	return requester.CreateUserConfig(m.Reference, m.PrivateKey)
+}
+
+func generateKeys() (string, string, error) {
+	ks := platformpolicy.NewKeyProcessor()
+
+	privateKey, err := ks.GeneratePrivateKey()
+	if err != nil {
+		return "", "", errors.Wrap(err, "can't generate private key")
+	}
+
+	privateKeyStr, err := ks.ExportPrivateKeyPEM(privateKey)
+	if err != nil {
+		return "", "", errors.Wrap(err, "can't export private key")
+	}
+
+	publicKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(privateKey))
+	if err != nil {
+		return "", "", errors.Wrap(err, "can't extract public key")
+	}
+
+	return string(privateKeyStr), string(publicKeyStr), nil
+}
+
 func (sdk *SDK) getResponse(body []byte) (*response, error) {
 	res := &response{}
 	err := json.Unmarshal(body, &res)
@@ -136,46 +166,193 @@ func (sdk *SDK) getResponse(body []byte) (*response, error) {
 func (sdk *SDK) CreateMember() (*Member, string, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "CreateMember")
 	memberName := testutils.RandomString()
-	ks := platformpolicy.NewKeyProcessor()
 
-	privateKey, err := ks.GeneratePrivateKey()
+	privateKeyStr, memberPubKeyStr, err := generateKeys()
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't generate private key")
+		return nil, "", errors.Wrap(err, "[ CreateMember ]")
 	}
 
-	privateKeyStr, err := ks.ExportPrivateKeyPEM(privateKey)
+	params := []interface{}{memberName, memberPubKeyStr}
+	body, err := sdk.sendRequest(ctx, "CreateMember", params, sdk.rootMember)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't export private key")
+		return nil, "", errors.Wrap(err, "[ CreateMember ] can't send request")
 	}
 
-	memberPubKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(privateKey))
+	response, err := sdk.getResponse(body)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't extract public key")
+		return nil, "", errors.Wrap(err, "[ CreateMember ] can't get response")
 	}
 
-	params := []interface{}{memberName, string(memberPubKeyStr)}
-	body, err := sdk.sendRequest(ctx, "CreateMember", params, sdk.rootMember)
+	if response.Error != "" {
+		return nil, response.TraceID, errors.New(response.Error)
+	}
+
+	return NewMember(response.Result.(string), privateKeyStr), response.TraceID, nil
+}
+
+// CreateMultisigMember api request creates member with given number of new random keys,
+// calls of created member must be signed by threshold of them
+func (sdk *SDK) CreateMultisigMember(keys int, threshold uint) (*Member, string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "CreateMultisigMember")
+	memberName := testutils.RandomString()
+
+	privateKeys := make([]string, 0, keys)
+	publicKeys := make([]string, 0, keys)
+	for i := 0; i < keys; i++ {
+		privateKeyStr, publicKeyStr, err := generateKeys()
+		if err != nil {
+			return nil, "", errors.Wrap(err, "[ CreateMultisigMember ]")
+		}
+		privateKeys = append(privateKeys, privateKeyStr)
+		publicKeys = append(publicKeys, publicKeyStr)
+	}
+
+	params := []interface{}{memberName, publicKeys, threshold}
+	body, err := sdk.sendRequest(ctx, "CreateMultisigMember", params, sdk.rootMember)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't send request")
+		return nil, "", errors.Wrap(err, "[ CreateMultisigMember ] can't send request")
 	}
 
 	response, err := sdk.getResponse(body)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't get response")
+		return nil, "", errors.Wrap(err, "[ CreateMultisigMember ] can't get response")
+	}
+
+	if response.Error != "" {
+		return nil, response.TraceID, errors.New(response.Error)
+	}
+
+	return NewMultisigMember(response.Result.(string), privateKeys), response.TraceID, nil
+}
+
+// AddKey method adds public key to multisig member
+func (sdk *SDK) AddKey(m *Member, publicKey string) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "AddKey")
+	_, traceID, err := sdk.memberCall(ctx, m, "AddKey", []interface{}{publicKey})
+	return traceID, err
+}
+
+// RemoveKey method removes public key from multisig member
+func (sdk *SDK) RemoveKey(m *Member, publicKey string) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "RemoveKey")
+	_, traceID, err := sdk.memberCall(ctx, m, "RemoveKey", []interface{}{publicKey})
+	return traceID, err
+}
+
+// CreateAllowance method locks amount of from's money until it is claimed by recipient or expires,
+// expire is lifetime of allowance in seconds. It returns reference of created allowance.
+func (sdk *SDK) CreateAllowance(from *Member, to *Member, amount *big.Int, expire uint) (string, string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "CreateAllowance")
+	result, traceID, err := sdk.memberCall(ctx, from, "CreateAllowance", []interface{}{to.Reference, amount.String(), expire})
+	if err != nil {
+		return "", traceID, err
+	}
+	return result.(string), traceID, nil
+}
+
+// ListAllowances returns incoming and outgoing allowances of member
+func (sdk *SDK) ListAllowances(m *Member) (*Allowances, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "ListAllowances")
+	result, _, err := sdk.memberCall(ctx, m, "ListAllowances", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// contract returns json bytes, api encodes them with base64
+	data, err := base64.StdEncoding.DecodeString(result.(string))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ListAllowances ] can't decode result")
+	}
+	allowances := &Allowances{}
+	err = json.Unmarshal(data, allowances)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ListAllowances ] can't unmarshal result")
+	}
+	return allowances, nil
+}
+
+// ClaimAllowance method moves amount of incoming allowance to member's balance
+func (sdk *SDK) ClaimAllowance(m *Member, allowance string) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "ClaimAllowance")
+	_, traceID, err := sdk.memberCall(ctx, m, "ClaimAllowance", []interface{}{allowance})
+	return traceID, err
+}
+
+// ReclaimExpired method returns expired outgoing allowances to member's balance, it returns reclaimed amount
+func (sdk *SDK) ReclaimExpired(m *Member) (*big.Int, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "ReclaimExpired")
+	result, _, err := sdk.memberCall(ctx, m, "ReclaimExpired", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseAmount(result)
+}
+
+// IssueToken method issues new asset with given ID, whole supply is credited to issuer
+func (sdk *SDK) IssueToken(issuer *Member, symbol string, name string, decimals uint, supply *big.Int) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "IssueToken")
+	_, traceID, err := sdk.memberCall(ctx, issuer, "IssueToken", []interface{}{symbol, name, decimals, supply.String()})
+	return traceID, err
+}
+
+// TransferAsset method send amount of given asset from one member to another
+func (sdk *SDK) TransferAsset(asset string, amount *big.Int, from *Member, to *Member) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "TransferAsset")
+	_, traceID, err := sdk.memberCall(ctx, from, "TransferAsset", []interface{}{asset, amount.String(), to.Reference})
+	return traceID, err
+}
+
+// GetAssetBalance returns balance of given asset of the given member.
+func (sdk *SDK) GetAssetBalance(asset string, m *Member) (*big.Int, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "GetAssetBalance")
+	result, _, err := sdk.memberCall(ctx, m, "GetAssetBalance", []interface{}{asset, m.Reference})
+	if err != nil {
+		return nil, err
+	}
+	return parseAmount(result)
+}
+
+// parseAmount converts amount returned by contract as decimal string
+func parseAmount(result interface{}) (*big.Int, error) {
+	str, ok := result.(string)
+	if !ok {
+		return nil, errors.Errorf("[ parseAmount ] unexpected type of amount %T", result)
+	}
+	amount, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return nil, errors.Errorf("[ parseAmount ] can't parse amount %q", str)
+	}
+	return amount, nil
+}
+
+func (sdk *SDK) memberCall(ctx context.Context, m *Member, method string, params []interface{}) (interface{}, string, error) {
+	config, err := userConfig(m)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "[ %s ] can't create user config", method)
+	}
+
+	body, err := sdk.sendRequest(ctx, method, params, config)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "[ %s ] can't send request", method)
+	}
+
+	response, err := sdk.getResponse(body)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "[ %s ] can't get response", method)
 	}
 
 	if response.Error != "" {
 		return nil, response.TraceID, errors.New(response.Error)
 	}
 
-	return NewMember(response.Result.(string), string(privateKeyStr)), response.TraceID, nil
+	return response.Result, response.TraceID, nil
 }
 
-// Transfer method send money from one member to another
-func (sdk *SDK) Transfer(amount uint, from *Member, to *Member) (string, error) {
+// Transfer method send money from one member to another, amount is counted in the smallest units of native coin
+func (sdk *SDK) Transfer(amount *big.Int, from *Member, to *Member) (string, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "Transfer")
-	params := []interface{}{amount, to.Reference}
-	config, err := requester.CreateUserConfig(from.Reference, from.PrivateKey)
+	params := []interface{}{amount.String(), to.Reference}
+	config, err := userConfig(from)
 	if err != nil {
 		return "", errors.Wrap(err, "[ Transfer ] can't create user config")
 	}
@@ -198,28 +375,27 @@ func (sdk *SDK) Transfer(amount uint, from *Member, to *Member) (string, error)
 }
 
 // GetBalance returns current balance of the given member.
-func (sdk *SDK) GetBalance(m *Member) (uint64, error) {
+func (sdk *SDK) GetBalance(m *Member) (*big.Int, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "GetBalance")
 	params := []interface{}{m.Reference}
-	config, err := requester.CreateUserConfig(m.Reference, m.PrivateKey)
+	config, err := userConfig(m)
 	if err != nil {
-		return 0, errors.Wrap(err, "[ GetBalance ] can't create user config")
+		return nil, errors.Wrap(err, "[ GetBalance ] can't create user config")
 	}
 
 	body, err := sdk.sendRequest(ctx, "GetBalance", params, config)
 	if err != nil {
-		return 0, errors.Wrap(err, "[ GetBalance ] can't send request")
+		return nil, errors.Wrap(err, "[ GetBalance ] can't send request")
 	}
 
 	response, err := sdk.getResponse(body)
 	if err != nil {
-		return 0, errors.Wrap(err, "[ GetBalance ] can't get response")
+		return nil, errors.Wrap(err, "[ GetBalance ] can't get response")
 	}
 
 	if response.Error != "" {
-		return 0, errors.New(response.Error)
+		return nil, errors.New(response.Error)
 	}
 
-	// TODO FIXME don't transfer money in floats!
-	return uint64(response.Result.(float64)), nil
+	return parseAmount(response.Result)
 }
@@ -33,6 +33,14 @@ type Node struct {
 	IsWorking bool
 }
 
+// ContractWorker is a state of `insgorund` worker executing contracts.
+type ContractWorker struct {
+	ID       int
+	PID      int
+	Alive    bool
+	Restarts int
+}
+
 // StatusReply is reply for Status service requests.
 type StatusReply struct {
 	NetworkState    string
@@ -44,6 +52,7 @@ type StatusReply struct {
 	Entropy         []byte
 	NodeState       string
 	Version         string
+	ContractWorkers []ContractWorker
 }
 
 // StatusService is a service that provides API for getting status of node.
@@ -98,5 +107,16 @@ func (s *StatusService) Get(r *http.Request, args *interface{}, reply *StatusRep
 	reply.Entropy = pulse.Entropy[:]
 	reply.Version = version.Version
 
+	if s.runner.ContractWorkers != nil {
+		for _, w := range s.runner.ContractWorkers.Health() {
+			reply.ContractWorkers = append(reply.ContractWorkers, ContractWorker{
+				ID:       w.ID,
+				PID:      w.PID,
+				Alive:    w.Alive,
+				Restarts: w.Restarts,
+			})
+		}
+	}
+
 	return nil
 }
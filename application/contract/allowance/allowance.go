@@ -20,59 +20,112 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/insolar/insolar/application/contract/wallet/safemath"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 )
 
+// Info holds allowance details
+type Info struct {
+	From       insolar.Reference
+	To         insolar.Reference
+	Asset      string
+	Amount     string
+	ExpireTime int64
+	Expired    bool
+}
+
+// Allowance locks amount of wallet for recipient, amount is decimal string since version 1.
+// Asset is symbol of token registry asset, it's empty for native balance.
+//ins:version 1
 type Allowance struct {
 	foundation.BaseContract
 	To         insolar.Reference
-	Amount     uint
+	Asset      string
+	Amount     string `codec:"DecimalAmount"`
 	ExpireTime int64
 }
 
+// Migrate converts uint amount saved by previous version of code to decimal string, the amount was counted in whole
+// coins, it's converted to the smallest units of native coin
+func Migrate(version uint, state []byte) (*Allowance, error) {
+	old := struct {
+		To         insolar.Reference
+		Amount     uint
+		ExpireTime int64
+	}{}
+	if err := insolar.Deserialize(state, &old); err != nil {
+		return nil, fmt.Errorf("[ Migrate ] Can't deserialize state of version %d: %s", version, err.Error())
+	}
+	return &Allowance{
+		To:         old.To,
+		Amount:     safemath.NativeUnits(uint64(old.Amount)),
+		ExpireTime: old.ExpireTime,
+	}, nil
+}
+
 func (a *Allowance) isExpired() bool {
 	return a.GetContext().Time.After(time.Unix(a.ExpireTime, 0))
 }
 
 // TakeAmount allows take amount and delete allowance
-func (a *Allowance) TakeAmount() (uint, error) {
+func (a *Allowance) TakeAmount() (string, error) {
 	if *(a.GetContext().Caller) != a.To {
-		return 0, fmt.Errorf("[ TakeAmount ] Only recepient can take amount")
+		return "", fmt.Errorf("[ TakeAmount ] Only recepient can take amount")
 	}
 	if a.isExpired() {
-		return 0, fmt.Errorf("[ TakeAmount ] Allowance expiried")
+		return "", fmt.Errorf("[ TakeAmount ] Allowance expiried")
 	}
 	if err := a.SelfDestruct(); err != nil {
-		return 0, err
+		return "", err
 	}
 	return a.Amount, nil
 }
 
 // GetBalanceForOwner returns balance
-func (a *Allowance) GetBalanceForOwner() (uint, error) {
+func (a *Allowance) GetBalanceForOwner() (string, error) {
 	return a.Amount, nil
 }
 
+// GetInfo returns allowance details
+func (a *Allowance) GetInfo() (Info, error) {
+	return Info{
+		From:       *a.GetContext().Parent,
+		To:         a.To,
+		Asset:      a.Asset,
+		Amount:     a.Amount,
+		ExpireTime: a.ExpireTime,
+		Expired:    a.isExpired(),
+	}, nil
+}
+
 // GetExpiredBalance gets balance from expired allowance and delete allowance
-func (a *Allowance) GetExpiredBalance() (uint, error) {
+func (a *Allowance) GetExpiredBalance() (string, error) {
 	if *(a.GetContext().Caller) != *(a.GetContext().Parent) {
-		return 0, fmt.Errorf("[ DeleteExpiredAllowance ] Only owner can delete expiried Allowance")
+		return "", fmt.Errorf("[ DeleteExpiredAllowance ] Only owner can delete expiried Allowance")
 	}
 	if a.isExpired() {
 		if err := a.SelfDestruct(); err != nil {
-			return 0, err
+			return "", err
 		}
 		return a.Amount, nil
 	}
-	return 0, nil
+	return "0", nil
 }
 
 // New check is caller wallet and makes new allowance
-func New(to *insolar.Reference, amount uint, expire int64) (*Allowance, error) {
+func New(to *insolar.Reference, amount string, expire int64) (*Allowance, error) {
 	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
 		return nil, fmt.Errorf("[ New Allowance ] : Can't create allowance from not wallet contract")
 	}
 	return &Allowance{To: *to, Amount: amount, ExpireTime: expire}, nil
 }
+
+// NewAsset check is caller wallet and makes new allowance of asset
+func NewAsset(to *insolar.Reference, asset string, amount string, expire int64) (*Allowance, error) {
+	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+		return nil, fmt.Errorf("[ NewAsset Allowance ] : Can't create allowance from not wallet contract")
+	}
+	return &Allowance{To: *to, Asset: asset, Amount: amount, ExpireTime: expire}, nil
+}
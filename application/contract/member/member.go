@@ -17,22 +17,52 @@
 package member
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 
 	"github.com/insolar/insolar/application/contract/member/signer"
+	"github.com/insolar/insolar/application/contract/wallet/safemath"
 	"github.com/insolar/insolar/application/proxy/nodedomain"
 	"github.com/insolar/insolar/application/proxy/rootdomain"
+	"github.com/insolar/insolar/application/proxy/tokenregistry"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 )
 
+// approvalWindow is the distance in pulse numbers during which a multisig call collects signatures
+const approvalWindow = 100
+
+// Approval holds signers of a multisig call which has not reached the threshold yet
+type Approval struct {
+	ID      string
+	Signers []string
+	Pulse   insolar.PulseNumber
+}
+
+// maxRecoveryDelay is the longest recovery delay, pulse numbers follow unix time, so it's about a year
+const maxRecoveryDelay = 365 * 24 * 60 * 60
+
+// Recovery is a pending replacement of member's key requested by holder of recovery key
+type Recovery struct {
+	NewKey string
+	Pulse  insolar.PulseNumber
+}
+
 type Member struct {
 	foundation.BaseContract
-	Name      string
-	PublicKey string
+	Name          string
+	PublicKey     string
+	PublicKeys    []string
+	Threshold     uint
+	Approvals     []Approval
+	RecoveryKey   string
+	RecoveryDelay insolar.PulseNumber
+	Recovery      *Recovery
 }
 
 func (m *Member) GetName() (string, error) {
@@ -45,6 +75,16 @@ func (m *Member) GetPublicKey() (string, error) {
 	return m.PublicKey, nil
 }
 
+var INSATTR_GetPublicKeys_API = true
+
+// GetPublicKeys returns keys of multisig member or the only key of ordinary member
+func (m *Member) GetPublicKeys() ([]string, error) {
+	if !m.isMultisig() {
+		return []string{m.PublicKey}, nil
+	}
+	return m.PublicKeys, nil
+}
+
 func New(name string, key string) (*Member, error) {
 	return &Member{
 		Name:      name,
@@ -52,15 +92,44 @@ func New(name string, key string) (*Member, error) {
 	}, nil
 }
 
-func (m *Member) verifySig(method string, params []byte, seed []byte, sign []byte) error {
+// NewMultisig creates member whose calls must be signed by threshold of given keys
+func NewMultisig(name string, keys []string, threshold uint) (*Member, error) {
+	if err := checkKeys(keys, threshold); err != nil {
+		return nil, fmt.Errorf("[ NewMultisig ] %s", err.Error())
+	}
+	return &Member{
+		Name:       name,
+		PublicKeys: keys,
+		Threshold:  threshold,
+	}, nil
+}
+
+func checkKeys(keys []string, threshold uint) error {
+	if threshold == 0 || threshold > uint(len(keys)) {
+		return fmt.Errorf("Threshold must be between 1 and %d", len(keys))
+	}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if _, err := foundation.ImportPublicKey(key); err != nil {
+			return fmt.Errorf("Invalid public key %q", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("Duplicate public key %q", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func (m *Member) isMultisig() bool {
+	return len(m.PublicKeys) > 0
+}
+
+func (m *Member) verifySig(key string, method string, params []byte, seed []byte, sign []byte) error {
 	args, err := insolar.MarshalArgs(m.GetReference(), method, params, seed)
 	if err != nil {
 		return fmt.Errorf("[ verifySig ] Can't MarshalArgs: %s", err.Error())
 	}
-	key, err := m.GetPublicKey()
-	if err != nil {
-		return fmt.Errorf("[ verifySig ]: %s", err.Error())
-	}
 
 	publicKey, err := foundation.ImportPublicKey(key)
 	if err != nil {
@@ -74,6 +143,82 @@ func (m *Member) verifySig(method string, params []byte, seed []byte, sign []byt
 	return nil
 }
 
+// verifyMultisig checks signatures of multisig call and accumulates them in pending approval.
+// It returns number of collected signatures, call may proceed when it reaches threshold.
+func (m *Member) verifyMultisig(method string, params []byte, seed []byte, sign []byte) (uint, error) {
+	var signatures [][]byte
+	if err := insolar.Deserialize(sign, &signatures); err != nil {
+		return 0, fmt.Errorf("[ verifyMultisig ] Can't unmarshal signatures: %s", err.Error())
+	}
+	args, err := insolar.MarshalArgs(m.GetReference(), method, params, seed)
+	if err != nil {
+		return 0, fmt.Errorf("[ verifyMultisig ] Can't MarshalArgs: %s", err.Error())
+	}
+
+	signers := map[string]bool{}
+	for _, s := range signatures {
+		key, err := m.findSigner(args, s)
+		if err != nil {
+			return 0, fmt.Errorf("[ verifyMultisig ] %s", err.Error())
+		}
+		signers[key] = true
+	}
+	// don't touch pending approvals unless call is signed by at least one member key
+	if len(signers) == 0 {
+		return 0, fmt.Errorf("[ verifyMultisig ] No signatures")
+	}
+
+	now := m.GetContext().Pulse.PulseNumber
+	id := approvalID(method, params)
+	started := now
+	approvals := make([]Approval, 0, len(m.Approvals)+1)
+	for _, a := range m.Approvals {
+		if now-a.Pulse > approvalWindow {
+			continue
+		}
+		if a.ID == id {
+			for _, key := range a.Signers {
+				signers[key] = true
+			}
+			started = a.Pulse
+			continue
+		}
+		approvals = append(approvals, a)
+	}
+
+	collected := uint(len(signers))
+	if collected < m.Threshold {
+		// keep signers in order of member keys so state doesn't depend on map iteration
+		approval := Approval{ID: id, Pulse: started}
+		for _, key := range m.PublicKeys {
+			if signers[key] {
+				approval.Signers = append(approval.Signers, key)
+			}
+		}
+		approvals = append(approvals, approval)
+	}
+	m.Approvals = approvals
+	return collected, nil
+}
+
+func (m *Member) findSigner(args []byte, sign []byte) (string, error) {
+	for _, key := range m.PublicKeys {
+		publicKey, err := foundation.ImportPublicKey(key)
+		if err != nil {
+			return "", fmt.Errorf("Invalid public key")
+		}
+		if foundation.Verify(args, sign, publicKey) {
+			return key, nil
+		}
+	}
+	return "", fmt.Errorf("Incorrect signature")
+}
+
+func approvalID(method string, params []byte) string {
+	h := sha256.Sum256(append([]byte(method), params...))
+	return hex.EncodeToString(h[:])
+}
+
 var INSATTR_Call_API = true
 
 // Call method for authorized calls
@@ -82,9 +227,23 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 	switch method {
 	case "CreateMember":
 		return m.createMemberCall(rootDomain, params)
+	case "CreateMultisigMember":
+		return m.createMultisigMemberCall(rootDomain, params)
+	case "StartRecovery":
+		return m.startRecoveryCall(params, seed, sign)
+	case "FinishRecovery":
+		return m.finishRecoveryCall(params, seed, sign)
 	}
 
-	if err := m.verifySig(method, params, seed, sign); err != nil {
+	if m.isMultisig() {
+		collected, err := m.verifyMultisig(method, params, seed, sign)
+		if err != nil {
+			return nil, fmt.Errorf("[ Call ]: %s", err.Error())
+		}
+		if collected < m.Threshold {
+			return fmt.Sprintf("Collected %d of %d signatures", collected, m.Threshold), nil
+		}
+	} else if err := m.verifySig(m.PublicKey, method, params, seed, sign); err != nil {
 		return nil, fmt.Errorf("[ Call ]: %s", err.Error())
 	}
 
@@ -95,6 +254,22 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 		return m.getBalanceCall(params)
 	case "Transfer":
 		return m.transferCall(params)
+	case "CreateAllowance":
+		return m.createAllowanceCall(params)
+	case "ListAllowances":
+		return m.listAllowancesCall()
+	case "ClaimAllowance":
+		return m.claimAllowanceCall(params)
+	case "ReclaimExpired":
+		return m.reclaimExpiredCall()
+	case "IssueToken":
+		return m.issueTokenCall(rootDomain, params)
+	case "ListTokens":
+		return m.listTokensCall(rootDomain)
+	case "TransferAsset":
+		return m.transferAssetCall(params)
+	case "GetAssetBalance":
+		return m.getAssetBalanceCall(params)
 	case "DumpUserInfo":
 		return m.dumpUserInfoCall(rootDomain, params)
 	case "DumpAllUsers":
@@ -103,6 +278,20 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 		return m.registerNodeCall(rootDomain, params)
 	case "GetNodeRef":
 		return m.getNodeRefCall(rootDomain, params)
+	case "MigrateObjects":
+		return m.migrateObjectsCall(rootDomain, params)
+	case "AddKey":
+		return m.addKeyCall(params)
+	case "RemoveKey":
+		return m.removeKeyCall(params)
+	case "SetThreshold":
+		return m.setThresholdCall(params)
+	case "RotateKey":
+		return m.rotateKeyCall(params)
+	case "SetRecoveryKey":
+		return m.setRecoveryKeyCall(params)
+	case "CancelRecovery":
+		return m.cancelRecoveryCall()
 	}
 	return nil, &foundation.Error{S: "Unknown method"}
 }
@@ -117,6 +306,21 @@ func (m *Member) createMemberCall(ref insolar.Reference, params []byte) (interfa
 	return rootDomain.CreateMember(name, key)
 }
 
+func (m *Member) createMultisigMemberCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	rootDomain := rootdomain.GetObject(ref)
+	var name string
+	var keys []string
+	var inThreshold interface{}
+	if err := signer.UnmarshalParams(params, &name, &keys, &inThreshold); err != nil {
+		return nil, fmt.Errorf("[ createMultisigMemberCall ]: %s", err.Error())
+	}
+	threshold, err := parseUint(inThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("[ createMultisigMemberCall ] Wrong threshold: %s", err.Error())
+	}
+	return rootDomain.CreateMultisigMember(name, keys, threshold)
+}
+
 func (m *Member) getMyBalanceCall() (interface{}, error) {
 	w, err := wallet.GetImplementationFrom(m.GetReference())
 	if err != nil {
@@ -144,32 +348,14 @@ func (m *Member) getBalanceCall(params []byte) (interface{}, error) {
 }
 
 func (m *Member) transferCall(params []byte) (interface{}, error) {
-	var amount uint
 	var toStr string
 	var inAmount interface{}
 	if err := signer.UnmarshalParams(params, &inAmount, &toStr); err != nil {
 		return nil, fmt.Errorf("[ transferCall ] Can't unmarshal params: %s", err.Error())
 	}
-	switch a := inAmount.(type) {
-	case uint:
-		amount = a
-	case uint64:
-		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
-		}
-		amount = uint(a)
-	case float32:
-		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
-		}
-		amount = uint(a)
-	case float64:
-		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
-		}
-		amount = uint(a)
-	default:
-		return nil, fmt.Errorf("Wrong type for amount %t", inAmount)
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferCall ] Wrong amount: %s", err.Error())
 	}
 	to, err := insolar.NewReferenceFromBase58(toStr)
 	if err != nil {
@@ -186,6 +372,153 @@ func (m *Member) transferCall(params []byte) (interface{}, error) {
 	return nil, w.Transfer(amount, to)
 }
 
+func (m *Member) createAllowanceCall(params []byte) (interface{}, error) {
+	var toStr string
+	var inAmount interface{}
+	var inExpire interface{}
+	if err := signer.UnmarshalParams(params, &toStr, &inAmount, &inExpire); err != nil {
+		return nil, fmt.Errorf("[ createAllowanceCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ createAllowanceCall ] Wrong amount: %s", err.Error())
+	}
+	expire, err := parseUint(inExpire)
+	if err != nil {
+		return nil, fmt.Errorf("[ createAllowanceCall ] Wrong expire: %s", err.Error())
+	}
+	to, err := insolar.NewReferenceFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ createAllowanceCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ createAllowanceCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.CreateAllowance(amount, to, int64(expire))
+}
+
+func (m *Member) listAllowancesCall() (interface{}, error) {
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ listAllowancesCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.GetAllowances()
+}
+
+func (m *Member) claimAllowanceCall(params []byte) (interface{}, error) {
+	var allowanceStr string
+	if err := signer.UnmarshalParams(params, &allowanceStr); err != nil {
+		return nil, fmt.Errorf("[ claimAllowanceCall ] Can't unmarshal params: %s", err.Error())
+	}
+	allowanceRef, err := insolar.NewReferenceFromBase58(allowanceStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ claimAllowanceCall ] Failed to parse allowance reference: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ claimAllowanceCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.Accept(allowanceRef)
+}
+
+func (m *Member) reclaimExpiredCall() (interface{}, error) {
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ reclaimExpiredCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.ReclaimExpired()
+}
+
+func getTokenRegistry(ref insolar.Reference) (*tokenregistry.TokenRegistry, error) {
+	registryRef, err := rootdomain.GetObject(ref).GetTokenRegistryRef()
+	if err != nil {
+		return nil, err
+	}
+	return tokenregistry.GetObject(registryRef), nil
+}
+
+func (m *Member) issueTokenCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var symbol string
+	var name string
+	var inDecimals interface{}
+	var inSupply interface{}
+	if err := signer.UnmarshalParams(params, &symbol, &name, &inDecimals, &inSupply); err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	decimals, err := parseUint(inDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Wrong decimals: %s", err.Error())
+	}
+	supply, err := parseAmount(inSupply)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Wrong supply: %s", err.Error())
+	}
+	registry, err := getTokenRegistry(ref)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Can't get token registry: %s", err.Error())
+	}
+
+	return nil, registry.Issue(symbol, name, decimals, supply)
+}
+
+func (m *Member) listTokensCall(ref insolar.Reference) (interface{}, error) {
+	registry, err := getTokenRegistry(ref)
+	if err != nil {
+		return nil, fmt.Errorf("[ listTokensCall ] Can't get token registry: %s", err.Error())
+	}
+
+	return registry.ListTokens()
+}
+
+func (m *Member) transferAssetCall(params []byte) (interface{}, error) {
+	var asset string
+	var inAmount interface{}
+	var toStr string
+	if err := signer.UnmarshalParams(params, &asset, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseAmount(inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] Wrong amount: %s", err.Error())
+	}
+	to, err := insolar.NewReferenceFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	if m.GetReference() == *to {
+		return nil, fmt.Errorf("[ transferAssetCall ] Recipient must be different from the sender")
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ transferAssetCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.TransferAsset(asset, amount, to)
+}
+
+func (m *Member) getAssetBalanceCall(params []byte) (interface{}, error) {
+	var asset string
+	var member string
+	if err := signer.UnmarshalParams(params, &asset, &member); err != nil {
+		return nil, fmt.Errorf("[ getAssetBalanceCall ] : %s", err.Error())
+	}
+	memberRef, err := insolar.NewReferenceFromBase58(member)
+	if err != nil {
+		return nil, fmt.Errorf("[ getAssetBalanceCall ] : %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(*memberRef)
+	if err != nil {
+		return nil, fmt.Errorf("[ getAssetBalanceCall ] : %s", err.Error())
+	}
+
+	return w.GetAssetBalance(asset)
+}
+
 func (m *Member) dumpUserInfoCall(ref insolar.Reference, params []byte) (interface{}, error) {
 	rootDomain := rootdomain.GetObject(ref)
 	var user string
@@ -242,3 +575,243 @@ func (m *Member) getNodeRefCall(ref insolar.Reference, params []byte) (interface
 
 	return nodeRef, nil
 }
+
+func (m *Member) migrateObjectsCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var prototype string
+	var objects []string
+	if err := signer.UnmarshalParams(params, &prototype, &objects); err != nil {
+		return nil, fmt.Errorf("[ migrateObjectsCall ] Can't unmarshal params: %s", err.Error())
+	}
+
+	rootDomain := rootdomain.GetObject(ref)
+	return rootDomain.MigrateObjects(prototype, objects)
+}
+
+func (m *Member) addKeyCall(params []byte) (interface{}, error) {
+	if !m.isMultisig() {
+		return nil, fmt.Errorf("[ addKeyCall ] Member is not multisig")
+	}
+	var key string
+	if err := signer.UnmarshalParams(params, &key); err != nil {
+		return nil, fmt.Errorf("[ addKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+
+	keys := append(append([]string{}, m.PublicKeys...), key)
+	if err := checkKeys(keys, m.Threshold); err != nil {
+		return nil, fmt.Errorf("[ addKeyCall ] %s", err.Error())
+	}
+	m.setKeys(keys, m.Threshold)
+	return nil, nil
+}
+
+func (m *Member) removeKeyCall(params []byte) (interface{}, error) {
+	if !m.isMultisig() {
+		return nil, fmt.Errorf("[ removeKeyCall ] Member is not multisig")
+	}
+	var key string
+	if err := signer.UnmarshalParams(params, &key); err != nil {
+		return nil, fmt.Errorf("[ removeKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+
+	keys := make([]string, 0, len(m.PublicKeys))
+	for _, k := range m.PublicKeys {
+		if k != key {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == len(m.PublicKeys) {
+		return nil, fmt.Errorf("[ removeKeyCall ] Unknown public key")
+	}
+	if err := checkKeys(keys, m.Threshold); err != nil {
+		return nil, fmt.Errorf("[ removeKeyCall ] %s", err.Error())
+	}
+	m.setKeys(keys, m.Threshold)
+	return nil, nil
+}
+
+func (m *Member) setThresholdCall(params []byte) (interface{}, error) {
+	if !m.isMultisig() {
+		return nil, fmt.Errorf("[ setThresholdCall ] Member is not multisig")
+	}
+	var inThreshold interface{}
+	if err := signer.UnmarshalParams(params, &inThreshold); err != nil {
+		return nil, fmt.Errorf("[ setThresholdCall ] Can't unmarshal params: %s", err.Error())
+	}
+	threshold, err := parseUint(inThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("[ setThresholdCall ] Wrong threshold: %s", err.Error())
+	}
+	if err := checkKeys(m.PublicKeys, threshold); err != nil {
+		return nil, fmt.Errorf("[ setThresholdCall ] %s", err.Error())
+	}
+	m.setKeys(m.PublicKeys, threshold)
+	return nil, nil
+}
+
+// setKeys changes keys and threshold of multisig member, pending approvals are dropped
+// because they were collected under old rules
+func (m *Member) setKeys(keys []string, threshold uint) {
+	m.PublicKeys = keys
+	m.Threshold = threshold
+	m.Approvals = nil
+}
+
+func (m *Member) rotateKeyCall(params []byte) (interface{}, error) {
+	if m.isMultisig() {
+		return nil, fmt.Errorf("[ rotateKeyCall ] Keys of multisig member are changed by AddKey and RemoveKey")
+	}
+	var key string
+	if err := signer.UnmarshalParams(params, &key); err != nil {
+		return nil, fmt.Errorf("[ rotateKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+	if _, err := foundation.ImportPublicKey(key); err != nil {
+		return nil, fmt.Errorf("[ rotateKeyCall ] Invalid public key")
+	}
+
+	m.PublicKey = key
+	// owner still controls the member, so recovery started by someone else is not needed anymore
+	m.Recovery = nil
+	return nil, nil
+}
+
+// setRecoveryKeyCall sets key which can replace member's key after delay. Delay is counted in pulse numbers rather
+// than in pulses: pulse numbers follow unix time, so it's about the number of seconds, while pulse number grows by
+// pulsar's NumberDelta every pulse.
+func (m *Member) setRecoveryKeyCall(params []byte) (interface{}, error) {
+	if m.isMultisig() {
+		return nil, fmt.Errorf("[ setRecoveryKeyCall ] Recovery is not supported for multisig member")
+	}
+	var key string
+	var inDelay interface{}
+	if err := signer.UnmarshalParams(params, &key, &inDelay); err != nil {
+		return nil, fmt.Errorf("[ setRecoveryKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+	delay, err := parseUint(inDelay)
+	if err != nil {
+		return nil, fmt.Errorf("[ setRecoveryKeyCall ] Wrong delay: %s", err.Error())
+	}
+	if delay > maxRecoveryDelay {
+		return nil, fmt.Errorf("[ setRecoveryKeyCall ] Delay is bigger than %d", maxRecoveryDelay)
+	}
+	if key != "" {
+		if _, err := foundation.ImportPublicKey(key); err != nil {
+			return nil, fmt.Errorf("[ setRecoveryKeyCall ] Invalid public key")
+		}
+	}
+
+	m.RecoveryKey = key
+	m.RecoveryDelay = insolar.PulseNumber(delay)
+	m.Recovery = nil
+	return nil, nil
+}
+
+func (m *Member) cancelRecoveryCall() (interface{}, error) {
+	if m.Recovery == nil {
+		return nil, fmt.Errorf("[ cancelRecoveryCall ] No recovery in progress")
+	}
+	m.Recovery = nil
+	return nil, nil
+}
+
+func (m *Member) verifyRecoverySig(method string, params []byte, seed []byte, sign []byte) error {
+	if m.RecoveryKey == "" {
+		return fmt.Errorf("Recovery key is not set")
+	}
+	return m.verifySig(m.RecoveryKey, method, params, seed, sign)
+}
+
+func (m *Member) startRecoveryCall(params []byte, seed []byte, sign []byte) (interface{}, error) {
+	if err := m.verifyRecoverySig("StartRecovery", params, seed, sign); err != nil {
+		return nil, fmt.Errorf("[ startRecoveryCall ] %s", err.Error())
+	}
+	var key string
+	if err := signer.UnmarshalParams(params, &key); err != nil {
+		return nil, fmt.Errorf("[ startRecoveryCall ] Can't unmarshal params: %s", err.Error())
+	}
+	if _, err := foundation.ImportPublicKey(key); err != nil {
+		return nil, fmt.Errorf("[ startRecoveryCall ] Invalid public key")
+	}
+
+	m.Recovery = &Recovery{
+		NewKey: key,
+		Pulse:  m.GetContext().Pulse.PulseNumber,
+	}
+	return uint64(m.Recovery.Pulse) + uint64(m.RecoveryDelay), nil
+}
+
+func (m *Member) finishRecoveryCall(params []byte, seed []byte, sign []byte) (interface{}, error) {
+	if err := m.verifyRecoverySig("FinishRecovery", params, seed, sign); err != nil {
+		return nil, fmt.Errorf("[ finishRecoveryCall ] %s", err.Error())
+	}
+	if m.Recovery == nil {
+		return nil, fmt.Errorf("[ finishRecoveryCall ] No recovery in progress")
+	}
+	now := m.GetContext().Pulse.PulseNumber
+	if now < m.Recovery.Pulse || now-m.Recovery.Pulse < m.RecoveryDelay {
+		availableFrom := uint64(m.Recovery.Pulse) + uint64(m.RecoveryDelay)
+		return nil, fmt.Errorf("[ finishRecoveryCall ] Recovery is available from pulse %d", availableFrom)
+	}
+
+	m.PublicKey = m.Recovery.NewKey
+	m.Recovery = nil
+	return nil, nil
+}
+
+func parseUint(in interface{}) (uint, error) {
+	switch v := in.(type) {
+	case uint:
+		return v, nil
+	case uint64:
+		if v > math.MaxUint32 {
+			return 0, errors.New("value bigger than integer")
+		}
+		return uint(v), nil
+	case int64:
+		if v < 0 || v > math.MaxUint32 {
+			return 0, errors.New("value out of range")
+		}
+		return uint(v), nil
+	case float64:
+		if v < 0 || v > math.MaxUint32 {
+			return 0, errors.New("value out of range")
+		}
+		return uint(v), nil
+	default:
+		return 0, fmt.Errorf("wrong type %T", in)
+	}
+}
+
+// maxFloatAmount is the biggest amount float64 holds exactly, bigger amounts must be passed as decimal strings
+const maxFloatAmount = 1 << 53
+
+// parseAmount accepts amount as decimal integer string of arbitrary size or as integral number. Amounts are counted
+// in the smallest units: of native coin (see safemath.NativeDecimals) or of asset (see its decimals).
+func parseAmount(in interface{}) (string, error) {
+	switch v := in.(type) {
+	case string:
+		amount, err := safemath.ParseAmount(v)
+		if err != nil {
+			return "", err
+		}
+		return amount.String(), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(v)).String(), nil
+	case uint64:
+		return new(big.Int).SetUint64(v).String(), nil
+	case int64:
+		if v < 0 {
+			return "", errors.New("amount can't be negative")
+		}
+		return big.NewInt(v).String(), nil
+	case float64:
+		if v < 0 || v != math.Trunc(v) || math.IsInf(v, 0) {
+			return "", errors.New("amount must be non-negative integer")
+		}
+		if v > maxFloatAmount {
+			return "", errors.New("amount bigger than 2^53 must be passed as decimal string")
+		}
+		return new(big.Int).SetUint64(uint64(v)).String(), nil
+	default:
+		return "", fmt.Errorf("wrong type %T", in)
+	}
+}
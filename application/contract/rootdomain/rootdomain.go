@@ -29,24 +29,43 @@ import (
 // RootDomain is smart contract representing entrance point to system
 type RootDomain struct {
 	foundation.BaseContract
-	RootMember    insolar.Reference
-	NodeDomainRef insolar.Reference
+	RootMember       insolar.Reference
+	NodeDomainRef    insolar.Reference
+	TokenRegistryRef insolar.Reference
 }
 
 var INSATTR_CreateMember_API = true
 
 // CreateMember processes create member request
 func (rd *RootDomain) CreateMember(name string, key string) (string, error) {
-	memberHolder := member.New(name, key)
+	ref, err := rd.createMember(member.New(name, key))
+	if err != nil {
+		return "", fmt.Errorf("[ CreateMember ] %s", err.Error())
+	}
+	return ref, nil
+}
+
+var INSATTR_CreateMultisigMember_API = true
+
+// CreateMultisigMember processes create member request for member with M-of-N keys
+func (rd *RootDomain) CreateMultisigMember(name string, keys []string, threshold uint) (string, error) {
+	ref, err := rd.createMember(member.NewMultisig(name, keys, threshold))
+	if err != nil {
+		return "", fmt.Errorf("[ CreateMultisigMember ] %s", err.Error())
+	}
+	return ref, nil
+}
+
+func (rd *RootDomain) createMember(memberHolder *member.ContractConstructorHolder) (string, error) {
 	m, err := memberHolder.AsChild(rd.GetReference())
 	if err != nil {
-		return "", fmt.Errorf("[ CreateMember ] Can't save as child: %s", err.Error())
+		return "", fmt.Errorf("Can't save as child: %s", err.Error())
 	}
 
-	wHolder := wallet.New(1000 * 1000 * 1000)
+	wHolder := wallet.New("1000000000")
 	_, err = wHolder.AsDelegate(m.GetReference())
 	if err != nil {
-		return "", fmt.Errorf("[ CreateMember ] Can't save as delegate: %s", err.Error())
+		return "", fmt.Errorf("Can't save as delegate: %s", err.Error())
 	}
 
 	return m.GetReference().String(), nil
@@ -131,6 +150,29 @@ func (rd *RootDomain) DumpAllUsers() ([]byte, error) {
 	return resJSON, nil
 }
 
+// MigrateObjects rolls objects of the prototype forward to the latest code of the prototype, only root can call it
+func (rd *RootDomain) MigrateObjects(prototype string, objects []string) (int, error) {
+	if *rd.GetContext().Caller != rd.RootMember {
+		return 0, fmt.Errorf("[ MigrateObjects ] Only root can call this method")
+	}
+	protoRef, err := insolar.NewReferenceFromBase58(prototype)
+	if err != nil {
+		return 0, fmt.Errorf("[ MigrateObjects ] Failed to parse prototype reference: %s", err.Error())
+	}
+
+	for i, object := range objects {
+		ref, err := insolar.NewReferenceFromBase58(object)
+		if err != nil {
+			return i, fmt.Errorf("[ MigrateObjects ] Failed to parse reference %s: %s", object, err.Error())
+		}
+		if err := foundation.MigrateState(*ref, *protoRef); err != nil {
+			return i, fmt.Errorf("[ MigrateObjects ] Can't migrate object %s: %s", object, err.Error())
+		}
+	}
+
+	return len(objects), nil
+}
+
 var INSATTR_Info_API = true
 
 // Info returns information about basic objects
@@ -151,6 +193,11 @@ func (rd *RootDomain) GetNodeDomainRef() (insolar.Reference, error) {
 	return rd.NodeDomainRef, nil
 }
 
+// GetTokenRegistryRef returns reference of TokenRegistry instance
+func (rd *RootDomain) GetTokenRegistryRef() (insolar.Reference, error) {
+	return rd.TokenRegistryRef, nil
+}
+
 // NewRootDomain creates new RootDomain
 func NewRootDomain() (*RootDomain, error) {
 	return &RootDomain{}, nil
@@ -0,0 +1,141 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tokenregistry
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/insolar/insolar/application/contract/wallet/safemath"
+	"github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+// maxDecimals limits precision of assets
+const maxDecimals = 18
+
+// maxSymbolLength limits length of asset ID
+const maxSymbolLength = 12
+
+// Token holds description of issued asset, supply is kept as decimal string
+type Token struct {
+	Symbol   string
+	Name     string
+	Decimals uint
+	Supply   string
+	Issuer   insolar.Reference
+}
+
+// TokenRegistry is smart contract which issues assets, it lives under root domain
+type TokenRegistry struct {
+	foundation.BaseContract
+	Tokens []Token
+}
+
+// New creates empty token registry
+func New() (*TokenRegistry, error) {
+	return &TokenRegistry{}, nil
+}
+
+func checkSymbol(symbol string) error {
+	if len(symbol) == 0 || len(symbol) > maxSymbolLength {
+		return fmt.Errorf("Symbol length must be between 1 and %d", maxSymbolLength)
+	}
+	for _, c := range symbol {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return fmt.Errorf("Symbol must contain only capital latin letters and digits")
+		}
+	}
+	return nil
+}
+
+func (tr *TokenRegistry) findToken(symbol string) (Token, bool) {
+	for _, t := range tr.Tokens {
+		if t.Symbol == symbol {
+			return t, true
+		}
+	}
+	return Token{}, false
+}
+
+// Issue registers new asset and credits whole supply to wallet of calling member
+func (tr *TokenRegistry) Issue(symbol string, name string, decimals uint, supply string) error {
+	if err := checkSymbol(symbol); err != nil {
+		return fmt.Errorf("[ Issue ] %s", err.Error())
+	}
+	if decimals > maxDecimals {
+		return fmt.Errorf("[ Issue ] Decimals must not be bigger than %d", maxDecimals)
+	}
+	amount, err := safemath.ParseAmount(supply)
+	if err != nil {
+		return fmt.Errorf("[ Issue ] Wrong supply: %s", err.Error())
+	}
+	if amount.Sign() == 0 {
+		return fmt.Errorf("[ Issue ] Supply must be positive")
+	}
+	supply = amount.String()
+	if _, ok := tr.findToken(symbol); ok {
+		return fmt.Errorf("[ Issue ] Asset %s already exists", symbol)
+	}
+
+	issuer := *tr.GetContext().Caller
+	w, err := wallet.GetImplementationFrom(issuer)
+	if err != nil {
+		return fmt.Errorf("[ Issue ] Can't get wallet of issuer: %s", err.Error())
+	}
+	if err := w.Mint(symbol, supply); err != nil {
+		return fmt.Errorf("[ Issue ] Can't mint supply: %s", err.Error())
+	}
+
+	tr.Tokens = append(tr.Tokens, Token{
+		Symbol:   symbol,
+		Name:     name,
+		Decimals: decimals,
+		Supply:   supply,
+		Issuer:   issuer,
+	})
+	return nil
+}
+
+// GetToken returns description of asset
+func (tr *TokenRegistry) GetToken(symbol string) (Token, error) {
+	t, ok := tr.findToken(symbol)
+	if !ok {
+		return Token{}, fmt.Errorf("[ GetToken ] Unknown asset %s", symbol)
+	}
+	return t, nil
+}
+
+// ListTokens returns json with all issued assets
+func (tr *TokenRegistry) ListTokens() ([]byte, error) {
+	list := []map[string]interface{}{}
+	for _, t := range tr.Tokens {
+		list = append(list, map[string]interface{}{
+			"symbol":   t.Symbol,
+			"name":     t.Name,
+			"decimals": t.Decimals,
+			"supply":   t.Supply,
+			"issuer":   t.Issuer.String(),
+		})
+	}
+	res, err := json.Marshal(list)
+	if err != nil {
+		return nil, fmt.Errorf("[ ListTokens ] Can't marshal: %s", err.Error())
+	}
+	return res, nil
+}
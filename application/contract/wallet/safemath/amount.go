@@ -0,0 +1,83 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package safemath
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// NativeDecimals is number of decimal places of native coin. Native balances and amounts are counted in its
+// smallest units like amounts of assets are counted with their decimals, one coin is 10^NativeDecimals units.
+const NativeDecimals = 9
+
+// NativeUnits converts whole coins to the smallest units of native coin.
+func NativeUnits(coins uint64) string {
+	v := new(big.Int).SetUint64(coins)
+	return v.Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(NativeDecimals), nil)).String()
+}
+
+// ParseAmount parses non-negative decimal integer of arbitrary size.
+// Amounts are integers only, they are counted in the smallest units of asset,
+// so fractional amounts like "1.5" are rejected.
+func ParseAmount(amount string) (*big.Int, error) {
+	if strings.HasPrefix(amount, "-") {
+		return nil, errors.New("amount can't be negative")
+	}
+	// big.Int accepts sign and underscores, amount must be plain digits
+	for _, c := range amount {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("can't parse amount %q", amount)
+		}
+	}
+	v, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("can't parse amount %q", amount)
+	}
+	return v, nil
+}
+
+// AddAmounts adds two decimal amounts.
+func AddAmounts(a string, b string) (string, error) {
+	x, err := ParseAmount(a)
+	if err != nil {
+		return "", err
+	}
+	y, err := ParseAmount(b)
+	if err != nil {
+		return "", err
+	}
+	return x.Add(x, y).String(), nil
+}
+
+// SubAmounts subtracts two decimal amounts, reverts if subtrahend is greater than minuend.
+func SubAmounts(a string, b string) (string, error) {
+	x, err := ParseAmount(a)
+	if err != nil {
+		return "", err
+	}
+	y, err := ParseAmount(b)
+	if err != nil {
+		return "", err
+	}
+	if x.Cmp(y) < 0 {
+		return "", errors.New("subtrahend must be smaller than minuend")
+	}
+	return x.Sub(x, y).String(), nil
+}
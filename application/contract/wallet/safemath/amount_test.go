@@ -0,0 +1,104 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package safemath
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	v, err := ParseAmount("123")
+	require.NoError(t, err)
+	require.Equal(t, "123", v.String())
+
+	v, err = ParseAmount("0")
+	require.NoError(t, err)
+	require.Equal(t, "0", v.String())
+
+	// leading zeros are dropped
+	v, err = ParseAmount("007")
+	require.NoError(t, err)
+	require.Equal(t, "7", v.String())
+
+	// amounts aren't limited by size of machine word
+	v, err = ParseAmount("340282366920938463463374607431768211456")
+	require.NoError(t, err)
+	require.Equal(t, "340282366920938463463374607431768211456", v.String())
+}
+
+func TestParseAmount_Negative(t *testing.T) {
+	for _, amount := range []string{"-1", "-0", "-007"} {
+		_, err := ParseAmount(amount)
+		require.EqualError(t, err, "amount can't be negative", amount)
+	}
+}
+
+func TestParseAmount_Malformed(t *testing.T) {
+	for _, amount := range []string{"", "abc", "1.5", "1e3", "0x10", "+1", " 1", "1 ", "1_000", "１"} {
+		_, err := ParseAmount(amount)
+		require.Error(t, err, amount)
+		require.Contains(t, err.Error(), "can't parse amount", amount)
+	}
+}
+
+func TestNativeUnits(t *testing.T) {
+	require.Equal(t, "0", NativeUnits(0))
+	require.Equal(t, "500000000000", NativeUnits(500))
+	require.Equal(t, "18446744073709551615000000000", NativeUnits(math.MaxUint64))
+}
+
+func TestAddAmounts(t *testing.T) {
+	sum, err := AddAmounts("1", "2")
+	require.NoError(t, err)
+	require.Equal(t, "3", sum)
+
+	sum, err = AddAmounts("007", "0")
+	require.NoError(t, err)
+	require.Equal(t, "7", sum)
+
+	// sum exceeding uint64 doesn't overflow
+	sum, err = AddAmounts("18446744073709551615", "1")
+	require.NoError(t, err)
+	require.Equal(t, "18446744073709551616", sum)
+
+	_, err = AddAmounts("-1", "2")
+	require.Error(t, err)
+	_, err = AddAmounts("1", "2.5")
+	require.Error(t, err)
+}
+
+func TestSubAmounts(t *testing.T) {
+	diff, err := SubAmounts("3", "2")
+	require.NoError(t, err)
+	require.Equal(t, "1", diff)
+
+	diff, err = SubAmounts("18446744073709551616", "18446744073709551616")
+	require.NoError(t, err)
+	require.Equal(t, "0", diff)
+
+	// result can't go below zero
+	_, err = SubAmounts("2", "3")
+	require.EqualError(t, err, "subtrahend must be smaller than minuend")
+
+	_, err = SubAmounts("3", "-2")
+	require.Error(t, err)
+	_, err = SubAmounts("abc", "1")
+	require.Error(t, err)
+}
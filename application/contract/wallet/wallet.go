@@ -17,23 +17,67 @@
 package wallet
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/insolar/insolar/application/contract/wallet/safemath"
 	"github.com/insolar/insolar/application/proxy/allowance"
+	"github.com/insolar/insolar/application/proxy/tokenregistry"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 )
 
-// Wallet - basic wallet contract
+// transferExpire is number of seconds recipient has to accept transfer, then sender can reclaim it
+const transferExpire = 10
+
+// AssetBalance holds balance of asset issued by token registry
+type AssetBalance struct {
+	Asset   string
+	Balance string
+}
+
+// Wallet - basic wallet contract, balances are decimal integer strings of arbitrary size since version 1,
+// native balance is counted in the smallest units of coin, see safemath.NativeDecimals
+//ins:version 1
 type Wallet struct {
 	foundation.BaseContract
-	Balance uint
+	Balance  string `codec:"DecimalBalance"`
+	Incoming []insolar.Reference
+	Assets   []AssetBalance `codec:"DecimalAssets"`
+}
+
+// Migrate converts uint balances saved by previous version of code to decimal strings. Native balance was counted
+// in whole coins, it's converted to the smallest units. Assets were already counted with their decimals.
+func Migrate(version uint, state []byte) (*Wallet, error) {
+	old := struct {
+		Balance  uint
+		Incoming []insolar.Reference
+		Assets   []struct {
+			Asset   string
+			Balance uint
+		}
+	}{}
+	if err := insolar.Deserialize(state, &old); err != nil {
+		return nil, fmt.Errorf("[ Migrate ] Can't deserialize state of version %d: %s", version, err.Error())
+	}
+
+	w := &Wallet{
+		Balance:  safemath.NativeUnits(uint64(old.Balance)),
+		Incoming: old.Incoming,
+	}
+	for _, a := range old.Assets {
+		w.Assets = append(w.Assets, AssetBalance{
+			Asset:   a.Asset,
+			Balance: strconv.FormatUint(uint64(a.Balance), 10),
+		})
+	}
+	return w, nil
 }
 
 // Transfer transfers money to given wallet
-func (w *Wallet) Transfer(amount uint, to *insolar.Reference) error {
+func (w *Wallet) Transfer(amount string, to *insolar.Reference) error {
 
 	toWallet, err := wallet.GetImplementationFrom(*to)
 	if err != nil {
@@ -42,12 +86,12 @@ func (w *Wallet) Transfer(amount uint, to *insolar.Reference) error {
 
 	toWalletRef := toWallet.GetReference()
 
-	newBalance, err := safemath.Sub(w.Balance, amount)
+	newBalance, err := safemath.SubAmounts(w.Balance, amount)
 	if err != nil {
 		return fmt.Errorf("[ Transfer ] Not enough balance for transfer: %s", err.Error())
 	}
 
-	ah := allowance.New(&toWalletRef, amount, w.GetContext().Time.Unix()+10)
+	ah := allowance.New(&toWalletRef, amount, w.GetContext().Time.Unix()+transferExpire)
 	a, err := ah.AsChild(w.GetReference())
 	if err != nil {
 		return fmt.Errorf("[ Transfer ] Can't save as child: %s", err.Error())
@@ -61,52 +105,291 @@ func (w *Wallet) Transfer(amount uint, to *insolar.Reference) error {
 	return err
 }
 
-// Accept transforms allowance to balance
+// CreateAllowance locks amount in allowance for given wallet until it is claimed by recipient or expires.
+// Unlike Transfer, recipient is not asked to accept allowance.
+func (w *Wallet) CreateAllowance(amount string, to *insolar.Reference, expire int64) (string, error) {
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return "", fmt.Errorf("[ CreateAllowance ] Can't get implementation: %s", err.Error())
+	}
+
+	toWalletRef := toWallet.GetReference()
+	if toWalletRef == w.GetReference() {
+		return "", fmt.Errorf("[ CreateAllowance ] Recipient must be different from the sender")
+	}
+
+	newBalance, err := safemath.SubAmounts(w.Balance, amount)
+	if err != nil {
+		return "", fmt.Errorf("[ CreateAllowance ] Not enough balance: %s", err.Error())
+	}
+
+	ah := allowance.New(&toWalletRef, amount, w.GetContext().Time.Unix()+expire)
+	a, err := ah.AsChild(w.GetReference())
+	if err != nil {
+		return "", fmt.Errorf("[ CreateAllowance ] Can't save as child: %s", err.Error())
+	}
+
+	w.Balance = newBalance
+
+	r := a.GetReference()
+	err = toWallet.AddIncomingNoWait(&r)
+	if err != nil {
+		return "", fmt.Errorf("[ CreateAllowance ] Can't notify recipient: %s", err.Error())
+	}
+	return r.String(), nil
+}
+
+// AddIncoming remembers allowance made for this wallet, so it can be listed by recipient
+func (w *Wallet) AddIncoming(aRef *insolar.Reference) error {
+	info, err := allowance.GetObject(*aRef).GetInfo()
+	if err != nil {
+		return fmt.Errorf("[ AddIncoming ] Can't get allowance info: %s", err.Error())
+	}
+	if info.To != w.GetReference() {
+		return fmt.Errorf("[ AddIncoming ] Allowance is made for another wallet")
+	}
+	w.Incoming = append(w.Incoming, *aRef)
+	return nil
+}
+
+// Accept transforms allowance to balance of its asset
 func (w *Wallet) Accept(aRef *insolar.Reference) error {
-	b, err := allowance.GetObject(*aRef).TakeAmount()
+	a := allowance.GetObject(*aRef)
+	info, err := a.GetInfo()
 	if err != nil {
-		return fmt.Errorf("[ Accept ] Can't take amount: %s", err.Error())
+		return fmt.Errorf("[ Accept ] Can't get allowance info: %s", err.Error())
 	}
-	w.Balance, err = safemath.Add(w.Balance, b)
+	b, err := a.TakeAmount()
 	if err != nil {
+		return fmt.Errorf("[ Accept ] Can't take amount: %s", err.Error())
+	}
+	if err := w.credit(info.Asset, b); err != nil {
 		return fmt.Errorf("[ Accept ] Couldn't add amount to balance: %s", err.Error())
 	}
+
+	for i, ref := range w.Incoming {
+		if ref == *aRef {
+			w.Incoming = append(w.Incoming[:i], w.Incoming[i+1:]...)
+			break
+		}
+	}
 	return nil
 }
 
-// GetBalance gets total balance
-func (w *Wallet) GetBalance() (uint, error) {
+// GetAllowances returns json with incoming and outgoing allowances which are not claimed or reclaimed yet
+func (w *Wallet) GetAllowances() ([]byte, error) {
+	outgoing, err := w.outgoingAllowances()
+	if err != nil {
+		return nil, fmt.Errorf("[ GetAllowances ] %s", err.Error())
+	}
+
+	// forget allowances which were reclaimed by sender
+	incoming, live := allowancesInfo(w.Incoming)
+	w.Incoming = live
+	outgoingInfo, _ := allowancesInfo(outgoing)
+
+	res, err := json.Marshal(map[string]interface{}{
+		"incoming": incoming,
+		"outgoing": outgoingInfo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ GetAllowances ] Can't marshal: %s", err.Error())
+	}
+	return res, nil
+}
+
+func (w *Wallet) outgoingAllowances() ([]insolar.Reference, error) {
 	iterator, err := w.NewChildrenTypedIterator(allowance.GetPrototype())
 	if err != nil {
-		return 0, fmt.Errorf("[ GetBalance ] Can't get children: %s", err.Error())
+		return nil, fmt.Errorf("Can't get children: %s", err.Error())
 	}
 
+	var refs []insolar.Reference
 	for iterator.HasNext() {
 		cref, err := iterator.Next()
 		if err != nil {
-			return 0, fmt.Errorf("[ GetBalance ] Can't get next child: %s", err.Error())
+			return nil, fmt.Errorf("Can't get next child: %s", err.Error())
 		}
-
 		if !cref.IsEmpty() {
-			a := allowance.GetObject(cref)
-			balance, err := a.GetExpiredBalance()
+			refs = append(refs, cref)
+		}
+	}
+	return refs, nil
+}
 
-			if err != nil {
-				balance = 0
-				//return 0, fmt.Errorf("[ GetBalance ] Can't get balance for owner: %s", err.Error())
-			}
+// allowancesInfo returns details of allowances which still exist together with their references
+func allowancesInfo(refs []insolar.Reference) ([]map[string]interface{}, []insolar.Reference) {
+	list := []map[string]interface{}{}
+	var live []insolar.Reference
+	for _, ref := range refs {
+		info, err := allowance.GetObject(ref).GetInfo()
+		if err != nil {
+			// allowance is already claimed or reclaimed
+			continue
+		}
+		item := map[string]interface{}{
+			"reference":  ref.String(),
+			"from":       info.From.String(),
+			"to":         info.To.String(),
+			"amount":     info.Amount,
+			"expireTime": info.ExpireTime,
+			"expired":    info.Expired,
+		}
+		if info.Asset != "" {
+			item["asset"] = info.Asset
+		}
+		list = append(list, item)
+		live = append(live, ref)
+	}
+	return list, live
+}
 
-			w.Balance, err = safemath.Add(w.Balance, balance)
-			if err != nil {
-				return 0, fmt.Errorf("[ GetBalance ] Couldn't add expired allowance to balance: %s", err.Error())
+// ReclaimExpired returns amounts of expired outgoing allowances to balances of their assets, returned value is
+// the amount reclaimed to native balance
+func (w *Wallet) ReclaimExpired() (string, error) {
+	outgoing, err := w.outgoingAllowances()
+	if err != nil {
+		return "", fmt.Errorf("[ ReclaimExpired ] %s", err.Error())
+	}
+
+	reclaimed := "0"
+	for _, ref := range outgoing {
+		a := allowance.GetObject(ref)
+		info, err := a.GetInfo()
+		if err != nil || !info.Expired {
+			continue
+		}
+		balance, err := a.GetExpiredBalance()
+		if err != nil {
+			continue
+		}
+
+		if info.Asset != "" {
+			if err := w.addAsset(info.Asset, balance); err != nil {
+				return "", fmt.Errorf("[ ReclaimExpired ] Couldn't add expired allowance to balance: %s", err.Error())
 			}
+			continue
+		}
+		reclaimed, err = safemath.AddAmounts(reclaimed, balance)
+		if err != nil {
+			return "", fmt.Errorf("[ ReclaimExpired ] Couldn't add expired allowance to balance: %s", err.Error())
+		}
+	}
+
+	balance, err := safemath.AddAmounts(w.Balance, reclaimed)
+	if err != nil {
+		return "", fmt.Errorf("[ ReclaimExpired ] Couldn't add expired allowance to balance: %s", err.Error())
+	}
+	w.Balance = balance
+	return reclaimed, nil
+}
+
+func (w *Wallet) findAsset(asset string) *AssetBalance {
+	for i := range w.Assets {
+		if w.Assets[i].Asset == asset {
+			return &w.Assets[i]
 		}
 	}
+	return nil
+}
+
+// credit adds amount to balance of asset, empty asset is native balance
+func (w *Wallet) credit(asset string, amount string) error {
+	if asset != "" {
+		return w.addAsset(asset, amount)
+	}
+	balance, err := safemath.AddAmounts(w.Balance, amount)
+	if err != nil {
+		return err
+	}
+	w.Balance = balance
+	return nil
+}
+
+func (w *Wallet) addAsset(asset string, amount string) error {
+	b := w.findAsset(asset)
+	if b == nil {
+		w.Assets = append(w.Assets, AssetBalance{Asset: asset, Balance: "0"})
+		b = &w.Assets[len(w.Assets)-1]
+	}
+	balance, err := safemath.AddAmounts(b.Balance, amount)
+	if err != nil {
+		return err
+	}
+	b.Balance = balance
+	return nil
+}
+
+// Mint credits supply of newly issued asset, only token registry can mint
+func (w *Wallet) Mint(asset string, amount string) error {
+	if !tokenregistry.PrototypeReference.Equal(*w.GetContext().CallerPrototype) {
+		return fmt.Errorf("[ Mint ] Only token registry can mint assets")
+	}
+	if err := w.addAsset(asset, amount); err != nil {
+		return fmt.Errorf("[ Mint ] Couldn't add amount to balance: %s", err.Error())
+	}
+	return nil
+}
+
+// TransferAsset transfers amount of given asset to wallet of given member. Amount is locked in allowance which
+// recipient accepts, sender reclaims it if recipient doesn't accept it in time.
+func (w *Wallet) TransferAsset(asset string, amount string, to *insolar.Reference) error {
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return fmt.Errorf("[ TransferAsset ] Can't get implementation: %s", err.Error())
+	}
+	toWalletRef := toWallet.GetReference()
+	if toWalletRef == w.GetReference() {
+		return fmt.Errorf("[ TransferAsset ] Recipient must be different from the sender")
+	}
+
+	b := w.findAsset(asset)
+	if b == nil {
+		return fmt.Errorf("[ TransferAsset ] No %s on balance", asset)
+	}
+	newBalance, err := safemath.SubAmounts(b.Balance, amount)
+	if err != nil {
+		return fmt.Errorf("[ TransferAsset ] Not enough balance for transfer: %s", err.Error())
+	}
+
+	ah := allowance.NewAsset(&toWalletRef, asset, amount, w.GetContext().Time.Unix()+transferExpire)
+	a, err := ah.AsChild(w.GetReference())
+	if err != nil {
+		return fmt.Errorf("[ TransferAsset ] Can't save as child: %s", err.Error())
+	}
+
+	// Changing balance only after allowance was successfully create
+	b.Balance = newBalance
+
+	// recipient isn't waited for, so wallets transferring to each other don't lock
+	r := a.GetReference()
+	return toWallet.AcceptNoWait(&r)
+}
+
+// GetAssetBalance returns balance of given asset
+func (w *Wallet) GetAssetBalance(asset string) (string, error) {
+	if _, err := w.ReclaimExpired(); err != nil {
+		return "", fmt.Errorf("[ GetAssetBalance ] %s", err.Error())
+	}
+	if b := w.findAsset(asset); b != nil {
+		return b.Balance, nil
+	}
+	return "0", nil
+}
+
+// GetBalance gets total balance
+func (w *Wallet) GetBalance() (string, error) {
+	if _, err := w.ReclaimExpired(); err != nil {
+		return "", fmt.Errorf("[ GetBalance ] %s", err.Error())
+	}
 	return w.Balance, nil
 }
 
 // New creates new allowance
-func New(balance uint) (*Wallet, error) {
+func New(balance string) (*Wallet, error) {
+	if _, err := safemath.ParseAmount(balance); err != nil {
+		return nil, fmt.Errorf("[ New Wallet ] Wrong balance: %s", err.Error())
+	}
 	return &Wallet{
 		Balance: balance,
 	}, nil
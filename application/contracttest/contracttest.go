@@ -0,0 +1,481 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package contracttest provides harness for unit tests of contracts, it builds contracts with
+// generated wrappers and proxies and runs them in goplugin executor against in-memory ledger.
+//
+// Usage:
+//    h := contracttest.New(t)
+//    defer h.Stop()
+//
+//    err := h.BuildApplication("member", "wallet", "allowance", "rootdomain")
+//    root, err := h.Genesis()
+//    alice, err := h.CreateMember(root, "alice")
+//    _, err = alice.Call("Transfer", 100, bob.Ref.String())
+package contracttest
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/application/contract/member"
+	"github.com/insolar/insolar/application/contract/member/signer"
+	"github.com/insolar/insolar/application/contract/rootdomain"
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/contractrequester"
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/delegationtoken"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/artifactmanager"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/logicrunner"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/goplugintestutils"
+	"github.com/insolar/insolar/logicrunner/pulsemanager"
+	"github.com/insolar/insolar/messagebus"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/nodekeeper"
+	"github.com/insolar/insolar/testutils/testmessagebus"
+)
+
+// Harness runs contracts for tests
+type Harness struct {
+	t   *testing.T
+	ctx context.Context
+
+	LogicRunner     *logicrunner.LogicRunner
+	ArtifactManager artifacts.Client
+	PulseManager    insolar.PulseManager
+	Builder         *goplugintestutils.ContractsBuilder
+
+	messageHandler *artifactmanager.MessageHandler
+	index          *object.InMemoryIndex
+	rootDomain     *insolar.Reference
+	stop           func()
+}
+
+// New builds executor and preprocessor of contracts, starts logicrunner with in-memory ledger
+// and returns harness, test fails if something goes wrong
+func New(t *testing.T) *Harness {
+	ctx := inslogger.TestContext(t)
+
+	insgorund, insgocc, err := goplugintestutils.Build()
+	require.NoError(t, err, "can't build executor and preprocessor")
+
+	lrSock := filepath.Join(os.TempDir(), testutils.RandomString()+".sock")
+	rundSock := filepath.Join(os.TempDir(), testutils.RandomString()+".sock")
+
+	rundCleaner, err := goplugintestutils.StartInsgorund(insgorund, "unix", rundSock, "unix", lrSock, true)
+	require.NoError(t, err, "can't start executor")
+
+	lr, err := logicrunner.NewLogicRunner(&configuration.LogicRunner{
+		RPCListen:   lrSock,
+		RPCProtocol: "unix",
+		GoPlugin: &configuration.GoPlugin{
+			RunnerListen:   rundSock,
+			RunnerProtocol: "unix",
+		},
+	})
+	require.NoError(t, err, "can't create logicrunner")
+
+	cryptoMock := testutils.NewCryptographyServiceMock(t)
+	cryptoMock.SignFunc = func(p []byte) (*insolar.Signature, error) {
+		signature := insolar.SignatureFromBytes(nil)
+		return &signature, nil
+	}
+	cryptoMock.GetPublicKeyFunc = func() (crypto.PublicKey, error) {
+		return nil, nil
+	}
+
+	nk := nodekeeper.GetTestNodekeeper(cryptoMock)
+	mb := testmessagebus.NewTestMessageBus(t)
+	nw := testutils.GetTestNetwork(t)
+
+	l, messageHandler, index := artifacts.TmpLedger(
+		t,
+		"",
+		insolar.Components{
+			LogicRunner: lr,
+			NodeNetwork: nk,
+			MessageBus:  mb,
+			Network:     nw,
+		},
+	)
+
+	cr, err := contractrequester.New()
+	require.NoError(t, err)
+
+	cm := &component.Manager{}
+	cm.Register(platformpolicy.NewPlatformCryptographyScheme())
+	am := l.GetArtifactManager()
+	cm.Register(am, l.GetPulseManager(), l.GetJetCoordinator())
+	cm.Inject(
+		l.PulseManager.(*pulsemanager.PulseManager).PulseAccessor,
+		nk,
+		recentstorage.NewProviderMock(t),
+		l,
+		lr,
+		nw,
+		mb,
+		cr,
+		delegationtoken.NewDelegationTokenFactory(),
+		messagebus.NewParcelFactory(),
+		testutils.NewTerminationHandlerMock(t),
+		cryptoMock,
+	)
+	require.NoError(t, cm.Init(ctx), "can't init components")
+	require.NoError(t, cm.Start(ctx), "can't start components")
+
+	// messages are handled by logicrunner directly, there are no other nodes
+	mb.ReRegister(insolar.TypeCallMethod, lr.FlowDispatcher.WrapBusHandle)
+	mb.ReRegister(insolar.TypeValidateCaseBind, lr.HandleValidateCaseBindMessage)
+	mb.ReRegister(insolar.TypeValidationResults, lr.HandleValidationResultsMessage)
+	mb.ReRegister(insolar.TypeExecutorResults, lr.FlowDispatcher.WrapBusHandle)
+
+	cb := goplugintestutils.NewContractBuilder(am, insgocc)
+	require.NotNil(t, cb, "can't create contracts builder")
+
+	h := &Harness{
+		t:               t,
+		ctx:             ctx,
+		LogicRunner:     lr,
+		ArtifactManager: am,
+		PulseManager:    l.GetPulseManager(),
+		Builder:         cb,
+		messageHandler:  messageHandler,
+		index:           index,
+		stop: func() {
+			cb.Clean()
+			// logicrunner and ledger components are stopped by component manager
+			cm.Stop(ctx) // nolint: errcheck
+			rundCleaner()
+		},
+	}
+	require.NoError(t, h.NextPulse(), "can't set pulse")
+
+	return h
+}
+
+// Stop stops components of logicrunner and ledger and executor, removes built contracts
+func (h *Harness) Stop() {
+	h.stop()
+}
+
+// NextPulse switches logicrunner and ledger to the next pulse
+func (h *Harness) NextPulse() error {
+	pulseAccessor := h.PulseManager.(*pulsemanager.PulseManager).PulseAccessor
+	current, err := pulseAccessor.Latest(h.ctx)
+	if err != nil {
+		return errors.Wrap(err, "[ NextPulse ] Can't get current pulse")
+	}
+
+	newPulse := insolar.Pulse{PulseNumber: current.PulseNumber + 1, Entropy: insolar.Entropy{}}
+	err = h.PulseManager.Set(h.ctx, newPulse, true)
+	if err != nil {
+		return errors.Wrap(err, "[ NextPulse ] Can't set pulse")
+	}
+	h.messageHandler.OnPulse(h.ctx, newPulse)
+
+	// ledger is the only one, so it passes indexes of objects to itself
+	var hotIndexes []message.HotIndex
+	rootJetID := *insolar.NewJetID(0, nil)
+	for _, meta := range h.index.ForPNAndJet(h.ctx, current.PulseNumber, rootJetID) {
+		encoded, err := meta.Lifeline.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "[ NextPulse ] Can't marshal lifeline")
+		}
+		hotIndexes = append(hotIndexes, message.HotIndex{
+			LastUsed: meta.LifelineLastUsed,
+			ObjID:    meta.ObjID,
+			Index:    encoded,
+		})
+	}
+
+	_, err = h.LogicRunner.MessageBus.Send(
+		h.ctx,
+		&message.HotData{
+			Jet:         *insolar.NewReference(insolar.DomainID, insolar.ID(rootJetID)),
+			Drop:        drop.Drop{Pulse: current.PulseNumber, JetID: rootJetID},
+			HotIndexes:  hotIndexes,
+			PulseNumber: newPulse.PulseNumber,
+		}, nil,
+	)
+	return errors.Wrap(err, "[ NextPulse ] Can't send hot data")
+}
+
+// Build builds contracts from source code by names
+func (h *Harness) Build(contracts map[string]string) error {
+	return h.Builder.Build(contracts)
+}
+
+// BuildApplication builds contracts from application/contract directory by names
+func (h *Harness) BuildApplication(names ...string) error {
+	p, err := build.Default.Import("github.com/insolar/insolar", "", build.FindOnly)
+	if err != nil {
+		return errors.Wrap(err, "[ BuildApplication ] Can't find project directory")
+	}
+
+	contracts := make(map[string]string)
+	for _, name := range names {
+		code, err := ioutil.ReadFile(filepath.Join(p.Dir, "application", "contract", name, name+".go"))
+		if err != nil {
+			return errors.Wrapf(err, "[ BuildApplication ] Can't read contract %q", name)
+		}
+		contracts[name] = string(code)
+	}
+	return h.Build(contracts)
+}
+
+// Prototype returns reference to prototype of built contract
+func (h *Harness) Prototype(name string) insolar.Reference {
+	ref, ok := h.Builder.Prototypes[name]
+	require.True(h.t, ok, "contract %q isn't built", name)
+	return *ref
+}
+
+// Activate creates object of built contract with memory, it's used to create objects
+// bypassing constructors like genesis does
+func (h *Harness) Activate(name string, parent insolar.Reference, memory interface{}) (*insolar.Reference, error) {
+	nonce := testutils.RandomRef()
+	id, err := h.ArtifactManager.RegisterRequest(
+		h.ctx,
+		record.Request{CallType: record.CTSaveAsChild, Prototype: &nonce},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Activate ] Can't register request")
+	}
+	ref := insolar.NewReference(insolar.ID{}, *id)
+
+	data, err := insolar.Serialize(memory)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Activate ] Can't serialize memory")
+	}
+
+	_, err = h.ArtifactManager.ActivateObject(h.ctx, insolar.Reference{}, *ref, parent, h.Prototype(name), false, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Activate ] Can't activate object")
+	}
+	return ref, nil
+}
+
+// CallMethod calls method of object of built contract by name and returns results of the method,
+// last result is error returned by contract
+func (h *Harness) CallMethod(object insolar.Reference, name string, method string, args ...interface{}) ([]interface{}, error) {
+	result, err := h.call(object, name, method, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CallMethod ]")
+	}
+
+	var res []interface{}
+	err = insolar.Deserialize(result, &res)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CallMethod ] Can't deserialize result")
+	}
+	return res, nil
+}
+
+func (h *Harness) call(object insolar.Reference, name string, method string, args ...interface{}) ([]byte, error) {
+	argsSerialized, err := insolar.Serialize(args)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't serialize arguments")
+	}
+
+	prototype := h.Prototype(name)
+	msg := &message.CallMethod{
+		Request: record.Request{
+			Caller:    testutils.RandomRef(),
+			Object:    &object,
+			Prototype: &prototype,
+			Method:    method,
+			Arguments: argsSerialized,
+		},
+	}
+
+	ctx := inslogger.ContextWithTrace(h.ctx, utils.RandTraceID())
+	rep, err := h.LogicRunner.ContractRequester.CallMethod(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "Call failed")
+	}
+	return rep.(*reply.CallMethod).Result, nil
+}
+
+// State deserializes memory of object into `to`
+func (h *Harness) State(object insolar.Reference, to interface{}) error {
+	desc, err := h.ArtifactManager.GetObject(h.ctx, object)
+	if err != nil {
+		return errors.Wrap(err, "[ State ] Can't get object")
+	}
+	return insolar.Deserialize(desc.Memory(), to)
+}
+
+// Children returns children of object
+func (h *Harness) Children(object insolar.Reference) ([]insolar.Reference, error) {
+	iterator, err := h.ArtifactManager.GetChildren(h.ctx, object, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Children ] Can't get children")
+	}
+
+	var res []insolar.Reference
+	for iterator.HasNext() {
+		ref, err := iterator.Next()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Children ] Can't get next child")
+		}
+		res = append(res, *ref)
+	}
+	return res, nil
+}
+
+// Delegate returns delegate of object of built contract by name
+func (h *Harness) Delegate(object insolar.Reference, name string) (*insolar.Reference, error) {
+	return h.ArtifactManager.GetDelegate(h.ctx, object, h.Prototype(name))
+}
+
+// Member is member contract with keys to sign its calls
+type Member struct {
+	Ref insolar.Reference
+
+	h  *Harness
+	cs insolar.CryptographyService
+}
+
+// Genesis creates root domain and root member, contracts "member" and "rootdomain" must be built
+func (h *Harness) Genesis() (*Member, error) {
+	rootDomainRef, err := h.Activate("rootdomain", insolar.GenesisRecord.Ref(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't create root domain")
+	}
+
+	kp := platformpolicy.NewKeyProcessor()
+	rootKey, err := kp.GeneratePrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't generate key")
+	}
+	rootPubKey, err := kp.ExportPublicKeyPEM(kp.ExtractPublicKey(rootKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't export key")
+	}
+
+	m, err := member.New("root", string(rootPubKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't create root member")
+	}
+	rootMemberRef, err := h.Activate("member", *rootDomainRef, m)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't create root member")
+	}
+
+	rootDomainDesc, err := h.ArtifactManager.GetObject(h.ctx, *rootDomainRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't get root domain")
+	}
+	memory, err := insolar.Serialize(rootdomain.RootDomain{RootMember: *rootMemberRef})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't serialize root domain")
+	}
+	_, err = h.ArtifactManager.UpdateObject(h.ctx, insolar.Reference{}, insolar.Reference{}, rootDomainDesc, memory)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Genesis ] Can't update root domain")
+	}
+
+	h.rootDomain = rootDomainRef
+	return &Member{Ref: *rootMemberRef, h: h, cs: cryptography.NewKeyBoundCryptographyService(rootKey)}, nil
+}
+
+// CreateMember creates member with new keys by call of root member
+func (h *Harness) CreateMember(root *Member, name string) (*Member, error) {
+	kp := platformpolicy.NewKeyProcessor()
+	key, err := kp.GeneratePrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CreateMember ] Can't generate key")
+	}
+	pubKey, err := kp.ExportPublicKeyPEM(kp.ExtractPublicKey(key))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CreateMember ] Can't export key")
+	}
+
+	res, err := root.Call("CreateMember", name, string(pubKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CreateMember ] Call failed")
+	}
+	ref, err := insolar.NewReferenceFromBase58(res.(string))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CreateMember ] Bad reference of member")
+	}
+
+	return &Member{Ref: *ref, h: h, cs: cryptography.NewKeyBoundCryptographyService(key)}, nil
+}
+
+// Call makes signed call of member like API does, errors of contract are returned as errors
+func (m *Member) Call(method string, params ...interface{}) (interface{}, error) {
+	if m.h.rootDomain == nil {
+		return nil, errors.New("[ Call ] There is no root domain, call Genesis first")
+	}
+
+	seed := make([]byte, 32)
+	_, err := rand.Read(seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Call ] Can't generate seed")
+	}
+
+	buf, err := insolar.Serialize(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Call ] Can't serialize params")
+	}
+
+	args, err := insolar.MarshalArgs(m.Ref, method, buf, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Call ] Can't marshal args")
+	}
+	signature, err := m.cs.Sign(args)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Call ] Can't sign args")
+	}
+
+	res, err := m.h.call(m.Ref, "member", "Call", *m.h.rootDomain, method, buf, seed, signature.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Call ]")
+	}
+
+	var result interface{}
+	var contractErr interface{}
+	err = signer.UnmarshalParams(res, &result, &contractErr)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Call ] Can't unmarshal result")
+	}
+	if contractErr != nil {
+		return nil, errors.Errorf("[ Call ] Contract error: %v", contractErr)
+	}
+	return result, nil
+}
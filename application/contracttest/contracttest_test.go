@@ -0,0 +1,98 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build slowtest
+
+package contracttest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/application/contract/wallet"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+func TestHarness_Transfer(t *testing.T) {
+	h := New(t)
+	defer h.Stop()
+
+	err := h.BuildApplication("member", "allowance", "wallet", "rootdomain")
+	require.NoError(t, err)
+
+	root, err := h.Genesis()
+	require.NoError(t, err)
+
+	alice, err := h.CreateMember(root, "alice")
+	require.NoError(t, err)
+	bob, err := h.CreateMember(root, "bob")
+	require.NoError(t, err)
+
+	_, err = alice.Call("Transfer", 100, bob.Ref.String())
+	require.NoError(t, err)
+
+	balance, err := root.Call("GetBalance", alice.Ref.String())
+	require.NoError(t, err)
+	require.Equal(t, "999999900", balance)
+
+	balance, err = root.Call("GetBalance", bob.Ref.String())
+	require.NoError(t, err)
+	require.Equal(t, "1000000100", balance)
+
+	walletRef, err := h.Delegate(alice.Ref, "wallet")
+	require.NoError(t, err)
+
+	var w wallet.Wallet
+	err = h.State(*walletRef, &w)
+	require.NoError(t, err)
+	require.Equal(t, "999999900", w.Balance)
+
+	children, err := h.Children(*walletRef)
+	require.NoError(t, err)
+	require.NotEmpty(t, children, "allowance is created by transfer")
+
+	_, err = alice.Call("Transfer", 100, alice.Ref.String())
+	require.Error(t, err)
+}
+
+func TestHarness_WalletMigration(t *testing.T) {
+	h := New(t)
+	defer h.Stop()
+
+	err := h.BuildApplication("member", "allowance", "wallet", "rootdomain")
+	require.NoError(t, err)
+
+	// state saved by wallet code of version 0 with uint balance of whole coins
+	old := struct {
+		foundation.BaseContract
+		Balance uint
+	}{Balance: 500}
+	walletRef, err := h.Activate("wallet", insolar.GenesisRecord.Ref(), old)
+	require.NoError(t, err)
+
+	res, err := h.CallMethod(*walletRef, "wallet", "GetBalance")
+	require.NoError(t, err)
+	require.Equal(t, "500000000000", res[0])
+	require.Nil(t, res[1])
+
+	var w wallet.Wallet
+	err = h.State(*walletRef, &w)
+	require.NoError(t, err)
+	require.Equal(t, "500000000000", w.Balance)
+	require.Equal(t, uint(1), w.CodeVersion)
+}
@@ -22,9 +22,18 @@ import (
 	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 )
 
+type Info struct {
+	From       insolar.Reference
+	To         insolar.Reference
+	Asset      string
+	Amount     string
+	ExpireTime int64
+	Expired    bool
+}
+
 // PrototypeReference to prototype of this contract
 // error checking hides in generator
-var PrototypeReference, _ = insolar.NewReferenceFromBase58("11112KLf85SMy2G8NcHbgYuKxNyDe5Y4tAfJph1xyHT.11111111111111111111111111111111")
+var PrototypeReference, _ = insolar.NewReferenceFromBase58("11112HE1zspmCzrZd5oBCENWEZrmTrY38paCnDHAc1b.11111111111111111111111111111111")
 
 // Allowance holds proxy type
 type Allowance struct {
@@ -77,7 +86,7 @@ func GetImplementationFrom(object insolar.Reference) (*Allowance, error) {
 }
 
 // New is constructor
-func New(to *insolar.Reference, amount uint, expire int64) *ContractConstructorHolder {
+func New(to *insolar.Reference, amount string, expire int64) *ContractConstructorHolder {
 	var args [3]interface{}
 	args[0] = to
 	args[1] = amount
@@ -92,6 +101,23 @@ func New(to *insolar.Reference, amount uint, expire int64) *ContractConstructorH
 	return &ContractConstructorHolder{constructorName: "New", argsSerialized: argsSerialized}
 }
 
+// NewAsset is constructor
+func NewAsset(to *insolar.Reference, asset string, amount string, expire int64) *ContractConstructorHolder {
+	var args [4]interface{}
+	args[0] = to
+	args[1] = asset
+	args[2] = amount
+	args[3] = expire
+
+	var argsSerialized []byte
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ContractConstructorHolder{constructorName: "NewAsset", argsSerialized: argsSerialized}
+}
+
 // GetReference returns reference of the object
 func (r *Allowance) GetReference() insolar.Reference {
 	return r.Reference
@@ -157,13 +183,13 @@ func (r *Allowance) GetCode() (insolar.Reference, error) {
 }
 
 // TakeAmount is proxy generated method
-func (r *Allowance) TakeAmount() (uint, error) {
+func (r *Allowance) TakeAmount() (string, error) {
 	var args [0]interface{}
 
 	var argsSerialized []byte
 
 	ret := [2]interface{}{}
-	var ret0 uint
+	var ret0 string
 	ret[0] = &ret0
 	var ret1 *foundation.Error
 	ret[1] = &ret1
@@ -209,13 +235,13 @@ func (r *Allowance) TakeAmountNoWait() error {
 }
 
 // TakeAmountAsImmutable is proxy generated method
-func (r *Allowance) TakeAmountAsImmutable() (uint, error) {
+func (r *Allowance) TakeAmountAsImmutable() (string, error) {
 	var args [0]interface{}
 
 	var argsSerialized []byte
 
 	ret := [2]interface{}{}
-	var ret0 uint
+	var ret0 string
 	ret[0] = &ret0
 	var ret1 *foundation.Error
 	ret[1] = &ret1
@@ -242,13 +268,13 @@ func (r *Allowance) TakeAmountAsImmutable() (uint, error) {
 }
 
 // GetBalanceForOwner is proxy generated method
-func (r *Allowance) GetBalanceForOwner() (uint, error) {
+func (r *Allowance) GetBalanceForOwner() (string, error) {
 	var args [0]interface{}
 
 	var argsSerialized []byte
 
 	ret := [2]interface{}{}
-	var ret0 uint
+	var ret0 string
 	ret[0] = &ret0
 	var ret1 *foundation.Error
 	ret[1] = &ret1
@@ -294,13 +320,13 @@ func (r *Allowance) GetBalanceForOwnerNoWait() error {
 }
 
 // GetBalanceForOwnerAsImmutable is proxy generated method
-func (r *Allowance) GetBalanceForOwnerAsImmutable() (uint, error) {
+func (r *Allowance) GetBalanceForOwnerAsImmutable() (string, error) {
 	var args [0]interface{}
 
 	var argsSerialized []byte
 
 	ret := [2]interface{}{}
-	var ret0 uint
+	var ret0 string
 	ret[0] = &ret0
 	var ret1 *foundation.Error
 	ret[1] = &ret1
@@ -326,14 +352,99 @@ func (r *Allowance) GetBalanceForOwnerAsImmutable() (uint, error) {
 	return ret0, nil
 }
 
+// GetInfo is proxy generated method
+func (r *Allowance) GetInfo() (Info, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 Info
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "GetInfo", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetInfoNoWait is proxy generated method
+func (r *Allowance) GetInfoNoWait() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "GetInfo", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetInfoAsImmutable is proxy generated method
+func (r *Allowance) GetInfoAsImmutable() (Info, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 Info
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "GetInfo", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
 // GetExpiredBalance is proxy generated method
-func (r *Allowance) GetExpiredBalance() (uint, error) {
+func (r *Allowance) GetExpiredBalance() (string, error) {
 	var args [0]interface{}
 
 	var argsSerialized []byte
 
 	ret := [2]interface{}{}
-	var ret0 uint
+	var ret0 string
 	ret[0] = &ret0
 	var ret1 *foundation.Error
 	ret[1] = &ret1
@@ -379,13 +490,13 @@ func (r *Allowance) GetExpiredBalanceNoWait() error {
 }
 
 // GetExpiredBalanceAsImmutable is proxy generated method
-func (r *Allowance) GetExpiredBalanceAsImmutable() (uint, error) {
+func (r *Allowance) GetExpiredBalanceAsImmutable() (string, error) {
 	var args [0]interface{}
 
 	var argsSerialized []byte
 
 	ret := [2]interface{}{}
-	var ret0 uint
+	var ret0 string
 	ret[0] = &ret0
 	var ret1 *foundation.Error
 	ret[1] = &ret1
@@ -22,9 +22,20 @@ import (
 	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 )
 
+type Approval struct {
+	ID      string
+	Signers []string
+	Pulse   insolar.PulseNumber
+}
+
+type Recovery struct {
+	NewKey string
+	Pulse  insolar.PulseNumber
+}
+
 // PrototypeReference to prototype of this contract
 // error checking hides in generator
-var PrototypeReference, _ = insolar.NewReferenceFromBase58("111145AjNzRRnnH8VizADQ2AW6o7inysgAx6FqCpFn.11111111111111111111111111111111")
+var PrototypeReference, _ = insolar.NewReferenceFromBase58("1111fejtHxS6nKBJ4yoEkuJwoNHmhvcnpjoHcg5Tb7.11111111111111111111111111111111")
 
 // Member holds proxy type
 type Member struct {
@@ -91,6 +102,22 @@ func New(name string, key string) *ContractConstructorHolder {
 	return &ContractConstructorHolder{constructorName: "New", argsSerialized: argsSerialized}
 }
 
+// NewMultisig is constructor
+func NewMultisig(name string, keys []string, threshold uint) *ContractConstructorHolder {
+	var args [3]interface{}
+	args[0] = name
+	args[1] = keys
+	args[2] = threshold
+
+	var argsSerialized []byte
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ContractConstructorHolder{constructorName: "NewMultisig", argsSerialized: argsSerialized}
+}
+
 // GetReference returns reference of the object
 func (r *Member) GetReference() insolar.Reference {
 	return r.Reference
@@ -325,6 +352,91 @@ func (r *Member) GetPublicKeyAsImmutable() (string, error) {
 	return ret0, nil
 }
 
+// GetPublicKeys is proxy generated method
+func (r *Member) GetPublicKeys() ([]string, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 []string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "GetPublicKeys", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetPublicKeysNoWait is proxy generated method
+func (r *Member) GetPublicKeysNoWait() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "GetPublicKeys", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPublicKeysAsImmutable is proxy generated method
+func (r *Member) GetPublicKeysAsImmutable() ([]string, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 []string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "GetPublicKeys", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
 // Call is proxy generated method
 func (r *Member) Call(rootDomain insolar.Reference, method string, params []byte, seed []byte, sign []byte) (interface{}, error) {
 	var args [5]interface{}
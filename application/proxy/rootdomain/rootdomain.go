@@ -24,7 +24,7 @@ import (
 
 // PrototypeReference to prototype of this contract
 // error checking hides in generator
-var PrototypeReference, _ = insolar.NewReferenceFromBase58("1111K34VivDatLasAwtEAQPNk7ihSLvA2ooU96oTJX.11111111111111111111111111111111")
+var PrototypeReference, _ = insolar.NewReferenceFromBase58("1111wq8r5witp4prEZg14nTDsvv34KgiiDn4rTpsQq.11111111111111111111111111111111")
 
 // RootDomain holds proxy type
 type RootDomain struct {
@@ -244,6 +244,100 @@ func (r *RootDomain) CreateMemberAsImmutable(name string, key string) (string, e
 	return ret0, nil
 }
 
+// CreateMultisigMember is proxy generated method
+func (r *RootDomain) CreateMultisigMember(name string, keys []string, threshold uint) (string, error) {
+	var args [3]interface{}
+	args[0] = name
+	args[1] = keys
+	args[2] = threshold
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "CreateMultisigMember", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// CreateMultisigMemberNoWait is proxy generated method
+func (r *RootDomain) CreateMultisigMemberNoWait(name string, keys []string, threshold uint) error {
+	var args [3]interface{}
+	args[0] = name
+	args[1] = keys
+	args[2] = threshold
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "CreateMultisigMember", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateMultisigMemberAsImmutable is proxy generated method
+func (r *RootDomain) CreateMultisigMemberAsImmutable(name string, keys []string, threshold uint) (string, error) {
+	var args [3]interface{}
+	args[0] = name
+	args[1] = keys
+	args[2] = threshold
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "CreateMultisigMember", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
 // GetRootMemberRef is proxy generated method
 func (r *RootDomain) GetRootMemberRef() (*insolar.Reference, error) {
 	var args [0]interface{}
@@ -502,6 +596,97 @@ func (r *RootDomain) DumpAllUsersAsImmutable() ([]byte, error) {
 	return ret0, nil
 }
 
+// MigrateObjects is proxy generated method
+func (r *RootDomain) MigrateObjects(prototype string, objects []string) (int, error) {
+	var args [2]interface{}
+	args[0] = prototype
+	args[1] = objects
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 int
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "MigrateObjects", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// MigrateObjectsNoWait is proxy generated method
+func (r *RootDomain) MigrateObjectsNoWait(prototype string, objects []string) error {
+	var args [2]interface{}
+	args[0] = prototype
+	args[1] = objects
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "MigrateObjects", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MigrateObjectsAsImmutable is proxy generated method
+func (r *RootDomain) MigrateObjectsAsImmutable(prototype string, objects []string) (int, error) {
+	var args [2]interface{}
+	args[0] = prototype
+	args[1] = objects
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 int
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "MigrateObjects", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
 // Info is proxy generated method
 func (r *RootDomain) Info() (interface{}, error) {
 	var args [0]interface{}
@@ -671,3 +856,88 @@ func (r *RootDomain) GetNodeDomainRefAsImmutable() (insolar.Reference, error) {
 	}
 	return ret0, nil
 }
+
+// GetTokenRegistryRef is proxy generated method
+func (r *RootDomain) GetTokenRegistryRef() (insolar.Reference, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 insolar.Reference
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "GetTokenRegistryRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetTokenRegistryRefNoWait is proxy generated method
+func (r *RootDomain) GetTokenRegistryRefNoWait() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "GetTokenRegistryRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTokenRegistryRefAsImmutable is proxy generated method
+func (r *RootDomain) GetTokenRegistryRefAsImmutable() (insolar.Reference, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 insolar.Reference
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "GetTokenRegistryRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
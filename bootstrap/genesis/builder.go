@@ -46,6 +46,7 @@ type prototypes map[string]*insolar.Reference
 type contractsBuilder struct {
 	root            string
 	prototypes      prototypes
+	codeVersions    map[string]uint
 	artifactManager artifact.Manager
 }
 
@@ -60,6 +61,7 @@ func newContractBuilder(am artifact.Manager) *contractsBuilder {
 	cb := &contractsBuilder{
 		root:            tmpDir,
 		prototypes:      make(map[string]*insolar.Reference),
+		codeVersions:    make(map[string]uint),
 		artifactManager: am,
 	}
 	return cb
@@ -108,6 +110,7 @@ func (cb *contractsBuilder) build(ctx context.Context, contracts map[string]*pre
 			return errors.Wrap(err, "[ buildPrototypes ] Can't RegisterRequest for contract")
 		}
 		cb.prototypes[name] = insolar.NewReference(rd.ID(), *protoID)
+		cb.codeVersions[name] = contracts[name].CodeVersion()
 
 		inslogger.FromContext(ctx).Infof("Register %v_proto reference: %v", name, cb.prototypes[name])
 	}
@@ -87,7 +87,7 @@ type storageComponents struct {
 }
 
 func initStorageComponents(conf configuration.Ledger) storageComponents {
-	db, err := store.NewBadgerDB(conf.Storage.DataDirectory)
+	db, err := store.NewBadgerDB(conf.Storage)
 	if err != nil {
 		panic(errors.Wrap(err, "failed to initialize DB"))
 	}
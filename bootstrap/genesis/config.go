@@ -47,8 +47,9 @@ type Config struct {
 
 	HeavyGeneisConfigFile string `mapstructure:"heavy_genesis_config_file"`
 
-	// RootBalance is a start balance for the root member's wallet.
-	RootBalance uint `mapstructure:"root_balance"`
+	// RootBalance is a start balance for the root member's wallet, decimal number of arbitrary precision.
+	// It's counted in the smallest units of native coin, see safemath.NativeDecimals.
+	RootBalance string `mapstructure:"root_balance"`
 
 	// Discovery settings.
 
@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/application/contract/member"
 	"github.com/insolar/insolar/application/contract/nodedomain"
 	rootdomaincontract "github.com/insolar/insolar/application/contract/rootdomain"
+	tokenregistrycontract "github.com/insolar/insolar/application/contract/tokenregistry"
 	walletcontract "github.com/insolar/insolar/application/contract/wallet"
 	"github.com/insolar/insolar/bootstrap"
 	"github.com/insolar/insolar/bootstrap/rootdomain"
@@ -48,6 +49,7 @@ var contractNames = []string{
 	insolar.GenesisNameRootMember,
 	insolar.GenesisNameRootWallet,
 	insolar.GenesisNameAllowance,
+	insolar.GenesisNameTokenRegistry,
 }
 
 type nodeInfo struct {
@@ -115,7 +117,7 @@ func (g *Generator) Run(ctx context.Context) error {
 		return errors.Wrap(err, "[ Genesis ] couldn't get root keys")
 	}
 
-	err = g.activateSmartContracts(ctx, platformpolicy.MustPublicKeyToString(pair.Public), prototypes)
+	err = g.activateSmartContracts(ctx, platformpolicy.MustPublicKeyToString(pair.Public), prototypes, cb.codeVersions)
 	if err != nil {
 		panic(errors.Wrap(err, "[ Genesis ] could't activate smart contracts"))
 	}
@@ -155,8 +157,9 @@ func (g *Generator) activateRootDomain(
 	inslog := inslogger.FromContext(ctx)
 
 	data, err := insolar.Serialize(&rootdomaincontract.RootDomain{
-		RootMember:    bootstrap.ContractRootMember,
-		NodeDomainRef: bootstrap.ContractNodeDomain,
+		RootMember:       bootstrap.ContractRootMember,
+		NodeDomainRef:    bootstrap.ContractNodeDomain,
+		TokenRegistryRef: bootstrap.ContractTokenRegistry,
 	})
 	if err != nil {
 		return errors.Wrap(err, "[ activateRootDomain ] serialization failed")
@@ -293,13 +296,16 @@ func (g *Generator) activateRootMember(
 }
 
 func (g *Generator) activateRootMemberWallet(
-	ctx context.Context, walletContractProto insolar.Reference,
+	ctx context.Context, walletContractProto insolar.Reference, codeVersion uint,
 ) error {
 
 	w, err := walletcontract.New(g.config.RootBalance)
 	if err != nil {
 		return errors.Wrap(err, "[ ActivateRootWallet ] failed to create wallet instance")
 	}
+	// state is saved bypassing contract wrapper, so it must be stamped with version of code
+	// or it will be migrated as state of the old code on the first call
+	w.CodeVersion = codeVersion
 
 	instanceData, err := insolar.Serialize(w)
 	if err != nil {
@@ -338,10 +344,55 @@ func (g *Generator) activateRootMemberWallet(
 	return nil
 }
 
+func (g *Generator) activateTokenRegistry(
+	ctx context.Context, tokenRegistryProto insolar.Reference,
+) error {
+	tr, _ := tokenregistrycontract.New()
+
+	instanceData, err := insolar.Serialize(tr)
+	if err != nil {
+		return errors.Wrap(err, "[ activateTokenRegistry ] token registry serialization")
+	}
+
+	contractID, err := g.artifactManager.RegisterRequest(
+		ctx,
+		record.Request{
+			CallType: record.CTGenesis,
+			Method:   insolar.GenesisNameTokenRegistry,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ activateTokenRegistry ] couldn't create token registry instance")
+	}
+	contract := insolar.NewReference(rootdomain.RootDomain.ID(), *contractID)
+
+	_, err = g.artifactManager.ActivateObject(
+		ctx,
+		insolar.Reference{},
+		*contract,
+		bootstrap.ContractRootDomain,
+		tokenRegistryProto,
+		false,
+		instanceData,
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ activateTokenRegistry ] couldn't create token registry instance")
+	}
+	_, err = g.artifactManager.RegisterResult(ctx, bootstrap.ContractRootDomain, *contract, nil)
+	if err != nil {
+		return errors.Wrap(err, "[ activateTokenRegistry ] couldn't create token registry instance")
+	}
+
+	inslogger.FromContext(ctx).Infof("[ activateTokenRegistry ] %v contract ref=%v", bootstrap.ContractTokenRegistry, contract)
+
+	return nil
+}
+
 func (g *Generator) activateSmartContracts(
 	ctx context.Context,
 	rootPubKey string,
 	prototypes prototypes,
+	codeVersions map[string]uint,
 ) error {
 	var err error
 
@@ -360,11 +411,18 @@ func (g *Generator) activateSmartContracts(
 		return errors.Wrap(err, "failed to store root GenesisNameRootMember contract")
 	}
 
-	err = g.activateRootMemberWallet(ctx, *prototypes[insolar.GenesisNameRootWallet])
+	err = g.activateRootMemberWallet(
+		ctx, *prototypes[insolar.GenesisNameRootWallet], codeVersions[insolar.GenesisNameRootWallet],
+	)
 	if err != nil {
 		return errors.Wrap(err, "failed to store root rootMemberWallet contract")
 	}
 
+	err = g.activateTokenRegistry(ctx, *prototypes[insolar.GenesisNameTokenRegistry])
+	if err != nil {
+		return errors.Wrap(err, "failed to store token registry contract")
+	}
+
 	return nil
 }
 
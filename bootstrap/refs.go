@@ -34,4 +34,6 @@ var (
 	ContractWallet = rootdomain.GenesisRef(insolar.GenesisNameRootWallet)
 	// ContractAllowance is the allowance contract reference.
 	ContractAllowance = rootdomain.GenesisRef(insolar.GenesisNameAllowance)
+	// ContractTokenRegistry is the token registry contract reference.
+	ContractTokenRegistry = rootdomain.GenesisRef(insolar.GenesisNameTokenRegistry)
 )
@@ -52,6 +52,10 @@ func TestReferences(t *testing.T) {
 			got:    ContractAllowance,
 			expect: "1tJCxMpe8nTqQq38ByCkdg77LtHhfkcTF1teWWtYwi.1tJDJLGWcX3TCXZMzZodTYWZyJGVdsajgGqyq8Vidw",
 		},
+		insolar.GenesisNameTokenRegistry: {
+			got:    ContractTokenRegistry,
+			expect: "1tJCuDcbbTrLFg4K65kjL79ouX9K6xqLzYWeJidhpG.1tJDJLGWcX3TCXZMzZodTYWZyJGVdsajgGqyq8Vidw",
+		},
 	}
 
 	for n, p := range pairs {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"strings"
 	"sync"
@@ -129,7 +130,7 @@ func (s *transferDifferentMembersScenario) startMember(ctx context.Context, inde
 		retry := true
 		for retry && bof.Attempt() < backoffAttemptsCount {
 			start = time.Now()
-			traceID, err = s.insSDK.Transfer(1, from, to)
+			traceID, err = s.insSDK.Transfer(big.NewInt(1), from, to)
 			stop = time.Since(start)
 
 			if err == nil {
@@ -370,6 +370,34 @@ func main() {
 	cmdCompile.Flags().BoolVarP(&keepTemp, "keep-temp", "k", false, "keep temp directory (default \"false\")")
 	cmdCompile.Flags().VarP(machineType, "machine-type", "m", "machine type (one of builtin/go)")
 
+	strict := false
+	var cmdCheck = &cobra.Command{
+		Use:   "check [flags] <file name to check>",
+		Short: "Check contract for non-deterministic code",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			parsed, err := preprocessor.ParseFile(args[0], machineType.Value())
+			if err != nil {
+				fmt.Println(errors.Wrap(err, "couldn't parse"))
+				os.Exit(1)
+			}
+
+			failed := false
+			for _, diagnostic := range parsed.Check() {
+				fmt.Println(diagnostic)
+				if strict || diagnostic.Severity == preprocessor.SeverityError {
+					failed = true
+				}
+			}
+			if failed {
+				os.Exit(1)
+			}
+		},
+	}
+	// default value for bool flags is not displayed automatically, thus it's done manually here
+	cmdCheck.Flags().BoolVarP(&strict, "strict", "s", false, "treat warnings as errors (default \"false\")")
+	cmdCheck.Flags().VarP(machineType, "machine-type", "m", "machine type (one of builtin/go)")
+
 	var cmdGenerateBuiltins = &cobra.Command{
 		Use:   "regen-builtin [flags] <dir path to builtin contracts>",
 		Short: "Build builtin proxy, wrappers and initializator",
@@ -436,7 +464,7 @@ func main() {
 	}
 
 	var rootCmd = &cobra.Command{Use: "insgocc"}
-	rootCmd.AddCommand(cmdProxy, cmdWrapper, cmdImports, cmdCompile, cmdGenerateBuiltins)
+	rootCmd.AddCommand(cmdProxy, cmdWrapper, cmdImports, cmdCompile, cmdCheck, cmdGenerateBuiltins)
 	err := rootCmd.Execute()
 	if err != nil {
 		fmt.Println(err)
@@ -45,6 +45,7 @@ func main() {
 	metricsAddress := pflag.String("metrics", "", "address and port of prometheus metrics")
 	code := pflag.String("code", "", "add pre-compiled code to cache (<ref>:</path/to/plugin.so>)")
 	logLevel := pflag.String("log-level", "debug", "log level")
+	memoryLimit := pflag.Uint64("memory-limit", 0, "limit of address space of the process in bytes, 0 means no limit")
 
 	pflag.Parse()
 
@@ -53,6 +54,14 @@ func main() {
 		log.Fatalf("Couldn't set log level to %q: %s", *logLevel, err)
 	}
 
+	if *memoryLimit > 0 {
+		err := syscall.Setrlimit(syscall.RLIMIT_AS, &syscall.Rlimit{Cur: *memoryLimit, Max: *memoryLimit})
+		if err != nil {
+			log.Fatal("Couldn't set memory limit: ", err)
+			os.Exit(1)
+		}
+	}
+
 	if *path == "" {
 		tmpDir, err := ioutil.TempDir("", "contractcache-")
 		if err != nil {
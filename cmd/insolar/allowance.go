@@ -0,0 +1,96 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// allowanceCommand returns command with subcommands for allowance workflow of member from keys file.
+func allowanceCommand(addURLFlag func(fs *pflag.FlagSet), sendURL *string) *cobra.Command {
+	var keysFile string
+	var allowanceCmd = &cobra.Command{
+		Use:   "allowance",
+		Short: "creates, lists, claims and reclaims allowances of member",
+	}
+	allowanceCmd.PersistentFlags().StringVarP(
+		&keysFile, "keys", "k", "config.json", "path to json with member's private key and reference")
+	addURLFlag(allowanceCmd.PersistentFlags())
+
+	allowanceCmd.AddCommand(&cobra.Command{
+		Use:   "create <to member> <amount> <expire seconds>",
+		Short: "locks amount for member until it is claimed or expires",
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			amount, ok := new(big.Int).SetString(args[1], 10)
+			if !ok || amount.Sign() < 0 {
+				check("Bad amount:", errors.New("amount must be non-negative integer"))
+			}
+			expire, err := strconv.ParseUint(args[2], 10, 32)
+			check("Bad expire:", err)
+			memberRequest(*sendURL, keysFile, "CreateAllowance", args[0], amount.String(), expire)
+		},
+	})
+	allowanceCmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "lists incoming and outgoing allowances",
+		Run: func(cmd *cobra.Command, args []string) {
+			memberRequest(*sendURL, keysFile, "ListAllowances")
+		},
+	})
+	allowanceCmd.AddCommand(&cobra.Command{
+		Use:   "claim <allowance>",
+		Short: "moves amount of incoming allowance to balance",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			memberRequest(*sendURL, keysFile, "ClaimAllowance", args[0])
+		},
+	})
+	allowanceCmd.AddCommand(&cobra.Command{
+		Use:   "reclaim",
+		Short: "returns expired outgoing allowances to balance",
+		Run: func(cmd *cobra.Command, args []string) {
+			memberRequest(*sendURL, keysFile, "ReclaimExpired")
+		},
+	})
+	return allowanceCmd
+}
+
+func memberRequest(sendURL string, keysFile string, method string, params ...interface{}) {
+	requester.SetVerbose(verbose)
+
+	userCfg, err := requester.ReadUserConfigFromFile(keysFile)
+	check("Problems with reading user config:", err)
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	response, err := requester.Send(ctx, sendURL, userCfg, &requester.RequestConfigJSON{
+		Params: params,
+		Method: method,
+	})
+	check("Problems with sending request", err)
+
+	mustWrite(os.Stdout, string(response))
+}
@@ -112,6 +112,21 @@ func main() {
 		&rootAsCaller, "root-caller", "r", false, "use root member as caller")
 	rootCmd.AddCommand(sendRequestCmd)
 
+	var userKeysFile string
+	var rotateKeyCmd = &cobra.Command{
+		Use:   "rotate-key",
+		Short: "replaces member's key with new random one and prints updated config",
+		Run: func(cmd *cobra.Command, args []string) {
+			rotateKey(sendURL, userKeysFile)
+		},
+	}
+	addURLFlag(rotateKeyCmd.Flags())
+	rotateKeyCmd.Flags().StringVarP(
+		&userKeysFile, "keys", "k", "config.json", "path to json with member's private key and reference")
+	rootCmd.AddCommand(rotateKeyCmd)
+
+	rootCmd.AddCommand(allowanceCommand(addURLFlag, &sendURL))
+
 	var (
 		role      string
 		reuseKeys bool
@@ -143,6 +158,23 @@ func main() {
 		&certFile, "node-cert", "c", "cert.json", "The OUT file the node certificate")
 	rootCmd.AddCommand(certgenCmd)
 
+	var (
+		snapshotPath string
+		dataDir      string
+	)
+	var restoreCmd = &cobra.Command{
+		Use:   "restore",
+		Short: "restores heavy node storage from snapshot into a fresh data directory",
+		Run: func(cmd *cobra.Command, args []string) {
+			restoreSnapshot(snapshotPath, dataDir)
+		},
+	}
+	restoreCmd.Flags().StringVarP(
+		&snapshotPath, "snapshot", "s", "", "path to snapshot file made by backup.Make")
+	restoreCmd.Flags().StringVarP(
+		&dataDir, "data-dir", "d", "./data", "empty data directory to restore storage into")
+	rootCmd.AddCommand(restoreCmd)
+
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
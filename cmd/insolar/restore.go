@@ -0,0 +1,60 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/heavy/backup"
+)
+
+// restoreSnapshot loads heavy node snapshot into a fresh data directory and verifies it against drop hashes.
+func restoreSnapshot(snapshotPath string, dataDir string) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "restore")
+
+	files, err := ioutil.ReadDir(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		check("Failed to read data directory:", err)
+	}
+	if len(files) > 0 {
+		fmt.Fprintln(os.Stderr, "Data directory is not empty:", dataDir)
+		os.Exit(1)
+	}
+
+	f, err := os.Open(filepath.Clean(snapshotPath))
+	check("Failed to open snapshot:", err)
+	defer f.Close() // nolint: errcheck
+
+	db, err := store.NewBadgerDB(configuration.Storage{DataDirectory: dataDir})
+	check("Failed to open storage:", err)
+
+	summary, err := backup.NewRestorer(db).Restore(ctx, f)
+	stopErr := db.Stop(ctx)
+	check("Failed to restore snapshot:", err)
+	check("Failed to close storage:", stopErr)
+
+	fmt.Printf("Pulse   : %d\n", summary.PulseNumber)
+	fmt.Printf("Entries : %d\n", summary.Entries)
+	fmt.Printf("Drops   : %d (hashes verified)\n", summary.Drops)
+}
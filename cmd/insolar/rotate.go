@@ -0,0 +1,93 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// rotateKey replaces member's key with newly generated one and prints config with new keys. The config is saved
+// to "<keysFile>.new" before the request is sent, so the new key isn't lost if the result of the call is unknown.
+// The file is removed only if the member confirmed that the key is not rotated.
+func rotateKey(sendURL string, keysFile string) {
+	requester.SetVerbose(verbose)
+
+	userCfg, err := requester.ReadUserConfigFromFile(keysFile)
+	check("Problems with reading user config:", err)
+	if userCfg.Caller == "" {
+		check("Bad keys file:", errors.New("caller must be set"))
+	}
+
+	ks := platformpolicy.NewKeyProcessor()
+
+	privKey, err := ks.GeneratePrivateKey()
+	check("Problems with generating of private key:", err)
+
+	privKeyStr, err := ks.ExportPrivateKeyPEM(privKey)
+	check("Problems with serialization of private key:", err)
+
+	pubKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(privKey))
+	check("Problems with serialization of public key:", err)
+
+	cfg := mixedConfig{
+		PrivateKey: string(privKeyStr),
+		PublicKey:  string(pubKeyStr),
+		Caller:     userCfg.Caller,
+	}
+	result, err := json.MarshalIndent(cfg, "", "    ")
+	check("Problems with marshaling config:", err)
+
+	// previous rotation may have succeeded with the key from existing file, so it's never overwritten
+	newKeysFile := keysFile + ".new"
+	f, err := os.OpenFile(newKeysFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	check("Problems with creating new keys file (check if previous rotation succeeded):", err)
+	_, err = f.Write(result)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(newKeysFile) // nolint: errcheck
+		check("Problems with writing new keys file:", err)
+	}
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	req := requester.RequestConfigJSON{
+		Params: []interface{}{string(pubKeyStr)},
+		Method: "RotateKey",
+	}
+	r, err := requester.Send(ctx, sendURL, userCfg, &req)
+	check("Problems with sending request, new keys are kept in "+newKeysFile+":", err)
+
+	var rStruct struct {
+		Error string `json:"error"`
+	}
+	err = json.Unmarshal(r, &rStruct)
+	check("Problems with understanding result, new keys are kept in "+newKeysFile+":", err)
+	if rStruct.Error != "" {
+		os.Remove(newKeysFile) // nolint: errcheck
+		check("Key is not rotated:", errors.New(rStruct.Error))
+	}
+
+	mustWrite(os.Stdout, string(result))
+}
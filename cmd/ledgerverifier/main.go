@@ -0,0 +1,90 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/heavy/retention"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/ledger/verifier"
+	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func main() {
+	dataDir := pflag.StringP("data-dir", "d", "./data", "heavy node data directory")
+	pflag.Parse()
+
+	if _, err := os.Stat(*dataDir); err != nil {
+		log.Errorf("Failed to open data directory: %s", err.Error())
+		os.Exit(2)
+	}
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "ledgerverifier")
+	db, err := store.NewBadgerDB(configuration.Storage{DataDirectory: *dataDir})
+	if err != nil {
+		log.Errorf("Failed to open storage: %s", err.Error())
+		os.Exit(2)
+	}
+	defer db.Stop(ctx) // nolint: errcheck
+
+	pulses := pulse.NewDB(db)
+	v := verifier.NewVerifier(
+		platformpolicy.NewPlatformCryptographyScheme(),
+		db,
+		pulses,
+		pulses,
+		exporter.NewCatalogDB(db),
+		object.NewRecordDB(db),
+		blob.NewDB(db),
+		object.NewIndexDB(db),
+		retention.NewHorizonDB(db),
+	)
+
+	report, err := v.Verify(ctx)
+	if err != nil {
+		log.Errorf("Verification failed: %s", err.Error())
+		db.Stop(ctx) // nolint: errcheck
+		os.Exit(2)
+	}
+
+	for _, p := range report.Problems {
+		fmt.Println(p)
+	}
+	fmt.Printf("Pulses   : %d\n", report.Pulses)
+	fmt.Printf("Drops    : %d\n", report.Drops)
+	fmt.Printf("Records  : %d\n", report.Records)
+	fmt.Printf("Blobs    : %d\n", report.Blobs)
+	fmt.Printf("Indexes  : %d\n", report.Indexes)
+	fmt.Printf("Problems : %d\n", len(report.Problems))
+
+	if !report.OK() {
+		db.Stop(ctx) // nolint: errcheck
+		os.Exit(1)
+	}
+}
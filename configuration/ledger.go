@@ -33,6 +33,9 @@ type Storage struct {
 type PulseManager struct {
 	// SplitThreshold is a drop size threshold in bytes to perform split.
 	SplitThreshold uint64
+	// MergeThreshold is a drop size threshold in bytes. Sibling jets, which drops are both smaller, are merged.
+	// Zero disables merging.
+	MergeThreshold uint64
 }
 
 // Backoff configures retry backoff algorithm
@@ -52,6 +55,19 @@ type Exporter struct {
 	ExportLag uint32
 }
 
+// Backup holds configuration of heavy node storage snapshots.
+type Backup struct {
+	// Directory is a directory where snapshots are written to.
+	Directory string
+}
+
+// Retention holds configuration of heavy node data pruning.
+type Retention struct {
+	// Depth is a number of pulses, after which superseded object states and their memory are removed.
+	// Zero disables pruning.
+	Depth int
+}
+
 // Ledger holds configuration for ledger.
 type Ledger struct {
 	// Storage defines storage configuration.
@@ -70,6 +86,12 @@ type Ledger struct {
 	// Exporter holds configuration of Exporter
 	Exporter Exporter
 
+	// Backup holds configuration of storage snapshots
+	Backup Backup
+
+	// Retention holds configuration of data pruning on heavy nodes
+	Retention Retention
+
 	// PendingRequestsLimit holds a number of pending requests, what can be stored in the system
 	// before they are declined
 	PendingRequestsLimit int
@@ -92,6 +114,10 @@ func NewLedger() Ledger {
 			ExportLag: 40, // 40 seconds
 		},
 
+		Backup: Backup{
+			Directory: "./backup",
+		},
+
 		PendingRequestsLimit: 1000,
 	}
 }
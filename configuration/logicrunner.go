@@ -16,6 +16,10 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // LogicRunner configuration
 type LogicRunner struct {
 	// RPCListen - address logic runner binds RPC API to
@@ -27,6 +31,23 @@ type LogicRunner struct {
 	BuiltIn *BuiltIn
 	// GoPlugin - configuration of executor based on Go plugins
 	GoPlugin *GoPlugin
+	// WASM - configuration of executor of WebAssembly contracts
+	WASM *WASM
+	// Budget - limits of resources one contract call can consume
+	Budget Budget
+}
+
+// Budget of a contract call, zero value of a limit disables it
+type Budget struct {
+	// Timeout - wall-clock time a call can run, call is cancelled when it's over
+	Timeout time.Duration
+	// Steps - how many instructions contract code can execute during a call, it's enforced by executors
+	// able to meter executed code (WebAssembly), native code is limited by timeout only
+	Steps uint64
+	// Upcalls - how many calls to the platform contract code can make during a call
+	Upcalls uint64
+	// OutgoingCalls - how many calls of other contracts a call can make
+	OutgoingCalls uint64
 }
 
 // BuiltIn configuration, no options at the moment
@@ -39,6 +60,33 @@ type GoPlugin struct {
 	// RunnerProtocol - protocol (network) of above address,
 	// e.g. "tcp", "unix"... see `net.Dial`
 	RunnerProtocol string
+	// Workers - pool of `insgorund` processes supervised by the node,
+	// if it's nil then single `insgorund` listening RunnerListen is started separately
+	Workers *GoPluginWorkers
+}
+
+// GoPluginWorkers configuration
+type GoPluginWorkers struct {
+	// Command - path to `insgorund` binary
+	Command string
+	// Count - number of workers
+	Count int
+	// RouteBy - reference calls are routed to workers by, either "object" or "prototype"
+	RouteBy string
+	// MemoryLimit - limit of address space of a worker in bytes, zero means no limit
+	MemoryLimit uint64
+	// RestartDelay - delay before crashed worker is started again
+	RestartDelay time.Duration
+}
+
+// WASM configuration
+type WASM struct {
+	// MaxMemoryPages - limit of linear memory of a contract in 64KiB pages
+	MaxMemoryPages int
+	// MaxCallStackDepth - limit of nested function calls in a contract
+	MaxCallStackDepth int
+	// GasLimit - how many instructions a contract can execute during a call, zero disables the limit
+	GasLimit uint64
 }
 
 // NewLogicRunner - returns default config of the logic runner
@@ -51,5 +99,16 @@ func NewLogicRunner() LogicRunner {
 			RunnerListen:   "127.0.0.1:7777",
 			RunnerProtocol: "tcp",
 		},
+		WASM: &WASM{
+			MaxMemoryPages:    256,
+			MaxCallStackDepth: 1024,
+			GasLimit:          100000000,
+		},
+		Budget: Budget{
+			Timeout:       time.Minute * 10,
+			Steps:         100000000,
+			Upcalls:       10000,
+			OutgoingCalls: 1000,
+		},
 	}
 }
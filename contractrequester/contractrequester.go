@@ -173,6 +173,60 @@ func (cr *ContractRequester) CallConstructor(ctx context.Context, inMsg insolar.
 	return rep.Object, nil
 }
 
+// DryRun makes synchronously dry-run call to method of contract by its ref, request isn't registered and state isn't saved
+func (cr *ContractRequester) DryRun(ctx context.Context, ref *insolar.Reference, method string, argsIn []interface{}) (insolar.Reply, error) {
+	ctx, span := instracer.StartSpan(ctx, "DryRun "+method)
+	defer span.End()
+
+	args, err := insolar.MarshalArgs(argsIn...)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ContractRequester::DryRun ] Can't marshal")
+	}
+
+	msg := &message.DryRunMethod{
+		CallMethod: message.CallMethod{
+			Request: record.Request{
+				Object:    ref,
+				Method:    method,
+				Arguments: args,
+			},
+		},
+	}
+
+	res, err := cr.DryRunMethod(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ContractRequester::DryRun ] Can't route call")
+	}
+
+	return res, nil
+}
+
+func (cr *ContractRequester) DryRunMethod(ctx context.Context, inMsg insolar.Message) (insolar.Reply, error) {
+	ctx, span := instracer.StartSpan(ctx, "ContractRequester.DryRunMethod")
+	defer span.End()
+
+	msg, ok := inMsg.(*message.DryRunMethod)
+	if !ok {
+		return nil, errors.New("DryRunMethod() accepts only message.DryRunMethod")
+	}
+
+	if msg.Nonce == 0 {
+		msg.Nonce = randomUint64()
+	}
+
+	sender := messagebus.BuildSender(cr.MessageBus.Send, messagebus.RetryIncorrectPulse(cr.PulseAccessor))
+	res, err := sender(ctx, msg, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't dispatch event")
+	}
+
+	if _, ok := res.(*reply.DryRun); !ok {
+		return nil, errors.New("Got not reply.DryRun in reply for DryRunMethod")
+	}
+
+	return res, nil
+}
+
 func (cr *ContractRequester) ReceiveResult(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg, ok := parcel.Message().(*message.ReturnResults)
 	if !ok {
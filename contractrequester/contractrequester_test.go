@@ -146,6 +146,47 @@ func TestContractRequester_SendRequest_RouteError(t *testing.T) {
 	require.Nil(t, result)
 }
 
+func TestContractRequester_DryRun(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ref := testutils.RandomRef()
+
+	expected := &reply.DryRun{
+		Result: []byte{1, 2, 3},
+		Diff:   []insolar.StateDiff{{Object: ref, Before: []byte{1}, After: []byte{2}}},
+	}
+	mbm := testutils.NewMessageBusMock(t)
+	mbm.SendFunc = func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		msg, ok := m.(*message.DryRunMethod)
+		require.True(t, ok)
+		require.Equal(t, &ref, msg.Object)
+		require.Equal(t, "TestMethod", msg.Method)
+		return expected, nil
+	}
+
+	cReq, err := New()
+	require.NoError(t, err)
+	cReq.MessageBus = mbm
+	cReq.PulseAccessor = mockPulseAccessor(t)
+
+	result, err := cReq.DryRun(ctx, &ref, "TestMethod", []interface{}{})
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+	require.Empty(t, cReq.ResultMap)
+}
+
+func TestContractRequester_DryRun_WrongReply(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ref := testutils.RandomRef()
+
+	cReq, err := New()
+	require.NoError(t, err)
+	cReq.MessageBus = mockMessageBus(t, &reply.RegisterRequest{})
+	cReq.PulseAccessor = mockPulseAccessor(t)
+
+	_, err = cReq.DryRun(ctx, &ref, "TestMethod", []interface{}{})
+	require.Error(t, err)
+}
+
 func TestCallMethodCanceled(t *testing.T) {
 	ctx := context.Background()
 	ctx, cancelFunc := context.WithTimeout(ctx, time.Second)
@@ -0,0 +1,103 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type allowanceInfo struct {
+	Reference string
+	Amount    string
+	Expired   bool
+}
+
+func listAllowances(t *testing.T, member *user) (incoming []allowanceInfo, outgoing []allowanceInfo) {
+	resp, err := signedRequest(member, "ListAllowances")
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(resp.(string))
+	require.NoError(t, err)
+
+	result := struct {
+		Incoming []allowanceInfo
+		Outgoing []allowanceInfo
+	}{}
+	err = json.Unmarshal(data, &result)
+	require.NoError(t, err)
+	return result.Incoming, result.Outgoing
+}
+
+func TestAllowance_Claim(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+
+	ref, err := signedRequest(sender, "CreateAllowance", recipient.ref, 100, 3600)
+	require.NoError(t, err)
+	require.Equal(t, 1000*1000*1000-100, getBalanceNoErr(t, sender, sender.ref))
+
+	_, outgoing := listAllowances(t, sender)
+	require.Len(t, outgoing, 1)
+	require.Equal(t, ref, outgoing[0].Reference)
+	require.Equal(t, "100", outgoing[0].Amount)
+
+	// recipient is notified without waiting, so allowance appears in its list a bit later
+	var incoming []allowanceInfo
+	for i := 0; i < 100 && len(incoming) == 0; i++ {
+		time.Sleep(100 * time.Millisecond)
+		incoming, _ = listAllowances(t, recipient)
+	}
+	require.Len(t, incoming, 1)
+	require.Equal(t, ref, incoming[0].Reference)
+
+	_, err = signedRequest(recipient, "ClaimAllowance", ref)
+	require.NoError(t, err)
+	require.Equal(t, 1000*1000*1000+100, getBalanceNoErr(t, recipient, recipient.ref))
+
+	incoming, _ = listAllowances(t, recipient)
+	require.Empty(t, incoming)
+}
+
+func TestAllowance_ReclaimExpired(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+
+	ref, err := signedRequest(sender, "CreateAllowance", recipient.ref, 100, 1)
+	require.NoError(t, err)
+	time.Sleep(2 * time.Second)
+
+	reclaimed, err := signedRequest(sender, "ReclaimExpired")
+	require.NoError(t, err)
+	require.Equal(t, "100", reclaimed)
+
+	_, err = signedRequest(recipient, "ClaimAllowance", ref)
+	require.Error(t, err)
+}
+
+func TestAllowance_NotEnoughBalance(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+
+	_, err := signedRequest(sender, "CreateAllowance", recipient.ref, 1000*1000*1000+1, 3600)
+	require.Contains(t, err.Error(), "Not enough balance")
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/insolar/insolar/api"
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/insolar"
+	"github.com/stretchr/testify/require"
+)
+
+func createMultisigMember(t *testing.T, keys int, threshold uint) (string, []*user) {
+	var signers []*user
+	var pubKeys []string
+	for i := 0; i < keys; i++ {
+		u, err := newUserWithKeys()
+		require.NoError(t, err)
+		signers = append(signers, u)
+		pubKeys = append(pubKeys, u.pubKey)
+	}
+	result, err := signedRequest(&root, "CreateMultisigMember", "Multisig", pubKeys, threshold)
+	require.NoError(t, err)
+	ref, ok := result.(string)
+	require.True(t, ok)
+	return ref, signers
+}
+
+func TestMultisigMember_SignaturesInOneRequest(t *testing.T) {
+	ref, signers := createMultisigMember(t, 3, 2)
+
+	result, err := multisigRequest(ref, signers[:2], "GetMyBalance")
+	require.NoError(t, err)
+	require.Equal(t, "1000000000", result)
+}
+
+func TestMultisigMember_SignaturesAccumulated(t *testing.T) {
+	ref, signers := createMultisigMember(t, 3, 2)
+
+	result, err := multisigRequest(ref, signers[:1], "GetMyBalance")
+	require.NoError(t, err)
+	require.Equal(t, "Collected 1 of 2 signatures", result)
+
+	// same signer doesn't count twice
+	result, err = multisigRequest(ref, signers[:1], "GetMyBalance")
+	require.NoError(t, err)
+	require.Equal(t, "Collected 1 of 2 signatures", result)
+
+	result, err = multisigRequest(ref, signers[2:], "GetMyBalance")
+	require.NoError(t, err)
+	require.Equal(t, "1000000000", result)
+}
+
+func TestMultisigMember_UnknownKey(t *testing.T) {
+	ref, _ := createMultisigMember(t, 2, 1)
+	stranger, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	_, err = multisigRequest(ref, []*user{stranger}, "GetMyBalance")
+	require.Contains(t, err.Error(), "Incorrect signature")
+}
+
+func TestMultisigMember_AddRemoveKey(t *testing.T) {
+	ref, signers := createMultisigMember(t, 2, 2)
+	newSigner, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	result, err := multisigRequest(ref, signers[:1], "AddKey", newSigner.pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "Collected 1 of 2 signatures", result)
+
+	_, err = multisigRequest(ref, signers, "AddKey", newSigner.pubKey)
+	require.NoError(t, err)
+
+	_, err = multisigRequest(ref, []*user{signers[0], newSigner}, "RemoveKey", signers[1].pubKey)
+	require.NoError(t, err)
+
+	_, err = multisigRequest(ref, signers[1:], "GetMyBalance")
+	require.Contains(t, err.Error(), "Incorrect signature")
+
+	_, err = multisigRequest(ref, []*user{signers[0], newSigner}, "RemoveKey", newSigner.pubKey)
+	require.Contains(t, err.Error(), "Threshold must be between 1 and 1")
+}
+
+func getLatestState(t *testing.T, ref string) api.ObjectHistoryState {
+	body := getRPSResponseBody(t, postParams{
+		"jsonrpc": "2.0",
+		"method":  "object.History",
+		"id":      "",
+		"params":  map[string]interface{}{"Reference": ref, "Amount": 1},
+	})
+	historyResponse := &struct {
+		RPCResponse
+		Result api.ObjectHistoryReply `json:"result"`
+	}{}
+	unmarshalRPCResponse(t, body, historyResponse)
+	require.Len(t, historyResponse.Result.States, 1)
+	return historyResponse.Result.States[0]
+}
+
+func TestMultisigMember_NoSignatures(t *testing.T) {
+	ref, signers := createMultisigMember(t, 3, 2)
+
+	result, err := multisigRequest(ref, signers[:1], "GetMyBalance")
+	require.NoError(t, err)
+	require.Equal(t, "Collected 1 of 2 signatures", result)
+	before := getLatestState(t, ref)
+
+	args, err := insolar.MarshalArgs()
+	require.NoError(t, err)
+	noSignatures, err := insolar.Serialize([][]byte{})
+	require.NoError(t, err)
+	seed, err := requester.GetSeed(TestAPIURL)
+	require.NoError(t, err)
+	body, err := requester.GetResponseBody(TestCallUrl, requester.PostParams{
+		"params":    args,
+		"method":    "GetMyBalance",
+		"reference": ref,
+		"seed":      seed,
+		"signature": noSignatures,
+	})
+	require.NoError(t, err)
+	var res map[string]interface{}
+	err = json.Unmarshal(body, &res)
+	require.NoError(t, err)
+	require.Contains(t, res["error"], "No signatures")
+
+	// pending approval is neither dropped nor amended
+	require.Equal(t, before, getLatestState(t, ref))
+	result, err = multisigRequest(ref, signers[2:], "GetMyBalance")
+	require.NoError(t, err)
+	require.Equal(t, "1000000000", result)
+}
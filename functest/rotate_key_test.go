@@ -0,0 +1,91 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKey(t *testing.T) {
+	member := createMember(t, "Member")
+	newKeys, err := newUserWithKeys()
+	require.NoError(t, err)
+	newKeys.ref = member.ref
+
+	_, err = signedRequest(member, "RotateKey", newKeys.pubKey)
+	require.NoError(t, err)
+
+	_, err = signedRequest(member, "GetMyBalance")
+	require.Contains(t, err.Error(), "Incorrect signature")
+
+	_, err = signedRequest(newKeys, "GetMyBalance")
+	require.NoError(t, err)
+}
+
+func TestRecovery(t *testing.T) {
+	member := createMember(t, "Member")
+	recovery, err := newUserWithKeys()
+	require.NoError(t, err)
+	recovery.ref = member.ref
+	newKeys, err := newUserWithKeys()
+	require.NoError(t, err)
+	newKeys.ref = member.ref
+
+	_, err = signedRequest(member, "SetRecoveryKey", recovery.pubKey, 0)
+	require.NoError(t, err)
+
+	_, err = signedRequest(recovery, "StartRecovery", newKeys.pubKey)
+	require.NoError(t, err)
+	_, err = signedRequest(recovery, "FinishRecovery")
+	require.NoError(t, err)
+
+	_, err = signedRequest(member, "GetMyBalance")
+	require.Contains(t, err.Error(), "Incorrect signature")
+	_, err = signedRequest(newKeys, "GetMyBalance")
+	require.NoError(t, err)
+}
+
+func TestRecoveryBeforeDelay(t *testing.T) {
+	member := createMember(t, "Member")
+	recovery, err := newUserWithKeys()
+	require.NoError(t, err)
+	recovery.ref = member.ref
+
+	_, err = signedRequest(member, "SetRecoveryKey", recovery.pubKey, 1000*1000)
+	require.NoError(t, err)
+	_, err = signedRequest(recovery, "StartRecovery", recovery.pubKey)
+	require.NoError(t, err)
+
+	_, err = signedRequest(recovery, "FinishRecovery")
+	require.Contains(t, err.Error(), "Recovery is available from pulse")
+
+	_, err = signedRequest(member, "CancelRecovery")
+	require.NoError(t, err)
+	_, err = signedRequest(recovery, "FinishRecovery")
+	require.Contains(t, err.Error(), "No recovery in progress")
+}
+
+func TestRecoveryWithoutKey(t *testing.T) {
+	member := createMember(t, "Member")
+
+	_, err := signedRequest(member, "StartRecovery", member.pubKey)
+	require.Contains(t, err.Error(), "Recovery key is not set")
+}
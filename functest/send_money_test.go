@@ -152,7 +152,37 @@ func TestTransferToMyself(t *testing.T) {
 	require.Equal(t, oldMemberBalance, newMemberBalance)
 }
 
-// TODO: test to check overflow of balance
+func TestTransferAmountAsString(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+	oldSecondBalance := getBalanceNoErr(t, secondMember, secondMember.ref)
+
+	_, err := signedRequest(firstMember, "Transfer", "111", secondMember.ref)
+	require.NoError(t, err)
+
+	checkBalanceFewTimes(t, secondMember, secondMember.ref, oldSecondBalance+111)
+}
+
+func TestTransferAmountBiggerThanUint64(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+	oldFirstBalance := getBalanceNoErr(t, firstMember, firstMember.ref)
+
+	_, err := signedRequest(firstMember, "Transfer", "100000000000000000000000000", secondMember.ref)
+	require.Contains(t, err.Error(), "[ Transfer ] Not enough balance for transfer: subtrahend must be smaller than minuend")
+
+	newFirstBalance := getBalanceNoErr(t, firstMember, firstMember.ref)
+	require.Equal(t, oldFirstBalance, newFirstBalance)
+}
+
+func TestTransferFractionalAmount(t *testing.T) {
+	firstMember := createMember(t, "Member1")
+	secondMember := createMember(t, "Member2")
+
+	_, err := signedRequest(firstMember, "Transfer", "1.5", secondMember.ref)
+	require.Contains(t, err.Error(), "[ transferCall ] Wrong amount")
+}
+
 // TODO: check transfer zero amount
 
 // TODO: uncomment after undoing of all transaction in failed request will be supported
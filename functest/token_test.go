@@ -0,0 +1,129 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func randomSymbol() string {
+	return "T" + strings.ToUpper(testutils.RandomString()[:8])
+}
+
+func getAssetBalance(t *testing.T, caller *user, asset string, reference string) int {
+	res, err := signedRequest(caller, "GetAssetBalance", asset, reference)
+	require.NoError(t, err)
+	balance, err := strconv.Atoi(res.(string))
+	require.NoError(t, err)
+	return balance
+}
+
+func TestIssueToken(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	symbol := randomSymbol()
+
+	_, err := signedRequest(issuer, "IssueToken", symbol, "Test token", 2, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 1000, getAssetBalance(t, issuer, symbol, issuer.ref))
+	// default currency is untouched
+	require.Equal(t, 1000*1000*1000, getBalanceNoErr(t, issuer, issuer.ref))
+
+	resp, err := signedRequest(issuer, "ListTokens")
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(resp.(string))
+	require.NoError(t, err)
+	var tokens []struct {
+		Symbol string
+		Supply string
+		Issuer string
+	}
+	require.NoError(t, json.Unmarshal(data, &tokens))
+	found := false
+	for _, token := range tokens {
+		if token.Symbol == symbol {
+			found = true
+			require.Equal(t, "1000", token.Supply)
+			require.Equal(t, issuer.ref, token.Issuer)
+		}
+	}
+	require.True(t, found)
+
+	_, err = signedRequest(issuer, "IssueToken", symbol, "Test token", 2, 1000)
+	require.Contains(t, err.Error(), "already exists")
+}
+
+func TestIssueTokenBadSymbol(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+
+	_, err := signedRequest(issuer, "IssueToken", "bad symbol", "Test token", 2, 1000)
+	require.Contains(t, err.Error(), "Symbol must contain only capital latin letters and digits")
+}
+
+func TestTransferAsset(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	recipient := createMember(t, "Recipient")
+	symbol := randomSymbol()
+
+	_, err := signedRequest(issuer, "IssueToken", symbol, "Test token", 0, 1000)
+	require.NoError(t, err)
+
+	_, err = signedRequest(issuer, "TransferAsset", symbol, 300, recipient.ref)
+	require.NoError(t, err)
+	require.Equal(t, 700, getAssetBalance(t, issuer, symbol, issuer.ref))
+
+	// recipient accepts transfer after sender has finished
+	balance := 0
+	for i := 0; i < 100 && balance == 0; i++ {
+		time.Sleep(100 * time.Millisecond)
+		balance = getAssetBalance(t, recipient, symbol, recipient.ref)
+	}
+	require.Equal(t, 300, balance)
+
+	_, err = signedRequest(recipient, "TransferAsset", symbol, 301, issuer.ref)
+	require.Contains(t, err.Error(), "Not enough balance for transfer")
+}
+
+func TestIssueTokenHugeSupply(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	symbol := randomSymbol()
+	supply := "1000000000000000000000000000000"
+
+	_, err := signedRequest(issuer, "IssueToken", symbol, "Test token", 18, supply)
+	require.NoError(t, err)
+
+	res, err := signedRequest(issuer, "GetAssetBalance", symbol, issuer.ref)
+	require.NoError(t, err)
+	require.Equal(t, supply, res)
+}
+
+func TestTransferUnknownAsset(t *testing.T) {
+	member := createMember(t, "Member")
+	symbol := randomSymbol()
+
+	_, err := signedRequest(member, "TransferAsset", symbol, 1, root.ref)
+	require.Contains(t, err.Error(), "No "+symbol+" on balance")
+}
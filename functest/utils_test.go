@@ -26,6 +26,7 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -115,11 +116,11 @@ func getBalance(caller *user, reference string) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	amount, ok := res.(float64)
+	amount, ok := res.(string)
 	if !ok {
-		return 0, errors.New("result is not int")
+		return 0, errors.New("result is not string")
 	}
-	return int(amount), nil
+	return strconv.Atoi(amount)
 }
 
 func getRPSResponseBody(t *testing.T, postParams map[string]interface{}) []byte {
@@ -186,11 +187,27 @@ type response struct {
 }
 
 func signedRequest(user *user, method string, params ...interface{}) (interface{}, error) {
-	ctx := context.TODO()
 	rootCfg, err := requester.CreateUserConfig(user.ref, user.privKey)
 	if err != nil {
 		return nil, err
 	}
+	return sendRequest(rootCfg, method, params...)
+}
+
+func multisigRequest(ref string, signers []*user, method string, params ...interface{}) (interface{}, error) {
+	keys := make([]string, 0, len(signers))
+	for _, s := range signers {
+		keys = append(keys, s.privKey)
+	}
+	cfg, err := requester.CreateMultisigUserConfig(ref, keys)
+	if err != nil {
+		return nil, err
+	}
+	return sendRequest(cfg, method, params...)
+}
+
+func sendRequest(rootCfg *requester.UserConfigJSON, method string, params ...interface{}) (interface{}, error) {
+	ctx := context.TODO()
 	var resp response
 	currentIterNum := 1
 	for ; currentIterNum <= sendRetryCount; currentIterNum++ {
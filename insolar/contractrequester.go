@@ -29,4 +29,8 @@ type ContractRequester interface {
 	// CallMethod - low level calls contract
 	CallMethod(ctx context.Context, msg Message) (Reply, error)
 	CallConstructor(ctx context.Context, msg Message) (*Reference, error)
+	// DryRun calls method of contract without registering request and saving state
+	DryRun(ctx context.Context, ref *Reference, method string, argsIn []interface{}) (Reply, error)
+	// DryRunMethod - low level dry-run call of contract
+	DryRunMethod(ctx context.Context, msg Message) (Reply, error)
 }
@@ -33,4 +33,6 @@ var (
 	ErrNotFound = errors.New("not found")
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached on a current LME
 	ErrTooManyPendingRequests = errors.New("the limit of pending requests count has been reached")
+	// ErrBudgetExceeded is returned when contract call consumed more resources than its budget allows
+	ErrBudgetExceeded = errors.New("execution budget exceeded")
 )
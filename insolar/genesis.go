@@ -29,6 +29,8 @@ const (
 	GenesisNameRootWallet = "wallet"
 	// GenesisNameAllowance is the name of allowance contract for genesis record.
 	GenesisNameAllowance = "allowance"
+	// GenesisNameTokenRegistry is the name of token registry contract for genesis record.
+	GenesisNameTokenRegistry = "tokenregistry"
 )
 
 type genesisBinary []byte
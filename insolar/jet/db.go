@@ -0,0 +1,202 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package jet
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// ErrTreeNotFound is returned when there is no persisted tree for a pulse.
+var ErrTreeNotFound = errors.New("jet tree not found")
+
+var (
+	_ Accessor = &DBStore{}
+	_ Modifier = &DBStore{}
+)
+
+// DBStore stores jet trees per pulse like Store and persists their structure. A tree is written for every pulse it
+// was changed in, so tree of any past pulse can be restored after restart. Restored trees have no actual jets.
+type DBStore struct {
+	*Store
+	db store.DB
+}
+
+// NewDBStore creates new DBStore instance.
+func NewDBStore(db store.DB) *DBStore {
+	return &DBStore{
+		Store: NewStore(),
+		db:    db,
+	}
+}
+
+type treeKey insolar.PulseNumber
+
+func (k treeKey) Scope() store.Scope {
+	return store.ScopeJetTree
+}
+
+func (k treeKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+// All returns all jet from jet tree for provided pulse.
+func (s *DBStore) All(ctx context.Context, pulse insolar.PulseNumber) []insolar.JetID {
+	s.load(ctx, pulse)
+	return s.Store.All(ctx, pulse)
+}
+
+// ForID finds jet in jet tree for provided pulse and object.
+// Always returns jet id and activity flag for this jet.
+func (s *DBStore) ForID(ctx context.Context, pulse insolar.PulseNumber, recordID insolar.ID) (insolar.JetID, bool) {
+	s.load(ctx, pulse)
+	return s.Store.ForID(ctx, pulse, recordID)
+}
+
+// Update updates jet tree for specified pulse.
+func (s *DBStore) Update(ctx context.Context, pulse insolar.PulseNumber, setActual bool, ids ...insolar.JetID) {
+	s.load(ctx, pulse)
+	s.Store.Update(ctx, pulse, setActual, ids...)
+	s.persist(ctx, pulse)
+}
+
+// Split performs jet split and returns resulting jet ids.
+func (s *DBStore) Split(
+	ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID,
+) (insolar.JetID, insolar.JetID, error) {
+	s.load(ctx, pulse)
+	left, right, err := s.Store.Split(ctx, pulse, id)
+	if err != nil {
+		return insolar.ZeroJetID, insolar.ZeroJetID, err
+	}
+	s.persist(ctx, pulse)
+	return left, right, nil
+}
+
+// Merge performs merge of sibling jets and returns resulting jet id.
+func (s *DBStore) Merge(
+	ctx context.Context, pulse insolar.PulseNumber, left, right insolar.JetID,
+) (insolar.JetID, error) {
+	s.load(ctx, pulse)
+	parent, err := s.Store.Merge(ctx, pulse, left, right)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	s.persist(ctx, pulse)
+	return parent, nil
+}
+
+// Clone copies tree from one pulse to another. Use it to copy past tree into new pulse.
+func (s *DBStore) Clone(ctx context.Context, from, to insolar.PulseNumber) {
+	s.load(ctx, from)
+	s.Store.Clone(ctx, from, to)
+	s.persist(ctx, to)
+}
+
+// TreeForPulse restores tree for provided pulse from persisted trees. It returns the latest tree persisted before or
+// in provided pulse.
+func (s *DBStore) TreeForPulse(ctx context.Context, pulse insolar.PulseNumber) (*Tree, error) {
+	ids, err := s.persisted(pulse)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := NewTree(false)
+	for _, id := range ids {
+		tree.Update(id, false)
+	}
+	return tree, nil
+}
+
+// load restores tree for pulse from persisted trees, if there is no tree for pulse in memory.
+func (s *DBStore) load(ctx context.Context, pulse insolar.PulseNumber) {
+	if s.has(pulse) {
+		return
+	}
+
+	tree, err := s.TreeForPulse(ctx, pulse)
+	if err == ErrTreeNotFound {
+		return
+	}
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to restore jet tree for pulse %v", pulse))
+		return
+	}
+	s.setIfMissing(pulse, tree)
+}
+
+// persist writes tree for pulse, if its structure differs from the latest persisted one.
+func (s *DBStore) persist(ctx context.Context, pulse insolar.PulseNumber) {
+	ids := s.Store.All(ctx, pulse)
+	buf := encodeIDs(ids)
+
+	prev, err := s.persisted(pulse)
+	if err == nil && bytes.Equal(buf, encodeIDs(prev)) {
+		return
+	}
+	if err != nil && err != ErrTreeNotFound {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to read jet tree for pulse %v", pulse))
+	}
+
+	err = s.db.Set(treeKey(pulse), buf)
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to write jet tree for pulse %v", pulse))
+	}
+}
+
+// persisted returns leaves of the latest tree persisted before or in provided pulse.
+func (s *DBStore) persisted(pulse insolar.PulseNumber) ([]insolar.JetID, error) {
+	it := s.db.NewIterator(treeKey(pulse), nil, true)
+	defer it.Close()
+
+	if !it.Next() {
+		return nil, ErrTreeNotFound
+	}
+	buf, err := it.Value()
+	if err != nil {
+		return nil, err
+	}
+	return decodeIDs(buf)
+}
+
+func encodeIDs(ids []insolar.JetID) []byte {
+	buf := make([]byte, 0, len(ids)*insolar.RecordIDSize)
+	for _, id := range ids {
+		buf = append(buf, id[:]...)
+	}
+	return buf
+}
+
+func decodeIDs(buf []byte) ([]insolar.JetID, error) {
+	if len(buf)%insolar.RecordIDSize != 0 {
+		return nil, errors.New("bad jet tree value")
+	}
+	ids := make([]insolar.JetID, 0, len(buf)/insolar.RecordIDSize)
+	for len(buf) > 0 {
+		var id insolar.JetID
+		copy(id[:], buf)
+		ids = append(ids, id)
+		buf = buf[insolar.RecordIDSize:]
+	}
+	return ids, nil
+}
@@ -0,0 +1,62 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+func TestDBStore_TreeForPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	s := NewDBStore(db)
+
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+	_, err := s.TreeForPulse(ctx, pn)
+	assert.Equal(t, ErrTreeNotFound, err)
+
+	s.Update(ctx, pn, true, insolar.ZeroJetID)
+	s.Clone(ctx, pn, pn+1)
+	_, _, err = s.Split(ctx, pn+1, insolar.ZeroJetID)
+	require.NoError(t, err)
+	s.Clone(ctx, pn+1, pn+2)
+	s.Clone(ctx, pn+2, pn+3)
+	_, err = s.Merge(ctx, pn+3, NewIDFromString("0"), NewIDFromString("1"))
+	require.NoError(t, err)
+
+	// Restarted node restores trees from storage.
+	restored := NewDBStore(db)
+	tree, err := restored.TreeForPulse(ctx, pn)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.JetID{insolar.ZeroJetID}, tree.LeafIDs())
+
+	split := []insolar.JetID{NewIDFromString("0"), NewIDFromString("1")}
+	tree, err = restored.TreeForPulse(ctx, pn+2)
+	require.NoError(t, err)
+	assert.Equal(t, split, tree.LeafIDs())
+	assert.Equal(t, split, restored.All(ctx, pn+1))
+
+	assert.Equal(t, []insolar.JetID{insolar.ZeroJetID}, restored.All(ctx, pn+3))
+	assert.Equal(t, []insolar.JetID{insolar.ZeroJetID}, restored.All(ctx, pn+10))
+}
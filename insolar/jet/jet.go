@@ -38,6 +38,7 @@ type Accessor interface {
 type Modifier interface {
 	Update(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID)
 	Split(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, insolar.JetID, error)
+	Merge(ctx context.Context, pulse insolar.PulseNumber, left, right insolar.JetID) (insolar.JetID, error)
 	Clone(ctx context.Context, from, to insolar.PulseNumber)
 	DeleteForPN(ctx context.Context, pulse insolar.PulseNumber)
 }
@@ -98,6 +99,19 @@ func Parent(id insolar.JetID) insolar.JetID {
 	return *insolar.NewJetID(depth-1, resetBits(prefix, depth-1))
 }
 
+// Sibling returns a sibling of the jet or jet itself if depth of provided JetID is zero.
+func Sibling(id insolar.JetID) insolar.JetID {
+	depth, prefix := id.Depth(), id.Prefix()
+	if depth == 0 {
+		return id
+	}
+
+	sibling := make([]byte, len(prefix))
+	copy(sibling, prefix)
+	sibling[(depth-1)/8] ^= 1 << (7 - (depth-1)%8)
+	return *insolar.NewJetID(depth, sibling)
+}
+
 // resetBits returns a new byte slice with all bits in 'value' reset,
 // starting from 'start' number of bit.
 //
@@ -38,6 +38,14 @@ func TestJet_Parent(t *testing.T) {
 	require.Equal(t, emptyChild, emptyParent, "for empty jet ID, got the same parent")
 }
 
+func TestJet_Sibling(t *testing.T) {
+	require.Equal(t, NewIDFromString("010100"), Sibling(NewIDFromString("010101")))
+	require.Equal(t, NewIDFromString("1"), Sibling(NewIDFromString("0")))
+
+	emptyID := *insolar.NewJetID(0, nil)
+	require.Equal(t, emptyID, Sibling(emptyID), "for empty jet ID, got the same sibling")
+}
+
 func TestJet_ResetBits(t *testing.T) {
 	orig := []byte{0xFF}
 	got := resetBits(orig, 5)
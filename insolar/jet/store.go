@@ -63,6 +63,12 @@ func (lt *lockedTree) split(id insolar.JetID) (insolar.JetID, insolar.JetID, err
 	return lt.t.Split(id)
 }
 
+func (lt *lockedTree) merge(left, right insolar.JetID) (insolar.JetID, error) {
+	lt.Lock()
+	defer lt.Unlock()
+	return lt.t.Merge(left, right)
+}
+
 // Store stores jet trees per pulse.
 // It provides methods for querying and modification this trees.
 type Store struct {
@@ -113,6 +119,13 @@ func (s *Store) Split(
 	return left, right, nil
 }
 
+// Merge performs merge of sibling jets and returns resulting jet id.
+func (s *Store) Merge(
+	ctx context.Context, pulse insolar.PulseNumber, left, right insolar.JetID,
+) (insolar.JetID, error) {
+	return s.ltreeForPulse(pulse).merge(left, right)
+}
+
 // Clone copies tree from one pulse to another. Use it to copy past tree into new pulse.
 func (s *Store) Clone(
 	ctx context.Context, from, to insolar.PulseNumber,
@@ -135,6 +148,23 @@ func (s *Store) DeleteForPN(
 	delete(s.trees, pulse)
 }
 
+// has checks if there is a tree for pulse.
+func (s *Store) has(pulse insolar.PulseNumber) bool {
+	s.RLock()
+	defer s.RUnlock()
+	_, ok := s.trees[pulse]
+	return ok
+}
+
+// setIfMissing sets tree for pulse, if there is no tree for it yet.
+func (s *Store) setIfMissing(pulse insolar.PulseNumber, tree *Tree) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.trees[pulse]; !ok {
+		s.trees[pulse] = &lockedTree{t: tree}
+	}
+}
+
 // ltreeForPulse returns jet tree with lock for pulse, it's concurrent safe.
 func (s *Store) ltreeForPulse(pulse insolar.PulseNumber) *lockedTree {
 	s.Lock()
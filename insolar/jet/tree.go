@@ -52,7 +52,10 @@ func (j *jet) Find(val []byte, depth uint8) (*jet, uint8) {
 func (j *jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	if depth == maxDepth {
 		if setActual {
+			// Actual jet can't have branches. They are left from a tree, where the jet was not merged yet.
 			j.Actual = true
+			j.Left = nil
+			j.Right = nil
 		}
 		return
 	}
@@ -70,6 +73,21 @@ func (j *jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	}
 }
 
+// Get returns jet with provided prefix and depth or nil if there is no such jet in the tree.
+func (j *jet) Get(prefix []byte, maxDepth, depth uint8) *jet {
+	if j == nil || depth == maxDepth {
+		return j
+	}
+	if getBit(prefix, depth) {
+		return j.Right.Get(prefix, maxDepth, depth+1)
+	}
+	return j.Left.Get(prefix, maxDepth, depth+1)
+}
+
+func (j *jet) isLeaf() bool {
+	return j.Left == nil && j.Right == nil
+}
+
 // Clone clones tree either keeping actuality state or resetting it to false.
 func (j *jet) Clone(keep bool) *jet {
 	res := &jet{
@@ -165,6 +183,25 @@ func (t *Tree) Split(id insolar.JetID) (insolar.JetID, insolar.JetID, error) {
 	return *left, *right, nil
 }
 
+// Merge looks for provided sibling jets and removes them from the tree (and returns their parent).
+// If provided jets are not sibling leaves of the tree, an error will be returned.
+func (t *Tree) Merge(left, right insolar.JetID) (insolar.JetID, error) {
+	parent := Parent(left)
+	if left.Depth() == 0 || left == right || Parent(right) != parent {
+		return insolar.ZeroJetID, errors.New("failed to merge: provided jets are not siblings")
+	}
+
+	j := t.Head.Get(parent.Prefix(), parent.Depth(), 0)
+	if j == nil || j.Left == nil || j.Right == nil || !j.Left.isLeaf() || !j.Right.isLeaf() {
+		return insolar.ZeroJetID, errors.New("failed to merge: incorrect jets provided")
+	}
+
+	j.Left = nil
+	j.Right = nil
+
+	return parent, nil
+}
+
 func (t *Tree) LeafIDs() []insolar.JetID {
 	var ids []insolar.JetID
 	t.Head.ExtractLeafIDs(&ids, make([]byte, insolar.RecordHashSize), 0)
@@ -63,6 +63,12 @@ func TestTree_Update(t *testing.T) {
 	assert.Equal(t, uint8(8), depth)
 	assert.Equal(t, lookup.Hash()[:insolar.RecordHashSize-1], prefix)
 	assert.Equal(t, true, actual)
+
+	// Actual jet removes branches below it.
+	tree.Update(*insolar.NewJetID(1, []byte{1 << 7}), true)
+	id, actual = tree.Find(*lookup)
+	assert.Equal(t, uint8(1), id.Depth())
+	assert.Equal(t, true, actual)
 }
 
 func TestTree_Find(t *testing.T) {
@@ -134,6 +140,46 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Merge(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Left: &jet{},
+			Right: &jet{
+				Left: &jet{
+					Left:  &jet{},
+					Right: &jet{},
+				},
+				Right: &jet{},
+			},
+		},
+	}
+
+	t.Run("not siblings return error", func(t *testing.T) {
+		_, err := tree.Merge(NewIDFromString("0"), NewIDFromString("11"))
+		assert.Error(t, err)
+		_, err = tree.Merge(NewIDFromString("0"), NewIDFromString("0"))
+		assert.Error(t, err)
+	})
+
+	t.Run("not leaves return error", func(t *testing.T) {
+		_, err := tree.Merge(NewIDFromString("10"), NewIDFromString("11"))
+		assert.Error(t, err)
+		_, err = tree.Merge(NewIDFromString("000"), NewIDFromString("001"))
+		assert.Error(t, err)
+	})
+
+	t.Run("merges jets", func(t *testing.T) {
+		parent, err := tree.Merge(NewIDFromString("101"), NewIDFromString("100"))
+		require.NoError(t, err)
+		assert.Equal(t, NewIDFromString("10"), parent)
+		assert.Equal(t, []insolar.JetID{
+			NewIDFromString("0"),
+			NewIDFromString("10"),
+			NewIDFromString("11"),
+		}, tree.LeafIDs())
+	})
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
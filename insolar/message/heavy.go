@@ -29,6 +29,7 @@ type HeavyPayload struct {
 	Drop         []byte
 	Blobs        [][]byte
 	Records      [][]byte
+	TypeIndex    [][]byte
 }
 
 // AllowedSenderObjectAndRole implements interface method
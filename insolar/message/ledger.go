@@ -226,6 +226,34 @@ func (*GetChildren) Type() insolar.MessageType {
 	return insolar.TypeGetChildren
 }
 
+// GetObjectHistory retrieves a chunk of object states starting from the provided one and following PrevState links.
+type GetObjectHistory struct {
+	ledgerMessage
+	Head      insolar.Reference
+	FromState *insolar.ID // If nil, will start from the latest state.
+	Amount    int
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetObjectHistory) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return &m.Head, insolar.DynamicRoleVirtualExecutor
+}
+
+// DefaultRole returns role for this event
+func (*GetObjectHistory) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetObjectHistory) DefaultTarget() *insolar.Reference {
+	return &m.Head
+}
+
+// Type implementation of Message interface.
+func (*GetObjectHistory) Type() insolar.MessageType {
+	return insolar.TypeGetObjectHistory
+}
+
 // ValidateRecord creates VM validation for specific object record.
 type ValidateRecord struct {
 	ledgerMessage
@@ -311,6 +339,67 @@ func (*GetObjectIndex) Type() insolar.MessageType {
 	return insolar.TypeGetObjectIndex
 }
 
+// GetObjectRequests retrieves a chunk of object requests with their results from newer to older.
+type GetObjectRequests struct {
+	ledgerMessage
+
+	Object      insolar.Reference
+	FromRequest *insolar.ID // If nil, will start from the latest request.
+	Amount      int
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetObjectRequests) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return &m.Object, insolar.DynamicRoleVirtualExecutor
+}
+
+// DefaultRole returns role for this event
+func (*GetObjectRequests) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetObjectRequests) DefaultTarget() *insolar.Reference {
+	return &m.Object
+}
+
+// Type implementation of Message interface.
+func (*GetObjectRequests) Type() insolar.MessageType {
+	return insolar.TypeGetObjectRequests
+}
+
+// GetObjectEvents retrieves a chunk of events emitted by object from newer to older requests.
+type GetObjectEvents struct {
+	ledgerMessage
+
+	Object      insolar.Reference
+	Method      string              // If not empty, only events of the method calls are returned.
+	FromPulse   insolar.PulseNumber // If not zero, events of requests from older pulses are skipped.
+	ToPulse     insolar.PulseNumber // If not zero, events of requests from newer pulses are skipped.
+	FromRequest *insolar.ID         // If nil, will start from the latest request.
+	Amount      int
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetObjectEvents) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return &m.Object, insolar.DynamicRoleVirtualExecutor
+}
+
+// DefaultRole returns role for this event
+func (*GetObjectEvents) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleHeavyExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetObjectEvents) DefaultTarget() *insolar.Reference {
+	return &m.Object
+}
+
+// Type implementation of Message interface.
+func (*GetObjectEvents) Type() insolar.MessageType {
+	return insolar.TypeGetObjectEvents
+}
+
 // HotData contains hot-data
 type HotData struct {
 	ledgerMessage
@@ -319,6 +408,8 @@ type HotData struct {
 	HotIndexes      []HotIndex
 	PendingRequests map[insolar.ID]recentstorage.PendingObjectContext
 	PulseNumber     insolar.PulseNumber
+	// Parts is a number of hot data messages sent for the jet. Merged jet receives one from each sibling.
+	Parts int
 }
 
 // AllowedSenderObjectAndRole implements interface method
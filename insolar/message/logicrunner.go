@@ -108,6 +108,26 @@ func (cm *CallMethod) Type() insolar.MessageType {
 	return insolar.TypeCallMethod
 }
 
+// DryRunMethod - call method on current state of object, request isn't registered and state isn't saved
+type DryRunMethod struct {
+	CallMethod
+
+	// Root is a request of the top dry-run call, it's empty in the top call itself
+	Root *insolar.Reference
+	// Overlay contains objects changed by the dry-run before this call, they are used instead of ledger state
+	Overlay []insolar.StateDiff
+}
+
+// Type returns TypeDryRunMethod.
+func (dr *DryRunMethod) Type() insolar.MessageType {
+	return insolar.TypeDryRunMethod
+}
+
+// RequestRef returns reference of request for call context, it's never registered on ledger.
+func (dr *DryRunMethod) RequestRef(pn insolar.PulseNumber) *insolar.Reference {
+	return genRequest(pn, MustSerializeBytes(dr), &insolar.DomainID)
+}
+
 // TODO rename to executorObjectResult (results?)
 type ExecutorResults struct {
 	Caller                insolar.Reference
@@ -165,6 +185,8 @@ type CaseBindRequest struct {
 	MessageBusTape []byte
 	Reply          insolar.Reply
 	Error          string
+	State          *insolar.ID
+	Result         *insolar.ID
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -297,3 +319,30 @@ func (se *StillExecuting) DefaultTarget() *insolar.Reference {
 func (se *StillExecuting) Type() insolar.MessageType {
 	return insolar.TypeStillExecuting
 }
+
+// GetCallTrace is sent to a node which executed the request to get tree of calls it executed for the request.
+// The receiver is specified explicitly, it's the executor of the object in the pulse of the request.
+type GetCallTrace struct {
+	Object  insolar.Reference
+	Request insolar.Reference
+}
+
+func (gt *GetCallTrace) GetCaller() *insolar.Reference {
+	return nil
+}
+
+func (gt *GetCallTrace) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, 0
+}
+
+func (gt *GetCallTrace) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleVirtualExecutor
+}
+
+func (gt *GetCallTrace) DefaultTarget() *insolar.Reference {
+	return &gt.Object
+}
+
+func (gt *GetCallTrace) Type() insolar.MessageType {
+	return insolar.TypeGetCallTrace
+}
@@ -49,6 +49,10 @@ func getEmptyMessage(mt insolar.MessageType) (insolar.Message, error) {
 		return &PendingFinished{}, nil
 	case insolar.TypeStillExecuting:
 		return &StillExecuting{}, nil
+	case insolar.TypeDryRunMethod:
+		return &DryRunMethod{}, nil
+	case insolar.TypeGetCallTrace:
+		return &GetCallTrace{}, nil
 
 	// Ledger
 	case insolar.TypeGetCode:
@@ -77,6 +81,12 @@ func getEmptyMessage(mt insolar.MessageType) (insolar.Message, error) {
 		return &GetPendingRequestID{}, nil
 	case insolar.TypeGetRequest:
 		return &GetRequest{}, nil
+	case insolar.TypeGetObjectHistory:
+		return &GetObjectHistory{}, nil
+	case insolar.TypeGetObjectRequests:
+		return &GetObjectRequests{}, nil
+	case insolar.TypeGetObjectEvents:
+		return &GetObjectEvents{}, nil
 
 	// heavy sync
 	case insolar.TypeHeavyPayload:
@@ -191,6 +201,8 @@ func init() {
 
 	// Logicrunner
 	gob.Register(&CallMethod{})
+	gob.Register(&DryRunMethod{})
+	gob.Register(&GetCallTrace{})
 	gob.Register(&ReturnResults{})
 	gob.Register(&ExecutorResults{})
 	gob.Register(&ValidateCaseBind{})
@@ -214,6 +226,9 @@ func init() {
 	gob.Register(&HotData{})
 	gob.Register(&GetPendingRequestID{})
 	gob.Register(&GetRequest{})
+	gob.Register(&GetObjectHistory{})
+	gob.Register(&GetObjectRequests{})
+	gob.Register(&GetObjectEvents{})
 
 	// heavy
 	gob.Register(&HeavyPayload{})
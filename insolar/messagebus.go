@@ -191,6 +191,10 @@ const (
 	// TypeStillExecuting is sent by an old executor on pulse switch if it wants to continue executing
 	// to the current executor
 	TypeStillExecuting
+	// TypeDryRunMethod calls method without registering request and saving state
+	TypeDryRunMethod
+	// TypeGetCallTrace retrieves tree of calls executed by the node for a request
+	TypeGetCallTrace
 
 	// Ledger
 
@@ -226,6 +230,12 @@ const (
 	TypeGetRequest
 	// TypeGetPendingRequestID fetches a pending request id from ledger
 	TypeGetPendingRequestID
+	// TypeGetObjectHistory fetches object states chain from ledger.
+	TypeGetObjectHistory
+	// TypeGetObjectRequests fetches requests of object with their results from ledger.
+	TypeGetObjectRequests
+	// TypeGetObjectEvents fetches events emitted by object from ledger.
+	TypeGetObjectEvents
 
 	// Heavy replication
 
@@ -15,31 +15,36 @@ func _() {
 	_ = x[TypeValidationResults-4]
 	_ = x[TypePendingFinished-5]
 	_ = x[TypeStillExecuting-6]
-	_ = x[TypeGetCode-7]
-	_ = x[TypeGetObject-8]
-	_ = x[TypeGetDelegate-9]
-	_ = x[TypeGetChildren-10]
-	_ = x[TypeUpdateObject-11]
-	_ = x[TypeRegisterChild-12]
-	_ = x[TypeSetRecord-13]
-	_ = x[TypeValidateRecord-14]
-	_ = x[TypeSetBlob-15]
-	_ = x[TypeGetObjectIndex-16]
-	_ = x[TypeGetPendingRequests-17]
-	_ = x[TypeHotRecords-18]
-	_ = x[TypeGetJet-19]
-	_ = x[TypeAbandonedRequestsNotification-20]
-	_ = x[TypeGetRequest-21]
-	_ = x[TypeGetPendingRequestID-22]
-	_ = x[TypeHeavyStartStop-23]
-	_ = x[TypeHeavyPayload-24]
-	_ = x[TypeGenesisRequest-25]
-	_ = x[TypeNodeSignRequest-26]
+	_ = x[TypeDryRunMethod-7]
+	_ = x[TypeGetCallTrace-8]
+	_ = x[TypeGetCode-9]
+	_ = x[TypeGetObject-10]
+	_ = x[TypeGetDelegate-11]
+	_ = x[TypeGetChildren-12]
+	_ = x[TypeUpdateObject-13]
+	_ = x[TypeRegisterChild-14]
+	_ = x[TypeSetRecord-15]
+	_ = x[TypeValidateRecord-16]
+	_ = x[TypeSetBlob-17]
+	_ = x[TypeGetObjectIndex-18]
+	_ = x[TypeGetPendingRequests-19]
+	_ = x[TypeHotRecords-20]
+	_ = x[TypeGetJet-21]
+	_ = x[TypeAbandonedRequestsNotification-22]
+	_ = x[TypeGetRequest-23]
+	_ = x[TypeGetPendingRequestID-24]
+	_ = x[TypeGetObjectHistory-25]
+	_ = x[TypeGetObjectRequests-26]
+	_ = x[TypeGetObjectEvents-27]
+	_ = x[TypeHeavyStartStop-28]
+	_ = x[TypeHeavyPayload-29]
+	_ = x[TypeGenesisRequest-30]
+	_ = x[TypeNodeSignRequest-31]
 }
 
-const _MessageType_name = "TypeCallMethodTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeHeavyStartStopTypeHeavyPayloadTypeGenesisRequestTypeNodeSignRequest"
+const _MessageType_name = "TypeCallMethodTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeDryRunMethodTypeGetCallTraceTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeGetObjectHistoryTypeGetObjectRequestsTypeGetObjectEventsTypeHeavyStartStopTypeHeavyPayloadTypeGenesisRequestTypeNodeSignRequest"
 
-var _MessageType_index = [...]uint16{0, 14, 31, 50, 70, 91, 110, 128, 139, 152, 167, 182, 198, 215, 228, 246, 257, 275, 297, 311, 321, 354, 368, 391, 409, 425, 443, 462}
+var _MessageType_index = [...]uint16{0, 14, 31, 50, 70, 91, 110, 128, 144, 160, 171, 184, 199, 214, 230, 247, 260, 278, 289, 307, 329, 343, 353, 386, 400, 423, 443, 464, 483, 501, 517, 535, 554}
 
 func (i MessageType) String() string {
 	if i >= MessageType(len(_MessageType_index)-1) {
@@ -201,6 +201,24 @@ func (s *DB) Backwards(ctx context.Context, pn insolar.PulseNumber, steps int) (
 	return iterator.Pulse, nil
 }
 
+// ResetHead makes provided pulse the latest one. Links to later pulses are dropped, but the pulses themselves stay in
+// storage. It's used when storage is restored from a snapshot. If pulse does not exist, ErrNotFound will be returned.
+func (s *DB) ResetHead(ctx context.Context, pn insolar.PulseNumber) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	node, err := s.get(pn)
+	if err != nil {
+		return err
+	}
+	node.Next = nil
+	err = s.set(pn, node)
+	if err != nil {
+		return err
+	}
+	return s.setHead(pn)
+}
+
 func (s *DB) get(pn insolar.PulseNumber) (nd dbNode, err error) {
 	buf, err := s.db.Get(pulseKey(pn))
 	if err == store.ErrNotFound {
@@ -203,6 +203,7 @@ type Request struct {
 	Prototype       *github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,29,opt,name=Prototype,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Prototype,omitempty"`
 	Method          string                                        `protobuf:"bytes,30,opt,name=Method,proto3" json:"Method,omitempty"`
 	Arguments       []byte                                        `protobuf:"bytes,31,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
+	ParentRequest   *github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,32,opt,name=ParentRequest,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"ParentRequest,omitempty"`
 }
 
 func (m *Request) Reset()      { *m = Request{} }
@@ -242,6 +243,7 @@ type Result struct {
 	Object    github_com_insolar_insolar_insolar.ID        `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"Object"`
 	Request   github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,21,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
 	Payload   []byte                                       `protobuf:"bytes,22,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	Events    []byte                                       `protobuf:"bytes,23,opt,name=Events,proto3" json:"Events,omitempty"`
 }
 
 func (m *Result) Reset()      { *m = Result{} }
@@ -939,75 +941,76 @@ func init() {
 func init() { proto.RegisterFile("insolar/record/record.proto", fileDescriptor_0c86cc3f6f53fe45) }
 
 var fileDescriptor_0c86cc3f6f53fe45 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
+	// 1094 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xde, 0x89, 0x7f, 0xc4, 0x79, 0x49, 0x5a, 0x33, 0x0a, 0x61, 0x9a, 0xb6, 0x1b, 0xcb, 0x52,
-	0x24, 0x57, 0xa5, 0x4e, 0x15, 0x2a, 0x84, 0xb8, 0x39, 0x76, 0x8b, 0x1d, 0xea, 0x10, 0x4d, 0x2c,
-	0xe0, 0x84, 0x34, 0xb1, 0x27, 0xf6, 0x96, 0xf5, 0x6e, 0xd8, 0x9d, 0x8d, 0x94, 0x1b, 0x7f, 0x02,
-	0x17, 0x4e, 0x5c, 0x38, 0xf6, 0x6f, 0xe0, 0xc4, 0x05, 0x29, 0xc7, 0x1c, 0x2b, 0x24, 0x2a, 0xe2,
-	0x08, 0x09, 0x6e, 0x11, 0x7f, 0x01, 0x9a, 0x1f, 0xbb, 0xeb, 0x44, 0xa8, 0x4e, 0x1d, 0x84, 0x54,
-	0xc4, 0xc9, 0x3b, 0x6f, 0xbf, 0xf9, 0xbc, 0xdf, 0x37, 0xf3, 0xde, 0xbc, 0x81, 0xdb, 0x8e, 0x17,
-	0xfa, 0x2e, 0x0b, 0xd6, 0x03, 0xde, 0xf5, 0x83, 0x9e, 0xf9, 0xa9, 0x1e, 0x04, 0xbe, 0xf0, 0x71,
-	0x5e, 0x8f, 0x56, 0x1e, 0xf4, 0x1d, 0x31, 0x88, 0xf6, 0xaa, 0x5d, 0x7f, 0xb8, 0xde, 0xf7, 0xfb,
-	0xfe, 0xba, 0x7a, 0xbd, 0x17, 0xed, 0xab, 0x91, 0x1a, 0xa8, 0x27, 0x3d, 0xad, 0x5c, 0x83, 0xd9,
-	0x8f, 0xb8, 0xc7, 0x43, 0x27, 0xc4, 0x77, 0x60, 0xee, 0xc0, 0x77, 0x8f, 0x86, 0x7e, 0x70, 0x30,
-	0x20, 0xc5, 0x12, 0xaa, 0xe4, 0x68, 0x1a, 0xc0, 0x18, 0xb2, 0x4d, 0x16, 0x0e, 0xc8, 0x52, 0x09,
-	0x55, 0x16, 0xa8, 0x7a, 0xfe, 0x30, 0xfb, 0xfc, 0xfb, 0x55, 0x54, 0xfe, 0x11, 0x41, 0xae, 0x3e,
-	0x70, 0xdc, 0xde, 0x04, 0x86, 0x8f, 0x61, 0x6e, 0x27, 0xe0, 0x87, 0x0a, 0xaa, 0x69, 0x36, 0x1f,
-	0x1c, 0xbf, 0x5c, 0xb5, 0x7e, 0x7e, 0xb9, 0xba, 0x36, 0xf6, 0xd1, 0xb1, 0xc8, 0x4b, 0xbf, 0xd5,
-	0x56, 0x83, 0xa6, 0xf3, 0xf1, 0x13, 0xc8, 0x50, 0xbe, 0x4f, 0xde, 0x56, 0x34, 0x8f, 0x0c, 0xcd,
-	0xbb, 0x57, 0xa0, 0xa1, 0x7c, 0x9f, 0x07, 0xdc, 0xeb, 0x72, 0x2a, 0x09, 0x8c, 0x84, 0x7b, 0x90,
-	0xd9, 0xe2, 0xe2, 0xd5, 0xdf, 0x6f, 0xa0, 0xdf, 0xe5, 0x61, 0x96, 0xf2, 0xaf, 0x22, 0x1e, 0x4e,
-	0xc0, 0xe3, 0x2a, 0x14, 0xea, 0xcc, 0x75, 0x3b, 0x47, 0x07, 0x5c, 0xc9, 0xbd, 0xb1, 0x81, 0xab,
-	0x66, 0xc9, 0x0c, 0x41, 0xb5, 0xde, 0xa1, 0x09, 0x06, 0x3f, 0x85, 0xbc, 0x7c, 0xe6, 0xc1, 0xb5,
-	0x54, 0x19, 0x0e, 0xfc, 0x05, 0xdc, 0xd4, 0x4f, 0x3b, 0x72, 0x9d, 0x85, 0xfc, 0x88, 0xe5, 0x6b,
-	0xd0, 0x5e, 0x26, 0xc3, 0x4b, 0x90, 0xdb, 0xf6, 0xbd, 0x2e, 0x27, 0xef, 0x94, 0x50, 0x25, 0x4b,
-	0xf5, 0x00, 0xaf, 0x40, 0x61, 0x57, 0x6a, 0x93, 0x2f, 0x88, 0x7a, 0x91, 0x8c, 0xf1, 0x06, 0x00,
-	0xe5, 0x22, 0x0a, 0xbc, 0xb6, 0xdf, 0xe3, 0xe4, 0xd6, 0xdf, 0x3b, 0x42, 0xdb, 0x74, 0x0c, 0x25,
-	0x1d, 0x6e, 0x0d, 0x87, 0x91, 0x60, 0x7b, 0x2e, 0x27, 0x2b, 0x25, 0x54, 0x29, 0xd0, 0x34, 0x80,
-	0x1b, 0x90, 0xdd, 0x64, 0x21, 0x27, 0xb7, 0x95, 0xb0, 0x87, 0xaf, 0x2d, 0x4a, 0xcd, 0xc6, 0x4d,
-	0xc8, 0x7f, 0xb2, 0xf7, 0x8c, 0x77, 0x05, 0xb9, 0x33, 0x25, 0x8f, 0x99, 0x8f, 0xb7, 0xe5, 0x0e,
-	0x8f, 0xdd, 0xbe, 0x3b, 0x25, 0x59, 0x4a, 0x81, 0x97, 0x21, 0xdf, 0xe6, 0x62, 0xe0, 0xf7, 0x88,
-	0x5d, 0x42, 0x95, 0x39, 0x6a, 0x46, 0xd2, 0x95, 0x5a, 0xd0, 0x8f, 0x86, 0xdc, 0x13, 0x21, 0x59,
-	0x55, 0x09, 0x99, 0x06, 0xca, 0x5b, 0x30, 0x53, 0xef, 0xe0, 0x05, 0x28, 0xd4, 0x3b, 0x1a, 0x5f,
-	0xb4, 0xf0, 0x5b, 0xb0, 0x58, 0xef, 0xec, 0xb2, 0x43, 0x5e, 0x0b, 0x55, 0xfe, 0x14, 0x11, 0x5e,
-	0x82, 0x62, 0x1c, 0x6a, 0x70, 0x97, 0xf7, 0x99, 0xe0, 0xc5, 0x19, 0xbc, 0x08, 0x73, 0xf5, 0x8e,
-	0xa9, 0x08, 0xc5, 0x4c, 0xb9, 0x02, 0x33, 0xb4, 0x8d, 0x8b, 0xb0, 0xa0, 0xd7, 0x84, 0xf2, 0x30,
-	0x72, 0x45, 0xd1, 0x4a, 0x23, 0xdb, 0xfe, 0x67, 0xcc, 0x11, 0x45, 0x64, 0xb2, 0xe3, 0x17, 0x04,
-	0x79, 0x0d, 0x9a, 0x90, 0x1c, 0x8f, 0x13, 0xd3, 0xa7, 0xaa, 0x04, 0xa9, 0xe3, 0x71, 0x32, 0x5e,
-	0x2b, 0x69, 0x92, 0x8c, 0x26, 0x30, 0xbb, 0xc3, 0x8e, 0x5c, 0x9f, 0xf5, 0x74, 0xb6, 0xd0, 0x78,
-	0x68, 0xf4, 0xfd, 0x89, 0x20, 0xab, 0x92, 0xf5, 0xd5, 0xea, 0x9e, 0x42, 0xbe, 0xe1, 0x0f, 0x99,
-	0xe3, 0x19, 0x75, 0x53, 0xa6, 0xb2, 0xe6, 0xf8, 0xc7, 0x45, 0x56, 0xe0, 0xa6, 0xd4, 0xd0, 0xe0,
-	0x5d, 0x97, 0x05, 0x4c, 0x38, 0xbe, 0x67, 0xc4, 0x5e, 0x0e, 0x1b, 0xd1, 0xbf, 0xcd, 0x40, 0xb6,
-	0x6e, 0xb2, 0xf1, 0x8d, 0x15, 0x5d, 0xd3, 0x1a, 0x4c, 0x11, 0x7c, 0xcd, 0xed, 0xa6, 0xe5, 0x7f,
-	0x0e, 0xf3, 0x6d, 0xd6, 0x1d, 0x38, 0x1e, 0x57, 0x35, 0x5d, 0x16, 0xbe, 0xc5, 0xcd, 0xf7, 0x0d,
-	0x53, 0xf5, 0x0a, 0x4c, 0x63, 0xb3, 0xe9, 0x38, 0x95, 0xf1, 0xf9, 0x8f, 0x0c, 0x14, 0x6a, 0x5d,
-	0xe1, 0x1c, 0x32, 0xf1, 0x66, 0x7b, 0xfd, 0x58, 0xd6, 0xad, 0xa1, 0x1f, 0x1c, 0x4d, 0xe7, 0xb6,
-	0x99, 0x8c, 0xb7, 0x20, 0xd7, 0x1a, 0xb2, 0xbe, 0x76, 0x7a, 0xda, 0x8f, 0xd2, 0x14, 0xb8, 0x04,
-	0xf3, 0xad, 0x30, 0x2d, 0xce, 0x44, 0x1d, 0x25, 0xe3, 0x21, 0x69, 0xe9, 0x0e, 0x0b, 0xb8, 0x27,
-	0xd4, 0xd1, 0x34, 0xb5, 0xa5, 0x9a, 0x03, 0xdb, 0x00, 0xad, 0xa4, 0xae, 0x9a, 0x93, 0x6b, 0x2c,
-	0x52, 0xfe, 0x29, 0x03, 0xb9, 0xda, 0x90, 0x7b, 0xbd, 0xff, 0x17, 0xfa, 0xdf, 0x5e, 0x68, 0xd3,
-	0x87, 0xee, 0x0a, 0xb9, 0x32, 0xb7, 0xa6, 0xee, 0x43, 0xd5, 0xfc, 0xf2, 0xb7, 0x33, 0x00, 0x0d,
-	0xce, 0xfe, 0x0b, 0x59, 0x7b, 0xc1, 0x97, 0xe5, 0x6b, 0xfa, 0x72, 0x9e, 0x81, 0xd9, 0x4f, 0x9d,
-	0x40, 0x44, 0xcc, 0x9d, 0x60, 0xca, 0xfd, 0xe4, 0x06, 0x42, 0x78, 0x09, 0x55, 0xe6, 0x37, 0x6e,
-	0xc6, 0x3d, 0xa1, 0x09, 0x37, 0x2d, 0x9a, 0xdc, 0x51, 0xd6, 0xcc, 0x55, 0x83, 0xec, 0x2b, 0xe8,
-	0x62, 0x0c, 0x55, 0xc1, 0xa6, 0x45, 0xcd, 0x45, 0x64, 0x55, 0xf5, 0xf3, 0xa4, 0xaf, 0x40, 0xf3,
-	0x31, 0x68, 0x8b, 0x8b, 0xa6, 0x45, 0x55, 0xa7, 0x7f, 0x3f, 0xf5, 0x6e, 0x70, 0xf1, 0x4f, 0x4d,
-	0x58, 0xfe, 0x69, 0x7a, 0x5e, 0x9a, 0x9e, 0x86, 0x38, 0x0a, 0x7b, 0x23, 0xc5, 0xca, 0x68, 0xd3,
-	0xa2, 0x71, 0xcf, 0x53, 0xd6, 0xdd, 0x01, 0x79, 0xa6, 0x70, 0x0b, 0x31, 0x4e, 0xc6, 0x9a, 0x16,
-	0xd5, 0x9d, 0x43, 0xd9, 0x1c, 0x44, 0x5f, 0x5e, 0xc4, 0xc8, 0x98, 0xc4, 0xa8, 0x93, 0xa6, 0x9a,
-	0x1e, 0x04, 0xc4, 0x55, 0xb8, 0x62, 0x8c, 0x8b, 0xe3, 0x4d, 0x8b, 0xa6, 0x87, 0xc5, 0x9a, 0x29,
-	0x26, 0x64, 0x78, 0xd1, 0x16, 0x15, 0x94, 0xb6, 0xe8, 0x52, 0xf3, 0x68, 0x7c, 0xaf, 0x12, 0x4f,
-	0x61, 0x93, 0x0e, 0x3c, 0x7d, 0xd3, 0xb4, 0xe8, 0xf8, 0x9e, 0xbe, 0x0b, 0x73, 0xbb, 0x4e, 0xdf,
-	0x63, 0x22, 0x0a, 0x38, 0x39, 0x46, 0xba, 0xdd, 0x4c, 0x22, 0x9b, 0xb3, 0x90, 0x8b, 0x3c, 0xc7,
-	0xf7, 0xca, 0x3f, 0x20, 0x28, 0xb4, 0x99, 0xe0, 0x81, 0x33, 0x71, 0xcd, 0xef, 0x25, 0x9b, 0x43,
-	0x65, 0xc2, 0x98, 0xfd, 0x26, 0x4c, 0x93, 0xcd, 0xf3, 0x04, 0x72, 0x5b, 0x5c, 0xb4, 0x1a, 0x66,
-	0x8f, 0x3f, 0x34, 0x3b, 0xb2, 0x72, 0x85, 0x1d, 0xa9, 0xe6, 0x51, 0x3d, 0x7d, 0x92, 0x8a, 0x0f,
-	0x8e, 0x4f, 0x6d, 0xeb, 0xe4, 0xd4, 0xb6, 0x5e, 0x9c, 0xda, 0xd6, 0xf9, 0xa9, 0x8d, 0xbe, 0x1e,
-	0xd9, 0xe8, 0xf9, 0xc8, 0x46, 0xc7, 0x23, 0x1b, 0x9d, 0x8c, 0x6c, 0xf4, 0xeb, 0xc8, 0x46, 0xbf,
-	0x8f, 0x6c, 0xeb, 0x7c, 0x64, 0xa3, 0x6f, 0xce, 0x6c, 0xeb, 0xe4, 0xcc, 0xb6, 0x5e, 0x9c, 0xd9,
-	0xd6, 0x5e, 0x5e, 0x5d, 0xa4, 0xdf, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x75, 0x52, 0x0c, 0x3f,
-	0x9e, 0x0f, 0x00, 0x00,
+	0x14, 0xde, 0x89, 0x7f, 0xc4, 0x7e, 0x49, 0x5a, 0x33, 0x0a, 0xe9, 0x24, 0x6d, 0x37, 0x96, 0xa5,
+	0x48, 0xae, 0xa0, 0x4e, 0x15, 0x2a, 0x84, 0xb8, 0x39, 0x76, 0x8a, 0x1d, 0xea, 0x10, 0x4d, 0xac,
+	0xc2, 0x09, 0x69, 0x62, 0x4f, 0xec, 0x2d, 0xeb, 0xdd, 0xb0, 0x3b, 0x1b, 0x29, 0x37, 0xfe, 0x04,
+	0x2e, 0xdc, 0x39, 0xf6, 0x6f, 0xe0, 0xc4, 0x05, 0x29, 0xc7, 0x1c, 0x2b, 0x0e, 0x15, 0x71, 0x84,
+	0x04, 0xb7, 0x08, 0xc4, 0x1d, 0xcd, 0xec, 0xec, 0xae, 0x1d, 0xa1, 0x3a, 0xd8, 0x08, 0xa9, 0x88,
+	0xd3, 0xee, 0x7c, 0xf3, 0xcd, 0xb7, 0xf3, 0xbe, 0x37, 0x6f, 0x66, 0x16, 0xee, 0x5a, 0x8e, 0xef,
+	0xda, 0xcc, 0xdb, 0xf4, 0x78, 0xc7, 0xf5, 0xba, 0xfa, 0x51, 0x39, 0xf6, 0x5c, 0xe1, 0xe2, 0x6c,
+	0xd8, 0x5a, 0x7b, 0xd8, 0xb3, 0x44, 0x3f, 0x38, 0xac, 0x74, 0xdc, 0xc1, 0x66, 0xcf, 0xed, 0xb9,
+	0x9b, 0xaa, 0xfb, 0x30, 0x38, 0x52, 0x2d, 0xd5, 0x50, 0x6f, 0xe1, 0xb0, 0x52, 0x15, 0xe6, 0x3f,
+	0xe2, 0x0e, 0xf7, 0x2d, 0x1f, 0xdf, 0x83, 0xfc, 0xb1, 0x6b, 0x9f, 0x0e, 0x5c, 0xef, 0xb8, 0x4f,
+	0x0a, 0x45, 0x54, 0xce, 0xd0, 0x04, 0xc0, 0x18, 0xd2, 0x0d, 0xe6, 0xf7, 0xc9, 0x72, 0x11, 0x95,
+	0x17, 0xa9, 0x7a, 0xff, 0x30, 0xfd, 0xe2, 0xdb, 0x75, 0x54, 0xfa, 0x1e, 0x41, 0xa6, 0xd6, 0xb7,
+	0xec, 0xee, 0x04, 0x85, 0x8f, 0x21, 0xbf, 0xef, 0xf1, 0x13, 0x45, 0x0d, 0x65, 0xb6, 0x1f, 0x9e,
+	0xbd, 0x5a, 0x37, 0x7e, 0x7c, 0xb5, 0xbe, 0x31, 0x32, 0xe9, 0x28, 0xc8, 0x6b, 0xcf, 0x4a, 0xb3,
+	0x4e, 0x93, 0xf1, 0xf8, 0x09, 0xa4, 0x28, 0x3f, 0x22, 0x6f, 0x2b, 0x99, 0xc7, 0x5a, 0xe6, 0xdd,
+	0x1b, 0xc8, 0x50, 0x7e, 0xc4, 0x3d, 0xee, 0x74, 0x38, 0x95, 0x02, 0x3a, 0x84, 0x07, 0x90, 0xda,
+	0xe5, 0xe2, 0xf5, 0xf3, 0xd7, 0xd4, 0xdf, 0xb3, 0x30, 0x4f, 0xf9, 0x97, 0x01, 0xf7, 0x27, 0xf0,
+	0x71, 0x05, 0x72, 0x35, 0x66, 0xdb, 0xed, 0xd3, 0x63, 0xae, 0xc2, 0xbd, 0xb5, 0x85, 0x2b, 0x3a,
+	0x65, 0x5a, 0xa0, 0x52, 0x6b, 0xd3, 0x98, 0x83, 0x9f, 0x42, 0x56, 0xbe, 0x73, 0x6f, 0xa6, 0xa8,
+	0xb4, 0x06, 0xfe, 0x1c, 0x6e, 0x87, 0x6f, 0xfb, 0x32, 0xcf, 0x42, 0x4e, 0x62, 0x65, 0x06, 0xd9,
+	0xeb, 0x62, 0x78, 0x19, 0x32, 0x7b, 0xae, 0xd3, 0xe1, 0xe4, 0x4e, 0x11, 0x95, 0xd3, 0x34, 0x6c,
+	0xe0, 0x35, 0xc8, 0x1d, 0xc8, 0xd8, 0x64, 0x07, 0x51, 0x1d, 0x71, 0x1b, 0x6f, 0x01, 0x50, 0x2e,
+	0x02, 0xcf, 0x69, 0xb9, 0x5d, 0x4e, 0x56, 0xff, 0xda, 0x11, 0xda, 0xa2, 0x23, 0x2c, 0xe9, 0x70,
+	0x73, 0x30, 0x08, 0x04, 0x3b, 0xb4, 0x39, 0x59, 0x2b, 0xa2, 0x72, 0x8e, 0x26, 0x00, 0xae, 0x43,
+	0x7a, 0x9b, 0xf9, 0x9c, 0xdc, 0x55, 0x81, 0x3d, 0xfa, 0xdb, 0x41, 0xa9, 0xd1, 0xb8, 0x01, 0xd9,
+	0x4f, 0x0e, 0x9f, 0xf3, 0x8e, 0x20, 0xf7, 0xa6, 0xd4, 0xd1, 0xe3, 0xf1, 0x1e, 0xe4, 0x63, 0x83,
+	0xc8, 0xfd, 0x29, 0xc5, 0x12, 0x09, 0xbc, 0x02, 0xd9, 0x16, 0x17, 0x7d, 0xb7, 0x4b, 0xcc, 0x22,
+	0x2a, 0xe7, 0xa9, 0x6e, 0x49, 0x57, 0xaa, 0x5e, 0x2f, 0x18, 0x70, 0x47, 0xf8, 0x64, 0x5d, 0x15,
+	0x64, 0x02, 0xe0, 0x67, 0xb0, 0xb4, 0xcf, 0x3c, 0xee, 0x08, 0xed, 0x29, 0x29, 0x4e, 0x39, 0x93,
+	0x71, 0x99, 0xd2, 0x2e, 0xcc, 0xd5, 0xda, 0x78, 0x11, 0x72, 0xb5, 0x76, 0x38, 0x8f, 0x82, 0x81,
+	0xdf, 0x82, 0xa5, 0x5a, 0xfb, 0x80, 0x9d, 0xf0, 0xaa, 0xaf, 0xea, 0xb2, 0x80, 0xf0, 0x32, 0x14,
+	0x22, 0xa8, 0xce, 0x6d, 0xde, 0x63, 0x82, 0x17, 0xe6, 0xf0, 0x12, 0xe4, 0x6b, 0x6d, 0xbd, 0xd3,
+	0x14, 0x52, 0xa5, 0x32, 0xcc, 0xd1, 0x16, 0x2e, 0xc0, 0x62, 0x98, 0x6b, 0xca, 0xfd, 0xc0, 0x16,
+	0x05, 0x23, 0x41, 0xf6, 0xdc, 0x4f, 0x99, 0x25, 0x0a, 0x48, 0x57, 0xdd, 0x1f, 0x08, 0xb2, 0x21,
+	0x69, 0x42, 0xd1, 0xed, 0xc4, 0xc9, 0x9c, 0x6a, 0x87, 0x49, 0x32, 0x19, 0x15, 0xf9, 0x4c, 0xc5,
+	0x18, 0xef, 0x14, 0x04, 0xe6, 0xf7, 0xd9, 0xa9, 0xed, 0xb2, 0x6e, 0x58, 0x85, 0x34, 0x6a, 0xca,
+	0x1c, 0xef, 0x9c, 0xa8, 0x44, 0xde, 0x51, 0x1d, 0xba, 0xa5, 0xe3, 0xfe, 0x0d, 0x41, 0x5a, 0x6d,
+	0x0e, 0xaf, 0x8f, 0xfa, 0x29, 0x64, 0xeb, 0xee, 0x80, 0x59, 0x0e, 0x59, 0x9e, 0x61, 0xb6, 0x5a,
+	0xe3, 0x1f, 0x0f, 0xbe, 0x0c, 0xb7, 0x65, 0x0c, 0x75, 0xde, 0xb1, 0x99, 0xc7, 0x84, 0xe5, 0x3a,
+	0xda, 0x84, 0xeb, 0xb0, 0x0e, 0xfa, 0xe7, 0x39, 0x48, 0xd7, 0x74, 0xf5, 0xbf, 0xb1, 0x41, 0x57,
+	0xc3, 0x18, 0xc8, 0xca, 0x34, 0xcb, 0x30, 0x0c, 0xff, 0x33, 0x58, 0x68, 0xb1, 0x4e, 0xdf, 0x72,
+	0xb8, 0x3a, 0x43, 0xe4, 0xfa, 0x58, 0xda, 0x7e, 0x5f, 0x2b, 0x55, 0x6e, 0xa0, 0x34, 0x32, 0x9a,
+	0x8e, 0x4a, 0x69, 0x9f, 0x7f, 0x4d, 0x41, 0xae, 0xda, 0x11, 0xd6, 0x09, 0x13, 0x6f, 0xb6, 0xd7,
+	0x3b, 0x72, 0x9f, 0x1c, 0xb8, 0xde, 0xe9, 0x74, 0x6e, 0xeb, 0xc1, 0x78, 0x17, 0x32, 0xcd, 0x01,
+	0xeb, 0x85, 0x4e, 0x4f, 0x3b, 0xa9, 0x50, 0x02, 0x17, 0x61, 0xa1, 0xe9, 0x27, 0x87, 0x01, 0x51,
+	0x47, 0xd7, 0x28, 0x24, 0x2d, 0x0d, 0xf7, 0x57, 0xb2, 0x3a, 0xc3, 0xe7, 0xb4, 0x06, 0x36, 0x01,
+	0x9a, 0xf1, 0x7e, 0xab, 0x4f, 0xca, 0x11, 0xa4, 0xf4, 0x43, 0x0a, 0x32, 0xd5, 0x01, 0x77, 0xba,
+	0xff, 0x27, 0xfa, 0xdf, 0x4e, 0xb4, 0xbe, 0xf7, 0x1e, 0x08, 0x99, 0x99, 0xd5, 0xa9, 0xef, 0xbd,
+	0x6a, 0x7c, 0xe9, 0x9b, 0x39, 0x80, 0x3a, 0x67, 0xff, 0x85, 0xaa, 0x1d, 0xf3, 0x65, 0x65, 0x46,
+	0x5f, 0xae, 0x52, 0x30, 0xff, 0xcc, 0xf2, 0x44, 0xc0, 0xec, 0x09, 0xa6, 0xbc, 0x13, 0xff, 0xf1,
+	0x10, 0x5e, 0x44, 0xe5, 0x85, 0xad, 0xdb, 0xd1, 0x1d, 0x54, 0xc3, 0x0d, 0x83, 0x46, 0x0c, 0xbc,
+	0xa1, 0x7f, 0x6d, 0xc8, 0x91, 0xa2, 0x2e, 0x45, 0x54, 0x05, 0x36, 0x0c, 0x1a, 0xf6, 0xe2, 0x75,
+	0xf5, 0xff, 0x40, 0x7a, 0x8a, 0xb4, 0x10, 0x91, 0x76, 0xb9, 0x68, 0x18, 0x54, 0xf6, 0xc8, 0x8f,
+	0x46, 0xde, 0xf5, 0xc7, 0x3f, 0xaa, 0x61, 0xf9, 0xd1, 0xe4, 0xbc, 0xd4, 0x77, 0x1d, 0x62, 0x29,
+	0xee, 0xad, 0x84, 0x2b, 0xd1, 0x86, 0x41, 0x75, 0x3f, 0x2e, 0x85, 0xb7, 0x03, 0xf2, 0x5c, 0xf1,
+	0x16, 0x23, 0x9e, 0xc4, 0x1a, 0x06, 0x55, 0x7d, 0x92, 0xa3, 0x0e, 0xa2, 0x2f, 0xc6, 0x39, 0x12,
+	0x93, 0x1c, 0xf9, 0xc4, 0x95, 0xe4, 0x20, 0x20, 0xb6, 0xe2, 0x15, 0x22, 0x5e, 0x84, 0x37, 0x0c,
+	0x9a, 0x1c, 0x16, 0x1b, 0x7a, 0x33, 0x21, 0x83, 0x71, 0x5b, 0x14, 0x28, 0x6d, 0x51, 0x2f, 0xf8,
+	0xf1, 0xe8, 0x5a, 0x25, 0x8e, 0xe2, 0xc6, 0x37, 0xfe, 0xa4, 0xa7, 0x61, 0xd0, 0xd1, 0x35, 0x7d,
+	0x1f, 0xf2, 0x07, 0x56, 0xcf, 0x61, 0x22, 0xf0, 0x38, 0x39, 0x43, 0xe1, 0xf5, 0x36, 0x46, 0xb6,
+	0xe7, 0x21, 0x13, 0x38, 0x96, 0xeb, 0x94, 0xbe, 0x43, 0x90, 0x6b, 0x31, 0xc1, 0x3d, 0x6b, 0x62,
+	0xce, 0x1f, 0xc4, 0x8b, 0x83, 0x2c, 0x8f, 0xdb, 0xaf, 0x61, 0x1a, 0x2f, 0x9e, 0x27, 0x90, 0xd9,
+	0xe5, 0xa2, 0x59, 0xd7, 0x6b, 0xfc, 0x91, 0x5e, 0x91, 0xe5, 0x1b, 0xac, 0x48, 0x35, 0x8e, 0x86,
+	0xc3, 0x27, 0x45, 0xf1, 0xc1, 0xd9, 0x85, 0x69, 0x9c, 0x5f, 0x98, 0xc6, 0xcb, 0x0b, 0xd3, 0xb8,
+	0xba, 0x30, 0xd1, 0x57, 0x43, 0x13, 0xbd, 0x18, 0x9a, 0xe8, 0x6c, 0x68, 0xa2, 0xf3, 0xa1, 0x89,
+	0x7e, 0x1a, 0x9a, 0xe8, 0x97, 0xa1, 0x69, 0x5c, 0x0d, 0x4d, 0xf4, 0xf5, 0xa5, 0x69, 0x9c, 0x5f,
+	0x9a, 0xc6, 0xcb, 0x4b, 0xd3, 0x38, 0xcc, 0xaa, 0x1f, 0xf7, 0xf7, 0xfe, 0x1c, 0x00, 0x81, 0xa0,
+	0xf2, 0x6c, 0x0e, 0x10, 0x00, 0x00,
 }
 
 func (x Request_CT) String() string {
@@ -1175,6 +1178,13 @@ func (this *Request) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Arguments, that1.Arguments) {
 		return false
 	}
+	if that1.ParentRequest == nil {
+		if this.ParentRequest != nil {
+			return false
+		}
+	} else if !this.ParentRequest.Equal(*that1.ParentRequest) {
+		return false
+	}
 	return true
 }
 func (this *Result) Equal(that interface{}) bool {
@@ -1208,6 +1218,9 @@ func (this *Result) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Payload, that1.Payload) {
 		return false
 	}
+	if !bytes.Equal(this.Events, that1.Events) {
+		return false
+	}
 	return true
 }
 func (this *Type) Equal(that interface{}) bool {
@@ -1811,6 +1824,7 @@ type RequestFace interface {
 	GetPrototype() *github_com_insolar_insolar_insolar.Reference
 	GetMethod() string
 	GetArguments() []byte
+	GetParentRequest() *github_com_insolar_insolar_insolar.Reference
 }
 
 func (this *Request) Proto() github_com_gogo_protobuf_proto.Message {
@@ -1873,6 +1887,10 @@ func (this *Request) GetArguments() []byte {
 	return this.Arguments
 }
 
+func (this *Request) GetParentRequest() *github_com_insolar_insolar_insolar.Reference {
+	return this.ParentRequest
+}
+
 func NewRequestFromFace(that RequestFace) *Request {
 	this := &Request{}
 	this.Polymorph = that.GetPolymorph()
@@ -1888,6 +1906,7 @@ func NewRequestFromFace(that RequestFace) *Request {
 	this.Prototype = that.GetPrototype()
 	this.Method = that.GetMethod()
 	this.Arguments = that.GetArguments()
+	this.ParentRequest = that.GetParentRequest()
 	return this
 }
 
@@ -1897,6 +1916,7 @@ type ResultFace interface {
 	GetObject() github_com_insolar_insolar_insolar.ID
 	GetRequest() github_com_insolar_insolar_insolar.Reference
 	GetPayload() []byte
+	GetEvents() []byte
 }
 
 func (this *Result) Proto() github_com_gogo_protobuf_proto.Message {
@@ -1923,12 +1943,17 @@ func (this *Result) GetPayload() []byte {
 	return this.Payload
 }
 
+func (this *Result) GetEvents() []byte {
+	return this.Events
+}
+
 func NewResultFromFace(that ResultFace) *Result {
 	this := &Result{}
 	this.Polymorph = that.GetPolymorph()
 	this.Object = that.GetObject()
 	this.Request = that.GetRequest()
 	this.Payload = that.GetPayload()
+	this.Events = that.GetEvents()
 	return this
 }
 
@@ -2057,7 +2082,7 @@ func (this *Request) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&record.Request{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "CallType: "+fmt.Sprintf("%#v", this.CallType)+",\n")
@@ -2072,6 +2097,7 @@ func (this *Request) GoString() string {
 	s = append(s, "Prototype: "+fmt.Sprintf("%#v", this.Prototype)+",\n")
 	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
 	s = append(s, "Arguments: "+fmt.Sprintf("%#v", this.Arguments)+",\n")
+	s = append(s, "ParentRequest: "+fmt.Sprintf("%#v", this.ParentRequest)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2079,12 +2105,13 @@ func (this *Result) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&record.Result{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
 	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2515,6 +2542,18 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(len(m.Arguments)))
 		i += copy(dAtA[i:], m.Arguments)
 	}
+	if m.ParentRequest != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.ParentRequest.Size()))
+		n8, err := m.ParentRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
 	return i, nil
 }
 
@@ -2545,21 +2584,21 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Object.Size()))
-	n8, err := m.Object.MarshalTo(dAtA[i:])
+	n9, err := m.Object.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n9, err := m.Request.MarshalTo(dAtA[i:])
+	n10, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	if len(m.Payload) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -2568,6 +2607,14 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(len(m.Payload)))
 		i += copy(dAtA[i:], m.Payload)
 	}
+	if len(m.Events) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Events)))
+		i += copy(dAtA[i:], m.Events)
+	}
 	return i, nil
 }
 
@@ -2598,21 +2645,21 @@ func (m *Type) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n10, err := m.Domain.MarshalTo(dAtA[i:])
+	n11, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n11, err := m.Request.MarshalTo(dAtA[i:])
+	n12, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	if len(m.TypeDeclaration) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -2651,31 +2698,31 @@ func (m *Code) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n12, err := m.Domain.MarshalTo(dAtA[i:])
+	n13, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n13, err := m.Request.MarshalTo(dAtA[i:])
+	n14, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Code.Size()))
-	n14, err := m.Code.MarshalTo(dAtA[i:])
+	n15, err := m.Code.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	if m.MachineType != 0 {
 		dAtA[i] = 0xb8
 		i++
@@ -2713,41 +2760,41 @@ func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n15, err := m.Domain.MarshalTo(dAtA[i:])
+	n16, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n16, err := m.Request.MarshalTo(dAtA[i:])
+	n17, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Memory.Size()))
-	n17, err := m.Memory.MarshalTo(dAtA[i:])
+	n18, err := m.Memory.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Image.Size()))
-	n18, err := m.Image.MarshalTo(dAtA[i:])
+	n19, err := m.Image.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	if m.IsPrototype {
 		dAtA[i] = 0xc0
 		i++
@@ -2765,11 +2812,11 @@ func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Parent.Size()))
-	n19, err := m.Parent.MarshalTo(dAtA[i:])
+	n20, err := m.Parent.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	if m.IsDelegate {
 		dAtA[i] = 0xd0
 		i++
@@ -2812,41 +2859,41 @@ func (m *Amend) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n20, err := m.Domain.MarshalTo(dAtA[i:])
+	n21, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n21, err := m.Request.MarshalTo(dAtA[i:])
+	n22, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n22
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Memory.Size()))
-	n22, err := m.Memory.MarshalTo(dAtA[i:])
+	n23, err := m.Memory.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n23
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Image.Size()))
-	n23, err := m.Image.MarshalTo(dAtA[i:])
+	n24, err := m.Image.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n24
 	if m.IsPrototype {
 		dAtA[i] = 0xc0
 		i++
@@ -2864,11 +2911,11 @@ func (m *Amend) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.PrevState.Size()))
-	n24, err := m.PrevState.MarshalTo(dAtA[i:])
+	n25, err := m.PrevState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n25
 	return i, nil
 }
 
@@ -2899,31 +2946,31 @@ func (m *Deactivate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n25, err := m.Domain.MarshalTo(dAtA[i:])
+	n26, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n26
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n26, err := m.Request.MarshalTo(dAtA[i:])
+	n27, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n27
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.PrevState.Size()))
-	n27, err := m.PrevState.MarshalTo(dAtA[i:])
+	n28, err := m.PrevState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n28
 	return i, nil
 }
 
@@ -2975,11 +3022,11 @@ func (m *Virtual_Genesis) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Genesis.Size()))
-		n29, err := m.Genesis.MarshalTo(dAtA[i:])
+		n30, err := m.Genesis.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	return i, nil
 }
@@ -2991,11 +3038,11 @@ func (m *Virtual_Child) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Child.Size()))
-		n30, err := m.Child.MarshalTo(dAtA[i:])
+		n31, err := m.Child.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n31
 	}
 	return i, nil
 }
@@ -3007,11 +3054,11 @@ func (m *Virtual_Jet) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Jet.Size()))
-		n31, err := m.Jet.MarshalTo(dAtA[i:])
+		n32, err := m.Jet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n32
 	}
 	return i, nil
 }
@@ -3023,11 +3070,11 @@ func (m *Virtual_Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-		n32, err := m.Request.MarshalTo(dAtA[i:])
+		n33, err := m.Request.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n33
 	}
 	return i, nil
 }
@@ -3039,11 +3086,11 @@ func (m *Virtual_Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Result.Size()))
-		n33, err := m.Result.MarshalTo(dAtA[i:])
+		n34, err := m.Result.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n34
 	}
 	return i, nil
 }
@@ -3055,11 +3102,11 @@ func (m *Virtual_Type) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Type.Size()))
-		n34, err := m.Type.MarshalTo(dAtA[i:])
+		n35, err := m.Type.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n35
 	}
 	return i, nil
 }
@@ -3071,11 +3118,11 @@ func (m *Virtual_Code) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Code.Size()))
-		n35, err := m.Code.MarshalTo(dAtA[i:])
+		n36, err := m.Code.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n36
 	}
 	return i, nil
 }
@@ -3087,11 +3134,11 @@ func (m *Virtual_Activate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Activate.Size()))
-		n36, err := m.Activate.MarshalTo(dAtA[i:])
+		n37, err := m.Activate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n37
 	}
 	return i, nil
 }
@@ -3103,11 +3150,11 @@ func (m *Virtual_Amend) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Amend.Size()))
-		n37, err := m.Amend.MarshalTo(dAtA[i:])
+		n38, err := m.Amend.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n38
 	}
 	return i, nil
 }
@@ -3119,11 +3166,11 @@ func (m *Virtual_Deactivate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Deactivate.Size()))
-		n38, err := m.Deactivate.MarshalTo(dAtA[i:])
+		n39, err := m.Deactivate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n39
 	}
 	return i, nil
 }
@@ -3155,22 +3202,22 @@ func (m *Material) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Virtual.Size()))
-		n39, err := m.Virtual.MarshalTo(dAtA[i:])
+		n40, err := m.Virtual.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n40
 	}
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.JetID.Size()))
-	n40, err := m.JetID.MarshalTo(dAtA[i:])
+	n41, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n41
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
 		i++
@@ -3283,6 +3330,10 @@ func (m *Request) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRecord(uint64(l))
 	}
+	if m.ParentRequest != nil {
+		l = m.ParentRequest.Size()
+		n += 2 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -3303,6 +3354,10 @@ func (m *Result) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRecord(uint64(l))
 	}
+	l = len(m.Events)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -3643,6 +3698,7 @@ func (this *Request) String() string {
 		`Prototype:` + fmt.Sprintf("%v", this.Prototype) + `,`,
 		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
 		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
+		`ParentRequest:` + fmt.Sprintf("%v", this.ParentRequest) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3656,6 +3712,7 @@ func (this *Result) String() string {
 		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
 		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
 		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4563,6 +4620,41 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				m.Arguments = []byte{}
 			}
 			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRequest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.Reference
+			m.ParentRequest = &v
+			if err := m.ParentRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -4735,6 +4827,40 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events[:0], dAtA[iNdEx:postIndex]...)
+			if m.Events == nil {
+				m.Events = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -51,6 +51,10 @@ const (
 	TypeCallConstructor
 	// TypeRegisterRequest - request for execution was registered
 	TypeRegisterRequest
+	// TypeDryRun - result of dry-run call and changes of objects' memory
+	TypeDryRun
+	// TypeCallTrace - tree of calls executed by the node for a request
+	TypeCallTrace
 
 	// Ledger
 
@@ -76,6 +80,12 @@ const (
 	TypeJet
 	// TypeRequest contains request.
 	TypeRequest
+	// TypeObjectHistory is a reply for fetching object states in chunks.
+	TypeObjectHistory
+	// TypeObjectRequests is a reply for fetching object requests in chunks.
+	TypeObjectRequests
+	// TypeObjectEvents is a reply for fetching object events in chunks.
+	TypeObjectEvents
 	// TypeHeavyError carries heavy record sync
 	TypeHeavyError
 
@@ -106,6 +116,10 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 		return &CallConstructor{}, nil
 	case TypeRegisterRequest:
 		return &RegisterRequest{}, nil
+	case TypeDryRun:
+		return &DryRun{}, nil
+	case TypeCallTrace:
+		return &CallTrace{}, nil
 	case TypeCode:
 		return &Code{}, nil
 	case TypeObject:
@@ -136,6 +150,12 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 		return &Jet{}, nil
 	case TypeRequest:
 		return &Request{}, nil
+	case TypeObjectHistory:
+		return &ObjectHistory{}, nil
+	case TypeObjectRequests:
+		return &ObjectRequests{}, nil
+	case TypeObjectEvents:
+		return &ObjectEvents{}, nil
 
 	case TypeNodeSign:
 		return &NodeSign{}, nil
@@ -188,6 +208,8 @@ func init() {
 	gob.Register(&CallMethod{})
 	gob.Register(&CallConstructor{})
 	gob.Register(&RegisterRequest{})
+	gob.Register(&DryRun{})
+	gob.Register(&CallTrace{})
 	gob.Register(&Code{})
 	gob.Register(&Object{})
 	gob.Register(&Delegate{})
@@ -203,4 +225,7 @@ func init() {
 	gob.Register(&NodeSign{})
 	gob.Register(&HasPendingRequests{})
 	gob.Register(&Request{})
+	gob.Register(&ObjectHistory{})
+	gob.Register(&ObjectRequests{})
+	gob.Register(&ObjectEvents{})
 }
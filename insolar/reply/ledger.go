@@ -130,3 +130,60 @@ type Request struct {
 func (r *Request) Type() insolar.ReplyType {
 	return TypeRequest
 }
+
+// ObjectState is a single object state from the object history.
+type ObjectState struct {
+	ID     insolar.ID
+	Record []byte
+	Memory []byte
+}
+
+// ObjectHistory is a reply for fetching object states in chunks.
+type ObjectHistory struct {
+	States   []ObjectState
+	NextFrom *insolar.ID
+}
+
+// Type implementation of Reply interface.
+func (r *ObjectHistory) Type() insolar.ReplyType {
+	return TypeObjectHistory
+}
+
+// RequestResult is a request of an object with its result. Result fields are empty for not processed requests.
+type RequestResult struct {
+	RequestID insolar.ID
+	Request   []byte
+	ResultID  *insolar.ID
+	Result    []byte
+}
+
+// ObjectRequests is a reply for fetching object requests in chunks.
+type ObjectRequests struct {
+	Requests []RequestResult
+	NextFrom *insolar.ID
+}
+
+// Type implementation of Reply interface.
+func (r *ObjectRequests) Type() insolar.ReplyType {
+	return TypeObjectRequests
+}
+
+// ObjectEvent is an event emitted by an object during processing of a request.
+type ObjectEvent struct {
+	RequestID insolar.ID
+	ResultID  insolar.ID
+	Method    string
+	Name      string
+	Payload   []byte
+}
+
+// ObjectEvents is a reply for fetching object events in chunks.
+type ObjectEvents struct {
+	Events   []ObjectEvent
+	NextFrom *insolar.ID
+}
+
+// Type implementation of Reply interface.
+func (r *ObjectEvents) Type() insolar.ReplyType {
+	return TypeObjectEvents
+}
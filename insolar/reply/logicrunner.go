@@ -47,3 +47,24 @@ type RegisterRequest struct {
 func (r *RegisterRequest) Type() insolar.ReplyType {
 	return TypeRegisterRequest
 }
+
+// DryRun is a reply for dry-run call, Diff contains objects whose memory was changed by the call
+type DryRun struct {
+	Result []byte
+	Diff   []insolar.StateDiff
+}
+
+// Type returns type of the reply
+func (r *DryRun) Type() insolar.ReplyType {
+	return TypeDryRun
+}
+
+// CallTrace is a reply with serialized tree of calls, Tree is empty if the node doesn't know the request
+type CallTrace struct {
+	Tree []byte
+}
+
+// Type returns type of the reply
+func (r *CallTrace) Type() insolar.ReplyType {
+	return TypeCallTrace
+}
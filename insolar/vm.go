@@ -18,6 +18,7 @@ package insolar
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -29,6 +30,7 @@ const (
 	MachineTypeNotExist             = 0
 	MachineTypeBuiltin  MachineType = iota + 1
 	MachineTypeGoPlugin
+	MachineTypeWASM
 
 	MachineTypesLastID
 )
@@ -72,6 +74,7 @@ type LogicCallContext struct {
 	Mode            string     // either "execution" or "validation"
 	Callee          *Reference // Contract that was called
 	Request         *Reference // ref of request
+	ParentRequest   *Reference // ref of request made the call, nil for calls from outside
 	Prototype       *Reference // Image of the callee
 	Code            *Reference // ref of contract code
 	CallerPrototype *Reference // Image of the caller
@@ -81,4 +84,66 @@ type LogicCallContext struct {
 	Pulse           Pulse      // Number of the pulse
 	Immutable       bool
 	TraceID         string
+	Budget          CallBudget // Resources the call is allowed to consume
+
+	consumedLock sync.Mutex
+	consumed     CallConsumption // Resources consumed by the call so far
+}
+
+// Consume adds provided resources to the consumption of the call and returns the total. Executor and upcalls of
+// the call consume resources concurrently.
+func (lc *LogicCallContext) Consume(c CallConsumption) CallConsumption {
+	lc.consumedLock.Lock()
+	defer lc.consumedLock.Unlock()
+
+	lc.consumed.Time += c.Time
+	lc.consumed.Steps += c.Steps
+	lc.consumed.Upcalls += c.Upcalls
+	lc.consumed.OutgoingCalls += c.OutgoingCalls
+	return lc.consumed
+}
+
+// Consumption returns resources consumed by the call so far.
+func (lc *LogicCallContext) Consumption() CallConsumption {
+	lc.consumedLock.Lock()
+	defer lc.consumedLock.Unlock()
+
+	return lc.consumed
+}
+
+// CallBudget is a limit of resources one contract call can consume, zero limit means no limit
+type CallBudget struct {
+	Timeout       time.Duration // Wall-clock time of the call
+	Steps         uint64        // Instructions executed by contract code, metered by executors able to count them
+	Upcalls       uint64        // Calls from contract code to the platform
+	OutgoingCalls uint64        // Calls of other contracts
+}
+
+// CallConsumption is an amount of resources consumed by a contract call
+type CallConsumption struct {
+	Time          time.Duration
+	Steps         uint64
+	Upcalls       uint64
+	OutgoingCalls uint64
+}
+
+// ContractEvent is a structured event emitted by a contract during a call, events are saved with result of the call
+type ContractEvent struct {
+	Name    string
+	Payload []byte
+}
+
+// StateDiff is a change of object memory made by dry-run call, After is nil for deactivated object
+type StateDiff struct {
+	Object Reference
+	Before []byte
+	After  []byte
+}
+
+// Exceeded checks if consumption is over the budget.
+func (b CallBudget) Exceeded(c CallConsumption) bool {
+	return (b.Timeout > 0 && c.Time > b.Timeout) ||
+		(b.Steps > 0 && c.Steps > b.Steps) ||
+		(b.Upcalls > 0 && c.Upcalls > b.Upcalls) ||
+		(b.OutgoingCalls > 0 && c.OutgoingCalls > b.OutgoingCalls)
 }
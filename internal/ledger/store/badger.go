@@ -22,17 +22,21 @@ import (
 
 	"github.com/dgraph-io/badger"
 	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
 )
 
 // BadgerDB is a badger DB implementation.
 type BadgerDB struct {
 	backend *badger.DB
+
+	txRetriesOnConflict int
 }
 
 // NewBadgerDB creates new BadgerDB instance.
 // Creates new badger.DB instance with provided working dir and use it as backend for BadgerDB.
-func NewBadgerDB(dir string) (*BadgerDB, error) {
-	dir, err := filepath.Abs(dir)
+func NewBadgerDB(conf configuration.Storage) (*BadgerDB, error) {
+	dir, err := filepath.Abs(conf.DataDirectory)
 	if err != nil {
 		return nil, err
 	}
@@ -45,56 +49,170 @@ func NewBadgerDB(dir string) (*BadgerDB, error) {
 		return nil, errors.Wrap(err, "failed to open badger")
 	}
 
-	return &BadgerDB{backend: bdb}, nil
+	return &BadgerDB{
+		backend:             bdb,
+		txRetriesOnConflict: conf.TxRetriesOnConflict,
+	}, nil
 }
 
 // Get returns value for specified key or an error. A copy of a value will be returned (i.e. getting large value can be
 // long).
 func (b *BadgerDB) Get(key Key) (value []byte, err error) {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
-
 	err = b.backend.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(fullKey)
-		if err != nil {
-			return err
-		}
-		value, err = item.ValueCopy(nil)
-		if err != nil {
+		value, err = txnGet(txn, key)
+		return err
+	})
+	return
+}
+
+// Set stores value for a key.
+func (b *BadgerDB) Set(key Key, value []byte) error {
+	return b.backend.Update(func(txn *badger.Txn) error {
+		return txnSet(txn, key, value)
+	})
+}
+
+// Delete removes value for a key.
+func (b *BadgerDB) Delete(key Key) error {
+	return b.backend.Update(func(txn *badger.Txn) error {
+		return txnDelete(txn, key)
+	})
+}
+
+// NewIterator returns an iterator over keys of pivot's scope, which IDs start with prefix.
+// The iterator works over a read-only snapshot of the db taken at the moment of the call.
+func (b *BadgerDB) NewIterator(pivot Key, prefix []byte, reverse bool) Iterator {
+	txn := b.backend.NewTransaction(false)
+	it := newBadgerIterator(txn, pivot, prefix, reverse)
+	it.discard = true
+	return it
+}
+
+// Update runs fn in a single transaction. Writes made through tx are applied atomically when fn returns nil.
+// On transaction conflict fn is called again up to TxRetriesOnConflict times, so it should not have side effects
+// except writes to tx.
+func (b *BadgerDB) Update(fn func(tx DB) error) error {
+	var err error
+	for i := 0; i <= b.txRetriesOnConflict; i++ {
+		err = b.backend.Update(func(txn *badger.Txn) error {
+			return fn(&badgerTx{txn: txn})
+		})
+		if err != badger.ErrConflict {
 			return err
 		}
+	}
+	return errors.Wrapf(err, "transaction failed after %d retries", b.txRetriesOnConflict)
+}
 
-		return nil
+// View runs fn in a read-only transaction. All reads made through tx see the same snapshot of the db.
+func (b *BadgerDB) View(fn func(tx DB) error) error {
+	return b.backend.View(func(txn *badger.Txn) error {
+		return fn(&badgerTx{txn: txn})
 	})
+}
+
+// Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
+func (b *BadgerDB) Stop(ctx context.Context) error {
+	return b.backend.Close()
+}
 
+// badgerTx is a DB, that reads and writes within a single badger transaction.
+type badgerTx struct {
+	txn *badger.Txn
+}
+
+func (t *badgerTx) Get(key Key) ([]byte, error) {
+	return txnGet(t.txn, key)
+}
+
+func (t *badgerTx) Set(key Key, value []byte) error {
+	return txnSet(t.txn, key, value)
+}
+
+func (t *badgerTx) Delete(key Key) error {
+	return txnDelete(t.txn, key)
+}
+
+func (t *badgerTx) NewIterator(pivot Key, prefix []byte, reverse bool) Iterator {
+	return newBadgerIterator(t.txn, pivot, prefix, reverse)
+}
+
+// Update runs fn within the current transaction.
+func (t *badgerTx) Update(fn func(tx DB) error) error {
+	return fn(t)
+}
+
+// View runs fn within the current transaction.
+func (t *badgerTx) View(fn func(tx DB) error) error {
+	return fn(t)
+}
+
+func txnGet(txn *badger.Txn, key Key) ([]byte, error) {
+	fullKey := append(key.Scope().Bytes(), key.ID()...)
+
+	item, err := txn.Get(fullKey)
+	if err == badger.ErrKeyNotFound {
+		return nil, ErrNotFound
+	}
 	if err != nil {
-		if err == badger.ErrKeyNotFound {
-			return nil, ErrNotFound
-		}
 		return nil, err
 	}
+	return item.ValueCopy(nil)
+}
 
-	return
+func txnSet(txn *badger.Txn, key Key, value []byte) error {
+	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	return txn.Set(fullKey, value)
 }
 
-// Set stores value for a key.
-func (b *BadgerDB) Set(key Key, value []byte) error {
+func txnDelete(txn *badger.Txn, key Key) error {
 	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	return txn.Delete(fullKey)
+}
 
-	err := b.backend.Update(func(txn *badger.Txn) error {
-		err := txn.Set(fullKey, value)
-		if err != nil {
-			return err
-		}
-		return nil
-	})
-	if err != nil {
-		return err
+type badgerIterator struct {
+	txn        *badger.Txn
+	it         *badger.Iterator
+	seek       []byte
+	fullPrefix []byte
+	started    bool
+	discard    bool
+}
+
+func newBadgerIterator(txn *badger.Txn, pivot Key, prefix []byte, reverse bool) *badgerIterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = reverse
+
+	return &badgerIterator{
+		txn:        txn,
+		it:         txn.NewIterator(opts),
+		seek:       seekKey(pivot, reverse),
+		fullPrefix: append(pivot.Scope().Bytes(), prefix...),
 	}
+}
 
-	return nil
+func (bi *badgerIterator) Next() bool {
+	if !bi.started {
+		bi.it.Seek(bi.seek)
+		bi.started = true
+	} else {
+		bi.it.Next()
+	}
+	return bi.it.ValidForPrefix(bi.fullPrefix)
 }
 
-// Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
-func (b *BadgerDB) Stop(ctx context.Context) error {
-	return b.backend.Close()
+func (bi *badgerIterator) Key() []byte {
+	key := bi.it.Item().Key()[len(Scope(0).Bytes()):]
+	return append([]byte{}, key...)
+}
+
+func (bi *badgerIterator) Value() ([]byte, error) {
+	return bi.it.Item().ValueCopy(nil)
+}
+
+func (bi *badgerIterator) Close() {
+	bi.it.Close()
+	if bi.discard {
+		bi.txn.Discard()
+	}
 }
@@ -25,6 +25,8 @@ import (
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
 )
 
 type testBadgerKey struct {
@@ -47,7 +49,7 @@ func TestBadgerDB_Get(t *testing.T) {
 	defer os.RemoveAll(tmpdir)
 	assert.NoError(t, err)
 
-	db, err := NewBadgerDB(tmpdir)
+	db, err := NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
 	require.NoError(t, err)
 
 	var (
@@ -73,7 +75,7 @@ func TestBadgerDB_Set(t *testing.T) {
 	defer os.RemoveAll(tmpdir)
 	assert.NoError(t, err)
 
-	db, err := NewBadgerDB(tmpdir)
+	db, err := NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
 	require.NoError(t, err)
 
 	var (
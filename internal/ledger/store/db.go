@@ -16,12 +16,39 @@
 
 package store
 
+import (
+	"bytes"
+)
+
 //go:generate minimock -i github.com/insolar/insolar/internal/ledger/store.DB -o ./ -s _gen_mock.go
 
 // DB provides a simple key-value store interface for persisting data.
 type DB interface {
 	Get(key Key) (value []byte, err error)
 	Set(key Key, value []byte) error
+	// Delete removes value for a key. Removing a missing key is not an error.
+	Delete(key Key) error
+	// NewIterator returns an iterator over keys of pivot's scope, which IDs start with prefix.
+	// Iteration starts from pivot or from the nearest key in iteration direction. In reverse mode keys,
+	// that have pivot's ID as a prefix, are walked too.
+	NewIterator(pivot Key, prefix []byte, reverse bool) Iterator
+	// Update runs fn in a transaction. All writes made through tx are applied atomically if fn returns nil
+	// and discarded otherwise. Keys from different scopes can be written in one transaction.
+	Update(fn func(tx DB) error) error
+	// View runs fn over a consistent read-only snapshot of the store. Writes made through tx return an error.
+	View(fn func(tx DB) error) error
+}
+
+// Iterator walks over keys of a scope in lexicographical order.
+type Iterator interface {
+	// Next moves the iterator to the next key. It returns false when there are no keys left.
+	Next() bool
+	// Key returns ID part of the current key.
+	Key() []byte
+	// Value returns a copy of the current value.
+	Value() ([]byte, error)
+	// Close releases the iterator. It must be called when iteration is done.
+	Close()
 }
 
 // Key represents a key for the key-value store. Scope is required to separate different DB clients and should be
@@ -41,6 +68,19 @@ func (s Scope) Bytes() []byte {
 	return []byte{byte(s)}
 }
 
+// maxKeyTail is a number of 0xFF bytes appended to a pivot to make reverse iteration include keys,
+// that have pivot as a prefix. It's longer than any ID we store.
+const maxKeyTail = 64
+
+// seekKey returns a full key to start iteration from.
+func seekKey(pivot Key, reverse bool) []byte {
+	key := append(pivot.Scope().Bytes(), pivot.ID()...)
+	if reverse {
+		key = append(key, bytes.Repeat([]byte{0xFF}, maxKeyTail)...)
+	}
+	return key
+}
+
 const (
 	// ScopePulse is the scope for pulse storage.
 	ScopePulse Scope = 1
@@ -58,4 +98,19 @@ const (
 
 	// ScopeGenesis is the scope for a genesis records.
 	ScopeGenesis Scope = 8
+
+	// ScopeExportCatalog is the scope for a per-pulse catalog of exportable data.
+	ScopeExportCatalog Scope = 9
+
+	// ScopeRequestIndex is the scope for requests and results indexed by their objects.
+	ScopeRequestIndex Scope = 10
+
+	// ScopeTypeIndex is the scope for record ids indexed by record types.
+	ScopeTypeIndex Scope = 11
+
+	// ScopeJetTree is the scope for jet trees.
+	ScopeJetTree Scope = 12
+
+	// ScopeRetention is the scope for retention state.
+	ScopeRetention Scope = 13
 )
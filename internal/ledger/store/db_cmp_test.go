@@ -23,9 +23,12 @@ import (
 	"testing"
 
 	fuzz "github.com/google/gofuzz"
+	"github.com/pkg/errors"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
 )
 
 type testKey struct {
@@ -47,7 +50,7 @@ func TestDB_Components(t *testing.T) {
 	tmpdir, err := ioutil.TempDir("", "bdb-test-")
 	defer os.RemoveAll(tmpdir)
 	assert.NoError(t, err)
-	badger, err := NewBadgerDB(tmpdir)
+	badger, err := NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
 	require.NoError(t, err)
 
 	mock := NewMemoryMockDB()
@@ -94,3 +97,217 @@ func TestDB_Components(t *testing.T) {
 		}
 	}
 }
+
+func TestDB_Components_NewIterator(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+	badger, err := NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
+	require.NoError(t, err)
+
+	mock := NewMemoryMockDB()
+
+	keys := []testKey{
+		{scope: ScopeRecord, id: []byte{1, 1}},
+		{scope: ScopeRecord, id: []byte{1, 2}},
+		{scope: ScopeRecord, id: []byte{1, 3}},
+		{scope: ScopeRecord, id: []byte{2, 1}},
+		{scope: ScopeBlob, id: []byte{1, 4}},
+	}
+	for i, k := range keys {
+		require.NoError(t, badger.Set(k, []byte{byte(i)}))
+		require.NoError(t, mock.Set(k, []byte{byte(i)}))
+	}
+
+	collect := func(db DB, pivot Key, prefix []byte, reverse bool) [][]byte {
+		it := db.NewIterator(pivot, prefix, reverse)
+		defer it.Close()
+
+		var res [][]byte
+		for it.Next() {
+			res = append(res, it.Key())
+			_, err := it.Value()
+			require.NoError(t, err)
+		}
+		return res
+	}
+
+	cases := []struct {
+		name     string
+		pivot    testKey
+		prefix   []byte
+		reverse  bool
+		expected [][]byte
+	}{
+		{
+			name:     "whole scope forward",
+			pivot:    testKey{scope: ScopeRecord},
+			expected: [][]byte{{1, 1}, {1, 2}, {1, 3}, {2, 1}},
+		},
+		{
+			name:     "prefix forward",
+			pivot:    testKey{scope: ScopeRecord, id: []byte{1}},
+			prefix:   []byte{1},
+			expected: [][]byte{{1, 1}, {1, 2}, {1, 3}},
+		},
+		{
+			name:     "prefix forward from pivot",
+			pivot:    testKey{scope: ScopeRecord, id: []byte{1, 2}},
+			prefix:   []byte{1},
+			expected: [][]byte{{1, 2}, {1, 3}},
+		},
+		{
+			name:     "prefix backward",
+			pivot:    testKey{scope: ScopeRecord, id: []byte{1}},
+			prefix:   []byte{1},
+			reverse:  true,
+			expected: [][]byte{{1, 3}, {1, 2}, {1, 1}},
+		},
+		{
+			name:     "whole scope backward from pivot",
+			pivot:    testKey{scope: ScopeRecord, id: []byte{1, 2}},
+			reverse:  true,
+			expected: [][]byte{{1, 2}, {1, 1}},
+		},
+		{
+			name:   "no keys",
+			pivot:  testKey{scope: ScopeRecord, id: []byte{3}},
+			prefix: []byte{3},
+		},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, collect(badger, c.pivot, c.prefix, c.reverse), "badger: %s", c.name)
+		assert.Equal(t, c.expected, collect(mock, c.pivot, c.prefix, c.reverse), "mock: %s", c.name)
+	}
+}
+
+func TestDB_Components_Update(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+	badger, err := NewBadgerDB(configuration.Storage{DataDirectory: tmpdir, TxRetriesOnConflict: 3})
+	require.NoError(t, err)
+
+	dbs := map[string]DB{
+		"badger": badger,
+		"mock":   NewMemoryMockDB(),
+	}
+
+	for name, db := range dbs {
+		recordKey := testKey{scope: ScopeRecord, id: []byte(name)}
+		blobKey := testKey{scope: ScopeBlob, id: []byte(name)}
+
+		t.Run(name+" discards writes on error", func(t *testing.T) {
+			expectedErr := errors.New("test error")
+			err := db.Update(func(tx DB) error {
+				require.NoError(t, tx.Set(recordKey, []byte{1}))
+				require.NoError(t, tx.Set(blobKey, []byte{2}))
+				return expectedErr
+			})
+			assert.Equal(t, expectedErr, err)
+
+			_, err = db.Get(recordKey)
+			assert.Equal(t, ErrNotFound, err)
+			_, err = db.Get(blobKey)
+			assert.Equal(t, ErrNotFound, err)
+		})
+
+		t.Run(name+" applies writes from different scopes", func(t *testing.T) {
+			err := db.Update(func(tx DB) error {
+				require.NoError(t, tx.Set(recordKey, []byte{1}))
+				value, err := tx.Get(recordKey)
+				require.NoError(t, err)
+				assert.Equal(t, []byte{1}, value)
+
+				return tx.Set(blobKey, []byte{2})
+			})
+			require.NoError(t, err)
+
+			value, err := db.Get(recordKey)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{1}, value)
+			value, err = db.Get(blobKey)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{2}, value)
+		})
+	}
+}
+
+func TestDB_Components_View(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+	badger, err := NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
+	require.NoError(t, err)
+
+	dbs := map[string]DB{
+		"badger": badger,
+		"mock":   NewMemoryMockDB(),
+	}
+
+	for name, db := range dbs {
+		key := testKey{scope: ScopeRecord, id: []byte(name)}
+		require.NoError(t, db.Set(key, []byte{1}))
+
+		t.Run(name+" reads snapshot", func(t *testing.T) {
+			err := db.View(func(tx DB) error {
+				require.NoError(t, db.Set(key, []byte{2}))
+
+				value, err := tx.Get(key)
+				require.NoError(t, err)
+				assert.Equal(t, []byte{1}, value)
+				return nil
+			})
+			require.NoError(t, err)
+
+			value, err := db.Get(key)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{2}, value)
+		})
+
+		t.Run(name+" rejects writes", func(t *testing.T) {
+			err := db.View(func(tx DB) error {
+				return tx.Set(key, []byte{3})
+			})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDB_Components_Delete(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+	badger, err := NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
+	require.NoError(t, err)
+
+	dbs := map[string]DB{
+		"badger": badger,
+		"mock":   NewMemoryMockDB(),
+	}
+
+	for name, db := range dbs {
+		key := testKey{scope: ScopeRecord, id: []byte(name)}
+
+		t.Run(name+" removes value", func(t *testing.T) {
+			require.NoError(t, db.Set(key, []byte{1}))
+			require.NoError(t, db.Delete(key))
+
+			_, err := db.Get(key)
+			assert.Equal(t, ErrNotFound, err)
+		})
+
+		t.Run(name+" ignores missing key", func(t *testing.T) {
+			assert.NoError(t, db.Delete(testKey{scope: ScopeBlob, id: []byte(name)}))
+		})
+	}
+}
@@ -17,15 +17,35 @@ import (
 type DBMock struct {
 	t minimock.Tester
 
+	DeleteFunc       func(p Key) (r error)
+	DeleteCounter    uint64
+	DeletePreCounter uint64
+	DeleteMock       mDBMockDelete
+
 	GetFunc       func(p Key) (r []byte, r1 error)
 	GetCounter    uint64
 	GetPreCounter uint64
 	GetMock       mDBMockGet
 
+	NewIteratorFunc       func(p Key, p1 []byte, p2 bool) (r Iterator)
+	NewIteratorCounter    uint64
+	NewIteratorPreCounter uint64
+	NewIteratorMock       mDBMockNewIterator
+
 	SetFunc       func(p Key, p1 []byte) (r error)
 	SetCounter    uint64
 	SetPreCounter uint64
 	SetMock       mDBMockSet
+
+	UpdateFunc       func(p func(p DB) (r error)) (r error)
+	UpdateCounter    uint64
+	UpdatePreCounter uint64
+	UpdateMock       mDBMockUpdate
+
+	ViewFunc       func(p func(p DB) (r error)) (r error)
+	ViewCounter    uint64
+	ViewPreCounter uint64
+	ViewMock       mDBMockView
 }
 
 //NewDBMock returns a mock for github.com/insolar/insolar/internal/ledger/store.DB
@@ -36,12 +56,163 @@ func NewDBMock(t minimock.Tester) *DBMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.DeleteMock = mDBMockDelete{mock: m}
 	m.GetMock = mDBMockGet{mock: m}
+	m.NewIteratorMock = mDBMockNewIterator{mock: m}
 	m.SetMock = mDBMockSet{mock: m}
+	m.UpdateMock = mDBMockUpdate{mock: m}
+	m.ViewMock = mDBMockView{mock: m}
+
+	return m
+}
+
+type mDBMockDelete struct {
+	mock              *DBMock
+	mainExpectation   *DBMockDeleteExpectation
+	expectationSeries []*DBMockDeleteExpectation
+}
+
+type DBMockDeleteExpectation struct {
+	input  *DBMockDeleteInput
+	result *DBMockDeleteResult
+}
 
+type DBMockDeleteInput struct {
+	p Key
+}
+
+type DBMockDeleteResult struct {
+	r error
+}
+
+//Expect specifies that invocation of DB.Delete is expected from 1 to Infinity times
+func (m *mDBMockDelete) Expect(p Key) *mDBMockDelete {
+	m.mock.DeleteFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockDeleteExpectation{}
+	}
+	m.mainExpectation.input = &DBMockDeleteInput{p}
 	return m
 }
 
+//Return specifies results of invocation of DB.Delete
+func (m *mDBMockDelete) Return(r error) *DBMock {
+	m.mock.DeleteFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockDeleteExpectation{}
+	}
+	m.mainExpectation.result = &DBMockDeleteResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.Delete is expected once
+func (m *mDBMockDelete) ExpectOnce(p Key) *DBMockDeleteExpectation {
+	m.mock.DeleteFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockDeleteExpectation{}
+	expectation.input = &DBMockDeleteInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockDeleteExpectation) Return(r error) {
+	e.result = &DBMockDeleteResult{r}
+}
+
+//Set uses given function f as a mock of DB.Delete method
+func (m *mDBMockDelete) Set(f func(p Key) (r error)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.DeleteFunc = f
+	return m.mock
+}
+
+//Delete implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) Delete(p Key) (r error) {
+	counter := atomic.AddUint64(&m.DeletePreCounter, 1)
+	defer atomic.AddUint64(&m.DeleteCounter, 1)
+
+	if len(m.DeleteMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.DeleteMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.Delete. %v", p)
+			return
+		}
+
+		input := m.DeleteMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockDeleteInput{p}, "DB.Delete got unexpected parameters")
+
+		result := m.DeleteMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Delete")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.DeleteMock.mainExpectation != nil {
+
+		input := m.DeleteMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockDeleteInput{p}, "DB.Delete got unexpected parameters")
+		}
+
+		result := m.DeleteMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Delete")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.DeleteFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.Delete. %v", p)
+		return
+	}
+
+	return m.DeleteFunc(p)
+}
+
+//DeleteMinimockCounter returns a count of DBMock.DeleteFunc invocations
+func (m *DBMock) DeleteMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.DeleteCounter)
+}
+
+//DeleteMinimockPreCounter returns the value of DBMock.Delete invocations
+func (m *DBMock) DeleteMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.DeletePreCounter)
+}
+
+//DeleteFinished returns true if mock invocations count is ok
+func (m *DBMock) DeleteFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.DeleteMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.DeleteCounter) == uint64(len(m.DeleteMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.DeleteCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.DeleteFunc != nil {
+		return atomic.LoadUint64(&m.DeleteCounter) > 0
+	}
+
+	return true
+}
+
 type mDBMockGet struct {
 	mock              *DBMock
 	mainExpectation   *DBMockGetExpectation
@@ -192,6 +363,155 @@ func (m *DBMock) GetFinished() bool {
 	return true
 }
 
+type mDBMockNewIterator struct {
+	mock              *DBMock
+	mainExpectation   *DBMockNewIteratorExpectation
+	expectationSeries []*DBMockNewIteratorExpectation
+}
+
+type DBMockNewIteratorExpectation struct {
+	input  *DBMockNewIteratorInput
+	result *DBMockNewIteratorResult
+}
+
+type DBMockNewIteratorInput struct {
+	p  Key
+	p1 []byte
+	p2 bool
+}
+
+type DBMockNewIteratorResult struct {
+	r Iterator
+}
+
+//Expect specifies that invocation of DB.NewIterator is expected from 1 to Infinity times
+func (m *mDBMockNewIterator) Expect(p Key, p1 []byte, p2 bool) *mDBMockNewIterator {
+	m.mock.NewIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewIteratorExpectation{}
+	}
+	m.mainExpectation.input = &DBMockNewIteratorInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of DB.NewIterator
+func (m *mDBMockNewIterator) Return(r Iterator) *DBMock {
+	m.mock.NewIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewIteratorExpectation{}
+	}
+	m.mainExpectation.result = &DBMockNewIteratorResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.NewIterator is expected once
+func (m *mDBMockNewIterator) ExpectOnce(p Key, p1 []byte, p2 bool) *DBMockNewIteratorExpectation {
+	m.mock.NewIteratorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockNewIteratorExpectation{}
+	expectation.input = &DBMockNewIteratorInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockNewIteratorExpectation) Return(r Iterator) {
+	e.result = &DBMockNewIteratorResult{r}
+}
+
+//Set uses given function f as a mock of DB.NewIterator method
+func (m *mDBMockNewIterator) Set(f func(p Key, p1 []byte, p2 bool) (r Iterator)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NewIteratorFunc = f
+	return m.mock
+}
+
+//NewIterator implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) NewIterator(p Key, p1 []byte, p2 bool) (r Iterator) {
+	counter := atomic.AddUint64(&m.NewIteratorPreCounter, 1)
+	defer atomic.AddUint64(&m.NewIteratorCounter, 1)
+
+	if len(m.NewIteratorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NewIteratorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.NewIterator. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.NewIteratorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockNewIteratorInput{p, p1, p2}, "DB.NewIterator got unexpected parameters")
+
+		result := m.NewIteratorMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewIterator")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewIteratorMock.mainExpectation != nil {
+
+		input := m.NewIteratorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockNewIteratorInput{p, p1, p2}, "DB.NewIterator got unexpected parameters")
+		}
+
+		result := m.NewIteratorMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewIterator")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewIteratorFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.NewIterator. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.NewIteratorFunc(p, p1, p2)
+}
+
+//NewIteratorMinimockCounter returns a count of DBMock.NewIteratorFunc invocations
+func (m *DBMock) NewIteratorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NewIteratorCounter)
+}
+
+//NewIteratorMinimockPreCounter returns the value of DBMock.NewIterator invocations
+func (m *DBMock) NewIteratorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NewIteratorPreCounter)
+}
+
+//NewIteratorFinished returns true if mock invocations count is ok
+func (m *DBMock) NewIteratorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NewIteratorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NewIteratorCounter) == uint64(len(m.NewIteratorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NewIteratorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NewIteratorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NewIteratorFunc != nil {
+		return atomic.LoadUint64(&m.NewIteratorCounter) > 0
+	}
+
+	return true
+}
+
 type mDBMockSet struct {
 	mock              *DBMock
 	mainExpectation   *DBMockSetExpectation
@@ -340,18 +660,328 @@ func (m *DBMock) SetFinished() bool {
 	return true
 }
 
+type mDBMockUpdate struct {
+	mock              *DBMock
+	mainExpectation   *DBMockUpdateExpectation
+	expectationSeries []*DBMockUpdateExpectation
+}
+
+type DBMockUpdateExpectation struct {
+	input  *DBMockUpdateInput
+	result *DBMockUpdateResult
+}
+
+type DBMockUpdateInput struct {
+	p func(p DB) (r error)
+}
+
+type DBMockUpdateResult struct {
+	r error
+}
+
+//Expect specifies that invocation of DB.Update is expected from 1 to Infinity times
+func (m *mDBMockUpdate) Expect(p func(p DB) (r error)) *mDBMockUpdate {
+	m.mock.UpdateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockUpdateExpectation{}
+	}
+	m.mainExpectation.input = &DBMockUpdateInput{p}
+	return m
+}
+
+//Return specifies results of invocation of DB.Update
+func (m *mDBMockUpdate) Return(r error) *DBMock {
+	m.mock.UpdateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockUpdateExpectation{}
+	}
+	m.mainExpectation.result = &DBMockUpdateResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.Update is expected once
+func (m *mDBMockUpdate) ExpectOnce(p func(p DB) (r error)) *DBMockUpdateExpectation {
+	m.mock.UpdateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockUpdateExpectation{}
+	expectation.input = &DBMockUpdateInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockUpdateExpectation) Return(r error) {
+	e.result = &DBMockUpdateResult{r}
+}
+
+//Set uses given function f as a mock of DB.Update method
+func (m *mDBMockUpdate) Set(f func(p func(p DB) (r error)) (r error)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.UpdateFunc = f
+	return m.mock
+}
+
+//Update implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) Update(p func(p DB) (r error)) (r error) {
+	counter := atomic.AddUint64(&m.UpdatePreCounter, 1)
+	defer atomic.AddUint64(&m.UpdateCounter, 1)
+
+	if len(m.UpdateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.UpdateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.Update. %v", p)
+			return
+		}
+
+		input := m.UpdateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockUpdateInput{p}, "DB.Update got unexpected parameters")
+
+		result := m.UpdateMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Update")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.UpdateMock.mainExpectation != nil {
+
+		input := m.UpdateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockUpdateInput{p}, "DB.Update got unexpected parameters")
+		}
+
+		result := m.UpdateMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Update")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.UpdateFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.Update. %v", p)
+		return
+	}
+
+	return m.UpdateFunc(p)
+}
+
+//UpdateMinimockCounter returns a count of DBMock.UpdateFunc invocations
+func (m *DBMock) UpdateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.UpdateCounter)
+}
+
+//UpdateMinimockPreCounter returns the value of DBMock.Update invocations
+func (m *DBMock) UpdateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.UpdatePreCounter)
+}
+
+//UpdateFinished returns true if mock invocations count is ok
+func (m *DBMock) UpdateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.UpdateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.UpdateCounter) == uint64(len(m.UpdateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.UpdateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.UpdateFunc != nil {
+		return atomic.LoadUint64(&m.UpdateCounter) > 0
+	}
+
+	return true
+}
+
+type mDBMockView struct {
+	mock              *DBMock
+	mainExpectation   *DBMockViewExpectation
+	expectationSeries []*DBMockViewExpectation
+}
+
+type DBMockViewExpectation struct {
+	input  *DBMockViewInput
+	result *DBMockViewResult
+}
+
+type DBMockViewInput struct {
+	p func(p DB) (r error)
+}
+
+type DBMockViewResult struct {
+	r error
+}
+
+//Expect specifies that invocation of DB.View is expected from 1 to Infinity times
+func (m *mDBMockView) Expect(p func(p DB) (r error)) *mDBMockView {
+	m.mock.ViewFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockViewExpectation{}
+	}
+	m.mainExpectation.input = &DBMockViewInput{p}
+	return m
+}
+
+//Return specifies results of invocation of DB.View
+func (m *mDBMockView) Return(r error) *DBMock {
+	m.mock.ViewFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockViewExpectation{}
+	}
+	m.mainExpectation.result = &DBMockViewResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.View is expected once
+func (m *mDBMockView) ExpectOnce(p func(p DB) (r error)) *DBMockViewExpectation {
+	m.mock.ViewFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockViewExpectation{}
+	expectation.input = &DBMockViewInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockViewExpectation) Return(r error) {
+	e.result = &DBMockViewResult{r}
+}
+
+//Set uses given function f as a mock of DB.View method
+func (m *mDBMockView) Set(f func(p func(p DB) (r error)) (r error)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ViewFunc = f
+	return m.mock
+}
+
+//View implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) View(p func(p DB) (r error)) (r error) {
+	counter := atomic.AddUint64(&m.ViewPreCounter, 1)
+	defer atomic.AddUint64(&m.ViewCounter, 1)
+
+	if len(m.ViewMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ViewMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.View. %v", p)
+			return
+		}
+
+		input := m.ViewMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockViewInput{p}, "DB.View got unexpected parameters")
+
+		result := m.ViewMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.View")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ViewMock.mainExpectation != nil {
+
+		input := m.ViewMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockViewInput{p}, "DB.View got unexpected parameters")
+		}
+
+		result := m.ViewMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.View")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ViewFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.View. %v", p)
+		return
+	}
+
+	return m.ViewFunc(p)
+}
+
+//ViewMinimockCounter returns a count of DBMock.ViewFunc invocations
+func (m *DBMock) ViewMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ViewCounter)
+}
+
+//ViewMinimockPreCounter returns the value of DBMock.View invocations
+func (m *DBMock) ViewMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ViewPreCounter)
+}
+
+//ViewFinished returns true if mock invocations count is ok
+func (m *DBMock) ViewFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ViewMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ViewCounter) == uint64(len(m.ViewMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ViewMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ViewCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ViewFunc != nil {
+		return atomic.LoadUint64(&m.ViewCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *DBMock) ValidateCallCounters() {
 
+	if !m.DeleteFinished() {
+		m.t.Fatal("Expected call to DBMock.Delete")
+	}
+
 	if !m.GetFinished() {
 		m.t.Fatal("Expected call to DBMock.Get")
 	}
 
+	if !m.NewIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewIterator")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to DBMock.Set")
 	}
 
+	if !m.UpdateFinished() {
+		m.t.Fatal("Expected call to DBMock.Update")
+	}
+
+	if !m.ViewFinished() {
+		m.t.Fatal("Expected call to DBMock.View")
+	}
+
 }
 
 //CheckMocksCalled checks that all mocked methods of the interface have been called at least once
@@ -369,14 +999,30 @@ func (m *DBMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *DBMock) MinimockFinish() {
 
+	if !m.DeleteFinished() {
+		m.t.Fatal("Expected call to DBMock.Delete")
+	}
+
 	if !m.GetFinished() {
 		m.t.Fatal("Expected call to DBMock.Get")
 	}
 
+	if !m.NewIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewIterator")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to DBMock.Set")
 	}
 
+	if !m.UpdateFinished() {
+		m.t.Fatal("Expected call to DBMock.Update")
+	}
+
+	if !m.ViewFinished() {
+		m.t.Fatal("Expected call to DBMock.View")
+	}
+
 }
 
 //Wait waits for all mocked methods to be called at least once
@@ -391,8 +1037,12 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.DeleteFinished()
 		ok = ok && m.GetFinished()
+		ok = ok && m.NewIteratorFinished()
 		ok = ok && m.SetFinished()
+		ok = ok && m.UpdateFinished()
+		ok = ok && m.ViewFinished()
 
 		if ok {
 			return
@@ -401,14 +1051,30 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.DeleteFinished() {
+				m.t.Error("Expected call to DBMock.Delete")
+			}
+
 			if !m.GetFinished() {
 				m.t.Error("Expected call to DBMock.Get")
 			}
 
+			if !m.NewIteratorFinished() {
+				m.t.Error("Expected call to DBMock.NewIterator")
+			}
+
 			if !m.SetFinished() {
 				m.t.Error("Expected call to DBMock.Set")
 			}
 
+			if !m.UpdateFinished() {
+				m.t.Error("Expected call to DBMock.Update")
+			}
+
+			if !m.ViewFinished() {
+				m.t.Error("Expected call to DBMock.View")
+			}
+
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
 		default:
@@ -421,13 +1087,29 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *DBMock) AllMocksCalled() bool {
 
+	if !m.DeleteFinished() {
+		return false
+	}
+
 	if !m.GetFinished() {
 		return false
 	}
 
+	if !m.NewIteratorFinished() {
+		return false
+	}
+
 	if !m.SetFinished() {
 		return false
 	}
 
+	if !m.UpdateFinished() {
+		return false
+	}
+
+	if !m.ViewFinished() {
+		return false
+	}
+
 	return true
 }
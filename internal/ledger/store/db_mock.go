@@ -17,6 +17,8 @@
 package store
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 )
 
@@ -55,3 +57,117 @@ func (b *MockDB) Set(key Key, value []byte) error {
 	b.backend[string(fullKey)] = append([]byte{}, value...)
 	return nil
 }
+
+// Delete removes value for a key from memory storage.
+func (b *MockDB) Delete(key Key) error {
+	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.backend, string(fullKey))
+	return nil
+}
+
+// Update runs fn over a copy of the storage and replaces the storage with the copy if fn returns nil.
+// Transactions are serialized, so there are no conflicts.
+func (b *MockDB) Update(fn func(tx DB) error) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	tx := NewMemoryMockDB()
+	for k, v := range b.backend {
+		tx.backend[k] = v
+	}
+
+	err := fn(tx)
+	if err != nil {
+		return err
+	}
+
+	b.backend = tx.backend
+	return nil
+}
+
+// View runs fn over a read-only copy of the storage.
+func (b *MockDB) View(fn func(tx DB) error) error {
+	b.lock.RLock()
+	tx := &readOnlyMockDB{MockDB: NewMemoryMockDB()}
+	for k, v := range b.backend {
+		tx.backend[k] = v
+	}
+	b.lock.RUnlock()
+
+	return fn(tx)
+}
+
+// NewIterator returns an iterator over a snapshot of keys of pivot's scope, which IDs start with prefix.
+func (b *MockDB) NewIterator(pivot Key, prefix []byte, reverse bool) Iterator {
+	fullPrefix := append(pivot.Scope().Bytes(), prefix...)
+	seek := seekKey(pivot, reverse)
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	var keys []string
+	for k := range b.backend {
+		if !bytes.HasPrefix([]byte(k), fullPrefix) {
+			continue
+		}
+		if reverse && k > string(seek) {
+			continue
+		}
+		if !reverse && k < string(seek) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	if reverse {
+		sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	} else {
+		sort.Strings(keys)
+	}
+
+	it := &mockIterator{current: -1}
+	for _, k := range keys {
+		it.keys = append(it.keys, []byte(k)[len(Scope(0).Bytes()):])
+		it.values = append(it.values, append([]byte{}, b.backend[k]...))
+	}
+	return it
+}
+
+// readOnlyMockDB is a MockDB snapshot, that rejects writes.
+type readOnlyMockDB struct {
+	*MockDB
+}
+
+func (ro *readOnlyMockDB) Set(key Key, value []byte) error {
+	return ErrReadOnly
+}
+
+func (ro *readOnlyMockDB) Delete(key Key) error {
+	return ErrReadOnly
+}
+
+func (ro *readOnlyMockDB) Update(fn func(tx DB) error) error {
+	return ErrReadOnly
+}
+
+type mockIterator struct {
+	keys    [][]byte
+	values  [][]byte
+	current int
+}
+
+func (mi *mockIterator) Next() bool {
+	mi.current++
+	return mi.current < len(mi.keys)
+}
+
+func (mi *mockIterator) Key() []byte {
+	return append([]byte{}, mi.keys[mi.current]...)
+}
+
+func (mi *mockIterator) Value() ([]byte, error) {
+	return append([]byte{}, mi.values[mi.current]...), nil
+}
+
+func (mi *mockIterator) Close() {}
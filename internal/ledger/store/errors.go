@@ -23,4 +23,6 @@ import (
 var (
 	// ErrNotFound is returned when value was not found.
 	ErrNotFound = errors.New("value not found")
+	// ErrReadOnly is returned on write attempt in a read-only transaction.
+	ErrReadOnly = errors.New("transaction is read-only")
 )
@@ -25,6 +25,7 @@ import (
 	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
 )
 
@@ -52,6 +53,18 @@ func (k *dbKey) ID() []byte {
 	return k.id[:]
 }
 
+type pulseKey struct {
+	pn insolar.PulseNumber
+}
+
+func (k *pulseKey) Scope() store.Scope {
+	return store.ScopeBlob
+}
+
+func (k *pulseKey) ID() []byte {
+	return k.pn.Bytes()
+}
+
 // ForID returns Blob for provided id.
 func (s *DB) ForID(ctx context.Context, id insolar.ID) (Blob, error) {
 	b, err := s.db.Get(&dbKey{id: id})
@@ -65,6 +78,32 @@ func (s *DB) ForID(ctx context.Context, id insolar.ID) (Blob, error) {
 	return decode(b)
 }
 
+// ForPulse returns []Blob for a provided jetID and a pulse number.
+func (s *DB) ForPulse(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []Blob {
+	it := s.db.NewIterator(&pulseKey{pn: pn}, pn.Bytes(), false)
+	defer it.Close()
+
+	var res []Blob
+	for it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read blob"))
+			continue
+		}
+		b, err := decode(buf)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to decode blob"))
+			continue
+		}
+		if b.JetID != jetID {
+			continue
+		}
+		res = append(res, b)
+	}
+
+	return res
+}
+
 // Set saves new Blob-value in storage.
 func (s *DB) Set(ctx context.Context, id insolar.ID, blob Blob) error {
 	// Blob override is ok.
@@ -91,6 +130,11 @@ func (s *DB) Set(ctx context.Context, id insolar.ID, blob Blob) error {
 	return nil
 }
 
+// Delete removes Blob for provided id. Removing a missing Blob is not an error.
+func (s *DB) Delete(ctx context.Context, id insolar.ID) error {
+	return s.db.Delete(&dbKey{id: id})
+}
+
 // mustEncode serializes blob struct.
 func mustEncode(blob Blob) []byte {
 	var buf bytes.Buffer
@@ -126,3 +126,40 @@ func slice() []byte {
 	size := rand.Int31n(1024)
 	return sizedSlice(size)
 }
+
+func TestBlobStorages_ForPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	memStorage := NewStorageMemory()
+	dbStorage := NewDB(store.NewMemoryMockDB())
+
+	pn := gen.PulseNumber()
+	jetID := gen.JetID()
+	otherJetID := gen.JetID()
+	gen.UniqueJetIDs(&jetID, &otherJetID)
+
+	var expected []Blob
+	for i := 0; i < 10; i++ {
+		b := Blob{Value: slice(), JetID: jetID}
+		id := gen.ID()
+		switch i % 3 {
+		case 0:
+			// Another pulse.
+			id = *insolar.NewID(pn+1, id.Hash())
+		case 1:
+			// Another jet.
+			id = *insolar.NewID(pn, id.Hash())
+			b.JetID = otherJetID
+		default:
+			id = *insolar.NewID(pn, id.Hash())
+			expected = append(expected, b)
+		}
+		require.NoError(t, memStorage.Set(ctx, id, b))
+		require.NoError(t, dbStorage.Set(ctx, id, b))
+	}
+
+	assert.ElementsMatch(t, expected, memStorage.ForPulse(ctx, jetID, pn))
+	assert.ElementsMatch(t, expected, dbStorage.ForPulse(ctx, jetID, pn))
+}
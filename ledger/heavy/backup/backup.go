@@ -0,0 +1,257 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+// formatVersion is a version of snapshot stream layout. It should be increased on any incompatible change.
+const formatVersion = 1
+
+// Maker makes snapshots of heavy node storage.
+type Maker interface {
+	// Make writes a snapshot of all data up to provided pulse to a file in backup directory and returns its path.
+	Make(ctx context.Context, pn insolar.PulseNumber) (string, error)
+}
+
+// Snapshot stream is a sequence of CBOR values: header, entry frames in scope and key order, and a footer frame.
+type header struct {
+	Version     int
+	PulseNumber insolar.PulseNumber
+}
+
+type entry struct {
+	Scope store.Scope
+	ID    []byte
+	Value []byte
+}
+
+// DropHash identifies a drop included into a snapshot.
+type DropHash struct {
+	JetID insolar.JetID
+	Pulse insolar.PulseNumber
+	Hash  []byte
+}
+
+type footer struct {
+	Entries int
+	Drops   []DropHash
+}
+
+type frame struct {
+	Entry  *entry
+	Footer *footer
+}
+
+type rawKey struct {
+	scope store.Scope
+	id    []byte
+}
+
+func (k rawKey) Scope() store.Scope {
+	return k.scope
+}
+
+func (k rawKey) ID() []byte {
+	return k.id
+}
+
+// scopes lists scopes included into a snapshot with filters, that select keys of pulses up to the snapshot pulse.
+// Filters rely on key layouts of the corresponding storages. ScopeLastKnownIndexPN, ScopeRequestIndex and
+// ScopeTypeIndex are rebuilt on restore.
+var scopes = []struct {
+	scope   store.Scope
+	include func(id []byte, pn insolar.PulseNumber) bool
+}{
+	{scope: store.ScopePulse, include: pulseNodeBefore},
+	{scope: store.ScopeRecord, include: leadingPulseBefore},
+	{scope: store.ScopeJetDrop, include: trailingPulseBefore},
+	{scope: store.ScopeIndex, include: leadingPulseBefore},
+	{scope: store.ScopeBlob, include: leadingPulseBefore},
+	{scope: store.ScopeGenesis, include: func([]byte, insolar.PulseNumber) bool { return true }},
+	{scope: store.ScopeExportCatalog, include: leadingPulseBefore},
+}
+
+// leadingPulseBefore is used for keys, that start with a pulse number (record, index, blob and catalog keys).
+func leadingPulseBefore(id []byte, pn insolar.PulseNumber) bool {
+	if len(id) < insolar.PulseNumberSize {
+		return false
+	}
+	return insolar.NewPulseNumber(id[:insolar.PulseNumberSize]) <= pn
+}
+
+// trailingPulseBefore is used for keys, that end with a pulse number (drop keys).
+func trailingPulseBefore(id []byte, pn insolar.PulseNumber) bool {
+	if len(id) < insolar.PulseNumberSize {
+		return false
+	}
+	return insolar.NewPulseNumber(id[len(id)-insolar.PulseNumberSize:]) <= pn
+}
+
+// pulseNodeBefore is used for pulse storage keys. Only pulse nodes are included, meta records are restored from them.
+func pulseNodeBefore(id []byte, pn insolar.PulseNumber) bool {
+	if len(id) != insolar.PulseNumberSize+1 || id[0] != pulseNodePrefix {
+		return false
+	}
+	return insolar.NewPulseNumber(id[1:]) <= pn
+}
+
+// pulseNodePrefix is a key prefix of pulse nodes in pulse.DB.
+const pulseNodePrefix byte = 1
+
+// SnapshotMaker makes snapshots of a store.DB without stopping writes to it.
+type SnapshotMaker struct {
+	lock sync.Mutex
+	cfg  configuration.Backup
+	db   store.DB
+}
+
+// NewSnapshotMaker creates new snapshot maker, that writes snapshots to directory from config.
+func NewSnapshotMaker(cfg configuration.Backup, db store.DB) *SnapshotMaker {
+	return &SnapshotMaker{cfg: cfg, db: db}
+}
+
+// Make writes a snapshot of all data up to provided pulse to a file in backup directory and returns its path.
+// The file appears under its final name only when the snapshot is completely written.
+func (m *SnapshotMaker) Make(ctx context.Context, pn insolar.PulseNumber) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	err := os.MkdirAll(m.cfg.Directory, 0700)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create backup directory")
+	}
+
+	path := filepath.Join(m.cfg.Directory, fmt.Sprintf("snapshot-%d.cbor", pn))
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create snapshot file")
+	}
+	defer os.Remove(tmpPath) // nolint: errcheck
+
+	err = m.Write(ctx, f, pn)
+	if err != nil {
+		f.Close() // nolint: errcheck
+		return "", err
+	}
+	err = f.Sync()
+	if err != nil {
+		f.Close() // nolint: errcheck
+		return "", errors.Wrap(err, "failed to sync snapshot file")
+	}
+	err = f.Close()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to close snapshot file")
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to rename snapshot file")
+	}
+
+	inslogger.FromContext(ctx).Infof("[ SnapshotMaker.Make ] snapshot for pulse %v is written to %s", pn, path)
+	return path, nil
+}
+
+// Write writes a snapshot of all data up to provided pulse to w. All data is read from one consistent view of the
+// storage. If the pulse is not stored, ErrNoPulse will be returned.
+func (m *SnapshotMaker) Write(ctx context.Context, w io.Writer, pn insolar.PulseNumber) error {
+	return m.db.View(func(tx store.DB) error {
+		_, err := pulse.NewDB(tx).ForPulseNumber(ctx, pn)
+		if err == pulse.ErrNotFound {
+			return ErrNoPulse
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch pulse")
+		}
+
+		enc := codec.NewEncoder(w, &codec.CborHandle{})
+		err = enc.Encode(header{Version: formatVersion, PulseNumber: pn})
+		if err != nil {
+			return errors.Wrap(err, "failed to write header")
+		}
+
+		ft := footer{}
+		for _, s := range scopes {
+			err = writeScope(tx, enc, s.scope, func(id []byte) bool { return s.include(id, pn) }, &ft)
+			if err != nil {
+				return errors.Wrapf(err, "failed to write scope %d", s.scope)
+			}
+		}
+
+		err = enc.Encode(frame{Footer: &ft})
+		return errors.Wrap(err, "failed to write footer")
+	})
+}
+
+func writeScope(
+	db store.DB,
+	enc *codec.Encoder,
+	scope store.Scope,
+	include func(id []byte) bool,
+	ft *footer,
+) error {
+	it := db.NewIterator(rawKey{scope: scope}, nil, false)
+	defer it.Close()
+
+	for it.Next() {
+		id := it.Key()
+		if !include(id) {
+			continue
+		}
+		value, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		if scope == store.ScopeJetDrop {
+			d, err := drop.Decode(value)
+			if err != nil {
+				return errors.Wrap(err, "failed to decode drop")
+			}
+			ft.Drops = append(ft.Drops, DropHash{
+				JetID: d.JetID,
+				Pulse: d.Pulse,
+				Hash:  append([]byte{}, d.Hash...),
+			})
+		}
+
+		err = enc.Encode(frame{Entry: &entry{Scope: scope, ID: id, Value: value}})
+		if err != nil {
+			return err
+		}
+		ft.Entries++
+	}
+	return nil
+}
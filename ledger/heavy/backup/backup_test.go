@@ -0,0 +1,133 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestSnapshot_WriteAndRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	pulses := pulse.NewDB(db)
+	records := object.NewRecordDB(db)
+	blobs := blob.NewDB(db)
+	indexes := object.NewIndexDB(db)
+	drops := drop.NewDB(db)
+
+	first := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}
+	second := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 20}
+	third := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 30}
+	for _, p := range []insolar.Pulse{first, second, third} {
+		require.NoError(t, pulses.Append(ctx, p))
+	}
+
+	jetID := gen.JetID()
+	objID := gen.ID()
+	var recIDs, blobIDs []insolar.ID
+	for i, p := range []insolar.Pulse{first, second, third} {
+		virtual := record.Wrap(record.Code{Code: gen.ID()})
+		recID := *insolar.NewID(p.PulseNumber, []byte{byte(i)})
+		require.NoError(t, records.Set(ctx, recID, record.Material{Virtual: &virtual, JetID: jetID}))
+		recIDs = append(recIDs, recID)
+
+		blobID := *insolar.NewID(p.PulseNumber, []byte{byte(i)})
+		require.NoError(t, blobs.Set(ctx, blobID, blob.Blob{Value: []byte{byte(i)}, JetID: jetID}))
+		blobIDs = append(blobIDs, blobID)
+
+		lifeline := object.Lifeline{LatestState: &recID, JetID: jetID}
+		require.NoError(t, indexes.Set(ctx, p.PulseNumber, objID, lifeline))
+
+		d := drop.Drop{Pulse: p.PulseNumber, JetID: jetID, Hash: []byte{byte(i + 1)}}
+		if i > 0 {
+			d.PrevHash = []byte{byte(i)}
+		}
+		require.NoError(t, drops.Set(ctx, d))
+	}
+
+	maker := NewSnapshotMaker(configuration.Backup{}, db)
+
+	t.Run("unknown pulse", func(t *testing.T) {
+		err := maker.Write(ctx, &bytes.Buffer{}, third.PulseNumber+1)
+		assert.Equal(t, ErrNoPulse, err)
+	})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, maker.Write(ctx, buf, second.PulseNumber))
+	snapshot := buf.Bytes()
+
+	t.Run("restores data up to pulse", func(t *testing.T) {
+		restoredDB := store.NewMemoryMockDB()
+		summary, err := NewRestorer(restoredDB).Restore(ctx, bytes.NewReader(snapshot))
+		require.NoError(t, err)
+		assert.Equal(t, second.PulseNumber, summary.PulseNumber)
+		assert.Equal(t, 2, summary.Drops)
+
+		restoredPulses := pulse.NewDB(restoredDB)
+		latest, err := restoredPulses.Latest(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, second.PulseNumber, latest.PulseNumber)
+		_, err = restoredPulses.Forwards(ctx, second.PulseNumber, 1)
+		assert.Equal(t, pulse.ErrNotFound, err)
+
+		restoredRecords := object.NewRecordDB(restoredDB)
+		_, err = restoredRecords.ForID(ctx, recIDs[1])
+		assert.NoError(t, err)
+		_, err = restoredRecords.ForID(ctx, recIDs[2])
+		assert.Error(t, err)
+
+		restoredBlobs := blob.NewDB(restoredDB)
+		_, err = restoredBlobs.ForID(ctx, blobIDs[1])
+		assert.NoError(t, err)
+		_, err = restoredBlobs.ForID(ctx, blobIDs[2])
+		assert.Error(t, err)
+
+		lifeline, err := object.NewIndexDB(restoredDB).ForID(ctx, third.PulseNumber, objID)
+		require.NoError(t, err)
+		assert.Equal(t, &recIDs[1], lifeline.LatestState)
+
+		_, err = drop.NewDB(restoredDB).ForPulse(ctx, jetID, third.PulseNumber)
+		assert.Error(t, err)
+	})
+
+	t.Run("not empty storage", func(t *testing.T) {
+		_, err := NewRestorer(db).Restore(ctx, bytes.NewReader(snapshot))
+		assert.Equal(t, ErrNotEmpty, err)
+	})
+
+	t.Run("truncated snapshot", func(t *testing.T) {
+		_, err := NewRestorer(store.NewMemoryMockDB()).Restore(ctx, bytes.NewReader(snapshot[:len(snapshot)/2]))
+		assert.Error(t, err)
+	})
+}
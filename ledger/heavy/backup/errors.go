@@ -0,0 +1,38 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNoPulse is returned when a snapshot is requested for a pulse, that is not stored.
+	ErrNoPulse = errors.New("pulse is not stored")
+
+	// ErrNotEmpty is returned when a snapshot is restored into a storage, that already has data.
+	ErrNotEmpty = errors.New("storage is not empty")
+
+	// ErrBadVersion is returned when a snapshot has unsupported format version.
+	ErrBadVersion = errors.New("unsupported snapshot version")
+
+	// ErrTruncated is returned when a snapshot ends before its footer.
+	ErrTruncated = errors.New("snapshot is truncated")
+
+	// ErrHashMismatch is returned when a restored drop hash differs from the one in snapshot.
+	ErrHashMismatch = errors.New("drop hash mismatch")
+)
@@ -0,0 +1,255 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// restoreBatchSize is a max number of entries written in one transaction on restore.
+const restoreBatchSize = 1000
+
+// Summary describes a restored snapshot.
+type Summary struct {
+	PulseNumber insolar.PulseNumber
+	Entries     int
+	Drops       int
+}
+
+// Restorer loads snapshots into an empty storage.
+type Restorer struct {
+	db store.DB
+}
+
+// NewRestorer creates new restorer, that loads snapshots into provided storage.
+func NewRestorer(db store.DB) *Restorer {
+	return &Restorer{db: db}
+}
+
+// Restore loads a snapshot from r and verifies restored drops against hashes saved in the snapshot. Storage should
+// be empty, otherwise ErrNotEmpty will be returned.
+func (r *Restorer) Restore(ctx context.Context, rd io.Reader) (*Summary, error) {
+	pulses := pulse.NewDB(r.db)
+	_, err := pulses.Latest(ctx)
+	if err == nil {
+		return nil, ErrNotEmpty
+	}
+	if err != pulse.ErrNotFound {
+		return nil, errors.Wrap(err, "failed to check storage")
+	}
+
+	dec := codec.NewDecoder(rd, &codec.CborHandle{})
+	var h header
+	err = dec.Decode(&h)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read header")
+	}
+	if h.Version != formatVersion {
+		return nil, errors.Wrapf(ErrBadVersion, "got version %d", h.Version)
+	}
+
+	var (
+		batch   []entry
+		entries int
+		ft      *footer
+	)
+	for ft == nil {
+		var f frame
+		err = dec.Decode(&f)
+		if err == io.EOF {
+			return nil, ErrTruncated
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read entry")
+		}
+
+		if f.Footer != nil {
+			ft = f.Footer
+			continue
+		}
+		if f.Entry == nil {
+			continue
+		}
+		batch = append(batch, *f.Entry)
+		entries++
+		if len(batch) < restoreBatchSize {
+			continue
+		}
+		err = r.load(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		batch = batch[:0]
+	}
+	err = r.load(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	if entries != ft.Entries {
+		return nil, errors.Wrapf(ErrTruncated, "expected %d entries, got %d", ft.Entries, entries)
+	}
+
+	err = pulses.ResetHead(ctx, h.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set latest pulse")
+	}
+
+	err = r.verify(ctx, ft.Drops)
+	if err != nil {
+		return nil, err
+	}
+
+	inslogger.FromContext(ctx).Infof(
+		"[ Restorer.Restore ] snapshot for pulse %v is restored: %d entries, %d drops",
+		h.PulseNumber, entries, len(ft.Drops),
+	)
+	return &Summary{
+		PulseNumber: h.PulseNumber,
+		Entries:     entries,
+		Drops:       len(ft.Drops),
+	}, nil
+}
+
+func (r *Restorer) load(ctx context.Context, batch []entry) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	err := r.db.Update(func(tx store.DB) error {
+		indexes := object.NewIndexDB(tx)
+		requests := object.NewRequestIndexDB(tx)
+		types := object.NewTypeIndexDB(tx)
+		for _, e := range batch {
+			if e.Scope == store.ScopeRecord {
+				err := indexRecord(ctx, requests, types, e)
+				if err != nil {
+					return err
+				}
+			}
+			if e.Scope != store.ScopeIndex {
+				err := tx.Set(rawKey{scope: e.Scope, id: e.ID}, e.Value)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			// Index buckets are set through index storage to rebuild last known pulses of objects. Buckets come in
+			// pulse order, so the last one wins.
+			if len(e.ID) < insolar.PulseNumberSize {
+				return errors.New("bad index key")
+			}
+			bucket := object.IndexBucket{}
+			err := bucket.Unmarshal(e.Value)
+			if err != nil {
+				return errors.Wrap(err, "failed to decode index bucket")
+			}
+			err = indexes.SetBucket(ctx, insolar.NewPulseNumber(e.ID[:insolar.PulseNumberSize]), bucket)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errors.Wrap(err, "failed to write entries")
+}
+
+// indexRecord adds a record entry to requests and type indexes.
+func indexRecord(
+	ctx context.Context, requests object.RequestIndexModifier, types object.TypeIndexModifier, e entry,
+) error {
+	if len(e.ID) != insolar.RecordIDSize {
+		return errors.New("bad record key")
+	}
+	rec := record.Material{}
+	err := rec.Unmarshal(e.Value)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode record")
+	}
+	var id insolar.ID
+	copy(id[:], e.ID)
+	err = requests.IndexRecord(ctx, id, rec)
+	if err != nil {
+		return err
+	}
+	if te, ok := object.NewTypeIndexEntry(id, rec); ok {
+		return types.Add(ctx, te)
+	}
+	return nil
+}
+
+// verify checks, that restored drops have the same hashes as in snapshot and that every drop with previous hash has
+// a matching drop in the previous pulse.
+func (r *Restorer) verify(ctx context.Context, expected []DropHash) error {
+	drops := drop.NewDB(r.db)
+	pulses := pulse.NewDB(r.db)
+
+	var restored []drop.Drop
+	hashes := map[insolar.PulseNumber][][]byte{}
+	for _, h := range expected {
+		d, err := drops.ForPulse(ctx, h.JetID, h.Pulse)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch drop for jet %v and pulse %v", h.JetID.DebugString(), h.Pulse)
+		}
+		if !bytes.Equal(d.Hash, h.Hash) {
+			return errors.Wrapf(ErrHashMismatch, "jet %v, pulse %v", h.JetID.DebugString(), h.Pulse)
+		}
+		restored = append(restored, d)
+		hashes[d.Pulse] = append(hashes[d.Pulse], d.Hash)
+	}
+
+	for _, d := range restored {
+		if len(d.PrevHash) == 0 {
+			continue
+		}
+		prev, err := pulses.Backwards(ctx, d.Pulse, 1)
+		if err == pulse.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch previous pulse")
+		}
+		if !containsHash(hashes[prev.PulseNumber], d.PrevHash) {
+			return errors.Wrapf(
+				ErrHashMismatch,
+				"previous drop is not found for jet %v, pulse %v", d.JetID.DebugString(), d.Pulse,
+			)
+		}
+	}
+	return nil
+}
+
+func containsHash(hashes [][]byte, hash []byte) bool {
+	for _, h := range hashes {
+		if bytes.Equal(h, hash) {
+			return true
+		}
+	}
+	return false
+}
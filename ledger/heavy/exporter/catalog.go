@@ -0,0 +1,161 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// CatalogModifier provides methods for registering stored data in a catalog.
+type CatalogModifier interface {
+	// Add appends ids from provided entry to the catalog of a pulse.
+	Add(ctx context.Context, pn insolar.PulseNumber, entry Entry) error
+}
+
+// CatalogAccessor provides methods for fetching catalog entries.
+type CatalogAccessor interface {
+	// ForPulse returns ids of all the data, that was stored for a pulse.
+	ForPulse(ctx context.Context, pn insolar.PulseNumber) (Entry, error)
+}
+
+// Entry holds ids of data, that heavy has stored for a pulse.
+type Entry struct {
+	// Jets are jets, which payloads were received for a pulse.
+	Jets []insolar.JetID
+	// Records are ids of stored records.
+	Records []insolar.ID
+	// Blobs are ids of stored blobs.
+	Blobs []insolar.ID
+	// Indexes are ids of objects, which index buckets were stored.
+	Indexes []insolar.ID
+}
+
+// CatalogDB is a db-based catalog of data, that was stored on heavy per pulse.
+type CatalogDB struct {
+	lock sync.Mutex
+	db   store.DB
+}
+
+type catalogKey insolar.PulseNumber
+
+func (k catalogKey) Scope() store.Scope {
+	return store.ScopeExportCatalog
+}
+
+func (k catalogKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+// NewCatalogDB creates a new catalog, that holds data in a db.
+func NewCatalogDB(db store.DB) *CatalogDB {
+	return &CatalogDB{db: db}
+}
+
+// Add appends ids from provided entry to the catalog of a pulse.
+func (c *CatalogDB) Add(ctx context.Context, pn insolar.PulseNumber, entry Entry) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	current, err := c.get(pn)
+	if err != nil && err != ErrNotFound {
+		return errors.Wrapf(err, "failed to fetch catalog for pulse %v", pn)
+	}
+
+	current.Jets = append(current.Jets, entry.Jets...)
+	current.Records = append(current.Records, entry.Records...)
+	current.Blobs = append(current.Blobs, entry.Blobs...)
+	current.Indexes = append(current.Indexes, entry.Indexes...)
+
+	return c.db.Set(catalogKey(pn), mustEncodeEntry(current))
+}
+
+// Remove removes provided record and blob ids from the catalog of a pulse. It's used when data is pruned.
+func (c *CatalogDB) Remove(ctx context.Context, pn insolar.PulseNumber, ids ...insolar.ID) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	current, err := c.get(pn)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch catalog for pulse %v", pn)
+	}
+
+	removed := map[insolar.ID]struct{}{}
+	for _, id := range ids {
+		removed[id] = struct{}{}
+	}
+	current.Records = withoutIDs(current.Records, removed)
+	current.Blobs = withoutIDs(current.Blobs, removed)
+
+	return c.db.Set(catalogKey(pn), mustEncodeEntry(current))
+}
+
+// ForPulse returns ids of all the data, that was stored for a pulse.
+func (c *CatalogDB) ForPulse(ctx context.Context, pn insolar.PulseNumber) (Entry, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.get(pn)
+}
+
+func (c *CatalogDB) get(pn insolar.PulseNumber) (Entry, error) {
+	buf, err := c.db.Get(catalogKey(pn))
+	if err == store.ErrNotFound {
+		return Entry{}, ErrNotFound
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+	return decodeEntry(buf)
+}
+
+func withoutIDs(ids []insolar.ID, removed map[insolar.ID]struct{}) []insolar.ID {
+	res := ids[:0]
+	for _, id := range ids {
+		if _, ok := removed[id]; !ok {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+func mustEncodeEntry(entry Entry) []byte {
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
+	err := enc.Encode(entry)
+	if err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func decodeEntry(buf []byte) (Entry, error) {
+	dec := codec.NewDecoder(bytes.NewReader(buf), &codec.CborHandle{})
+	var entry Entry
+	err := dec.Decode(&entry)
+	return entry, err
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+func TestCatalogDB_ForPulse_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	catalog := NewCatalogDB(store.NewMemoryMockDB())
+
+	_, err := catalog.ForPulse(ctx, gen.PulseNumber())
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestCatalogDB_Add(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	catalog := NewCatalogDB(store.NewMemoryMockDB())
+	pn := gen.PulseNumber()
+
+	first := Entry{
+		Jets:    []insolar.JetID{gen.JetID()},
+		Records: []insolar.ID{gen.ID(), gen.ID()},
+		Blobs:   []insolar.ID{gen.ID()},
+		Indexes: []insolar.ID{gen.ID()},
+	}
+	second := Entry{
+		Jets:    []insolar.JetID{gen.JetID()},
+		Records: []insolar.ID{gen.ID()},
+	}
+
+	err := catalog.Add(ctx, pn, first)
+	require.NoError(t, err)
+	err = catalog.Add(ctx, pn, second)
+	require.NoError(t, err)
+
+	entry, err := catalog.ForPulse(ctx, pn)
+	require.NoError(t, err)
+	assert.Equal(t, append(first.Jets, second.Jets...), entry.Jets)
+	assert.Equal(t, append(first.Records, second.Records...), entry.Records)
+	assert.Equal(t, first.Blobs, entry.Blobs)
+	assert.Equal(t, first.Indexes, entry.Indexes)
+}
+
+func TestCatalogDB_Remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	catalog := NewCatalogDB(store.NewMemoryMockDB())
+	pn := gen.PulseNumber()
+
+	kept, removed := gen.ID(), gen.ID()
+	blobID := gen.ID()
+	err := catalog.Add(ctx, pn, Entry{
+		Records: []insolar.ID{kept, removed},
+		Blobs:   []insolar.ID{blobID},
+	})
+	require.NoError(t, err)
+
+	err = catalog.Remove(ctx, pn, removed, blobID)
+	require.NoError(t, err)
+
+	entry, err := catalog.ForPulse(ctx, pn)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.ID{kept}, entry.Records)
+	assert.Empty(t, entry.Blobs)
+
+	err = catalog.Remove(ctx, pn+1, removed)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,32 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotFound is returned when value was not found.
+	ErrNotFound = errors.New("value not found")
+
+	// ErrBadSize is returned when a requested page size is not positive.
+	ErrBadSize = errors.New("size must be positive")
+
+	// ErrTooEarly is returned when a requested pulse is not finalized yet.
+	ErrTooEarly = errors.New("pulse is not ready for export yet")
+)
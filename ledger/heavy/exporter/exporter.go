@@ -0,0 +1,228 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// Exporter provides methods for fetching finalized pulses from heavy.
+type Exporter interface {
+	// Export returns up to size finalized pulses starting from provided pulse number.
+	Export(ctx context.Context, from insolar.PulseNumber, size int) (*Result, error)
+}
+
+// Result is a page of exported pulses.
+type Result struct {
+	// Pulses are exported pulses in pulse order.
+	Pulses []Pulse
+	// NextFrom is a pulse number to request the next page from. It's nil when there are no known pulses left.
+	NextFrom *insolar.PulseNumber
+	// Size is a number of exported pulses.
+	Size int
+}
+
+// Pulse holds data, that was stored on heavy for a single pulse.
+type Pulse struct {
+	PulseNumber    insolar.PulseNumber
+	PulseTimestamp int64
+	Jets           []insolar.JetID
+	Records        []Record
+	Blobs          []Blob
+	Indexes        []Index
+}
+
+// Record is an exported record.
+type Record struct {
+	ID      insolar.ID
+	JetID   insolar.JetID
+	Type    string
+	Payload record.Record
+}
+
+// Blob is an exported blob.
+type Blob struct {
+	ID    insolar.ID
+	JetID insolar.JetID
+	Value []byte
+}
+
+// Index is an exported index change.
+type Index struct {
+	ObjID    insolar.ID
+	Lifeline object.Lifeline
+}
+
+// PulseExporter is an Exporter implementation, that reads data stored by heavy.
+type PulseExporter struct {
+	cfg configuration.Exporter
+
+	pulses     pulse.Accessor
+	calculator pulse.Calculator
+	catalog    CatalogAccessor
+	records    object.RecordAccessor
+	blobs      blob.Accessor
+	indexes    object.LifelineAccessor
+}
+
+// NewPulseExporter creates a new PulseExporter instance.
+func NewPulseExporter(
+	cfg configuration.Exporter,
+	pulses pulse.Accessor,
+	calculator pulse.Calculator,
+	catalog CatalogAccessor,
+	records object.RecordAccessor,
+	blobs blob.Accessor,
+	indexes object.LifelineAccessor,
+) *PulseExporter {
+	return &PulseExporter{
+		cfg:        cfg,
+		pulses:     pulses,
+		calculator: calculator,
+		catalog:    catalog,
+		records:    records,
+		blobs:      blobs,
+		indexes:    indexes,
+	}
+}
+
+// Export returns up to size finalized pulses starting from provided pulse number.
+// Pulses younger than ExportLag are not exported, NextFrom points to the first of them.
+func (e *PulseExporter) Export(ctx context.Context, from insolar.PulseNumber, size int) (*Result, error) {
+	if size <= 0 {
+		return nil, ErrBadSize
+	}
+	if from < insolar.FirstPulseNumber {
+		from = insolar.FirstPulseNumber
+	}
+
+	current, err := e.pulses.ForPulseNumber(ctx, from)
+	if err == pulse.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch pulse %v", from)
+	}
+
+	res := &Result{}
+	for {
+		if !e.isFinalized(current) {
+			pn := current.PulseNumber
+			res.NextFrom = &pn
+			break
+		}
+		if res.Size >= size {
+			pn := current.PulseNumber
+			res.NextFrom = &pn
+			break
+		}
+
+		exported, err := e.exportPulse(ctx, current)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export pulse %v", current.PulseNumber)
+		}
+		res.Pulses = append(res.Pulses, exported)
+		res.Size++
+
+		current, err = e.calculator.Forwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate next pulse")
+		}
+	}
+
+	return res, nil
+}
+
+func (e *PulseExporter) isFinalized(p insolar.Pulse) bool {
+	lag := time.Duration(e.cfg.ExportLag) * time.Second
+	return time.Since(time.Unix(0, p.PulseTimestamp)) >= lag
+}
+
+func (e *PulseExporter) exportPulse(ctx context.Context, p insolar.Pulse) (Pulse, error) {
+	res := Pulse{
+		PulseNumber:    p.PulseNumber,
+		PulseTimestamp: p.PulseTimestamp,
+	}
+
+	entry, err := e.catalog.ForPulse(ctx, p.PulseNumber)
+	if err == ErrNotFound {
+		return res, nil
+	}
+	if err != nil {
+		return Pulse{}, errors.Wrap(err, "failed to fetch catalog")
+	}
+	res.Jets = entry.Jets
+
+	for _, id := range entry.Records {
+		rec, err := e.records.ForID(ctx, id)
+		if err != nil {
+			return Pulse{}, errors.Wrapf(err, "failed to fetch record %v", id.DebugString())
+		}
+		concrete := record.Unwrap(rec.Virtual)
+		res.Records = append(res.Records, Record{
+			ID:      id,
+			JetID:   rec.JetID,
+			Type:    typeName(concrete),
+			Payload: concrete,
+		})
+	}
+
+	for _, id := range entry.Blobs {
+		b, err := e.blobs.ForID(ctx, id)
+		if err != nil {
+			return Pulse{}, errors.Wrapf(err, "failed to fetch blob %v", id.DebugString())
+		}
+		res.Blobs = append(res.Blobs, Blob{
+			ID:    id,
+			JetID: b.JetID,
+			Value: b.Value,
+		})
+	}
+
+	for _, objID := range entry.Indexes {
+		lifeline, err := e.indexes.ForID(ctx, p.PulseNumber, objID)
+		if err != nil {
+			return Pulse{}, errors.Wrapf(err, "failed to fetch index %v", objID.DebugString())
+		}
+		res.Indexes = append(res.Indexes, Index{
+			ObjID:    objID,
+			Lifeline: lifeline,
+		})
+	}
+
+	return res, nil
+}
+
+func typeName(rec record.Record) string {
+	name := fmt.Sprintf("%T", rec)
+	return name[strings.LastIndex(name, ".")+1:]
+}
@@ -0,0 +1,117 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestPulseExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	pulses := pulse.NewDB(db)
+	catalog := NewCatalogDB(db)
+	records := object.NewRecordDB(db)
+	blobs := blob.NewDB(db)
+	indexes := object.NewIndexDB(db)
+
+	old := time.Now().Add(-time.Hour).UnixNano()
+	first := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10, PulseTimestamp: old}
+	second := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 20, PulseTimestamp: old}
+	fresh := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 30, PulseTimestamp: time.Now().UnixNano()}
+	for _, p := range []insolar.Pulse{first, second, fresh} {
+		require.NoError(t, pulses.Append(ctx, p))
+	}
+
+	jetID := gen.JetID()
+	virtual := record.Wrap(record.Code{Code: gen.ID()})
+	recID := gen.ID()
+	require.NoError(t, records.Set(ctx, recID, record.Material{Virtual: &virtual, JetID: jetID}))
+	blobID := gen.ID()
+	require.NoError(t, blobs.Set(ctx, blobID, blob.Blob{Value: []byte{1, 2, 3}, JetID: jetID}))
+	objID := gen.ID()
+	lifeline := object.Lifeline{LatestState: &recID, JetID: jetID}
+	require.NoError(t, indexes.Set(ctx, second.PulseNumber, objID, lifeline))
+
+	err := catalog.Add(ctx, second.PulseNumber, Entry{
+		Jets:    []insolar.JetID{jetID},
+		Records: []insolar.ID{recID},
+		Blobs:   []insolar.ID{blobID},
+		Indexes: []insolar.ID{objID},
+	})
+	require.NoError(t, err)
+
+	exp := NewPulseExporter(configuration.Exporter{ExportLag: 40}, pulses, pulses, catalog, records, blobs, indexes)
+
+	t.Run("bad size", func(t *testing.T) {
+		_, err := exp.Export(ctx, first.PulseNumber, 0)
+		assert.Equal(t, ErrBadSize, err)
+	})
+
+	t.Run("unknown pulse", func(t *testing.T) {
+		_, err := exp.Export(ctx, fresh.PulseNumber+1, 10)
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("stops on lag", func(t *testing.T) {
+		res, err := exp.Export(ctx, first.PulseNumber, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Size)
+		require.Len(t, res.Pulses, 2)
+		require.NotNil(t, res.NextFrom)
+		assert.Equal(t, fresh.PulseNumber, *res.NextFrom)
+
+		assert.Equal(t, first.PulseNumber, res.Pulses[0].PulseNumber)
+		assert.Empty(t, res.Pulses[0].Records)
+
+		exported := res.Pulses[1]
+		assert.Equal(t, second.PulseNumber, exported.PulseNumber)
+		assert.Equal(t, []insolar.JetID{jetID}, exported.Jets)
+		require.Len(t, exported.Records, 1)
+		assert.Equal(t, recID, exported.Records[0].ID)
+		assert.Equal(t, "Code", exported.Records[0].Type)
+		require.Len(t, exported.Blobs, 1)
+		assert.Equal(t, []byte{1, 2, 3}, exported.Blobs[0].Value)
+		require.Len(t, exported.Indexes, 1)
+		assert.Equal(t, objID, exported.Indexes[0].ObjID)
+		assert.Equal(t, &recID, exported.Indexes[0].Lifeline.LatestState)
+	})
+
+	t.Run("pages by size", func(t *testing.T) {
+		res, err := exp.Export(ctx, first.PulseNumber, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Size)
+		require.NotNil(t, res.NextFrom)
+		assert.Equal(t, second.PulseNumber, *res.NextFrom)
+	})
+}
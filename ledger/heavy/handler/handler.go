@@ -28,8 +28,9 @@ import (
 	"github.com/insolar/insolar/insolar/payload"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/blob"
-	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/retention"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
@@ -46,12 +47,12 @@ type Handler struct {
 	JetCoordinator        jet.Coordinator
 	PCS                   insolar.PlatformCryptographyScheme
 	BlobAccessor          blob.Accessor
-	BlobModifier          blob.Modifier
 	RecordAccessor        object.RecordAccessor
-	RecordModifier        object.RecordModifier
 	IndexLifelineAccessor object.LifelineAccessor
-	IndexBucketModifier   object.IndexBucketModifier
-	DropModifier          drop.Modifier
+	RequestIndexAccessor  object.RequestIndexAccessor
+	RetentionHorizon      retention.HorizonAccessor
+	// DB is used to store replicated payloads atomically.
+	DB store.DB
 
 	jetID insolar.JetID
 }
@@ -116,6 +117,9 @@ func (h *Handler) Init(ctx context.Context) error {
 	h.Bus.MustRegister(insolar.TypeGetChildren, h.handleGetChildren)
 	h.Bus.MustRegister(insolar.TypeGetObjectIndex, h.handleGetObjectIndex)
 	h.Bus.MustRegister(insolar.TypeGetRequest, h.handleGetRequest)
+	h.Bus.MustRegister(insolar.TypeGetObjectHistory, h.handleGetObjectHistory)
+	h.Bus.MustRegister(insolar.TypeGetObjectRequests, h.handleGetObjectRequests)
+	h.Bus.MustRegister(insolar.TypeGetObjectEvents, h.handleGetObjectEvents)
 	return nil
 }
 
@@ -293,6 +297,220 @@ func (h *Handler) handleGetChildren(
 	return &reply.Children{Refs: refs, NextFrom: nil}, nil
 }
 
+func (h *Handler) handleGetObjectHistory(
+	ctx context.Context, parcel insolar.Parcel,
+) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectHistory)
+
+	stateID := msg.FromState
+	if stateID == nil {
+		idx, err := h.IndexLifelineAccessor.ForID(ctx, parcel.Pulse(), *msg.Head.Record())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch object index for %s", msg.Head.Record().DebugString())
+		}
+		stateID = idx.LatestState
+	}
+	if stateID == nil {
+		return &reply.Error{ErrType: reply.ErrStateNotAvailable}, nil
+	}
+
+	rep := reply.ObjectHistory{}
+	// supersededIn is a pulse of the state, that supersedes the fetched one. It's unknown for the first state.
+	var supersededIn insolar.PulseNumber
+	for stateID != nil && len(rep.States) < msg.Amount {
+		rec, err := h.RecordAccessor.ForID(ctx, *stateID)
+		if err == object.ErrNotFound {
+			pruned, pruneErr := h.isPruned(ctx, *stateID, supersededIn)
+			if pruneErr != nil {
+				return nil, pruneErr
+			}
+			if !pruned {
+				return nil, errors.Errorf("state %s for %s is not found", stateID.DebugString(), msg.Head.Record())
+			}
+			// History ends at the retention horizon, older states are removed.
+			stateID = nil
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch state %s for %s", stateID.DebugString(), msg.Head.Record())
+		}
+
+		virtRec := rec.Virtual
+		state, ok := record.Unwrap(virtRec).(record.State)
+		if !ok {
+			return nil, errors.New("invalid object record")
+		}
+		data, err := virtRec.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to serialize state")
+		}
+
+		objState := reply.ObjectState{
+			ID:     *stateID,
+			Record: data,
+		}
+		if state.GetMemory() != nil && state.GetMemory().NotEmpty() {
+			b, err := h.BlobAccessor.ForID(ctx, *state.GetMemory())
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to fetch blob")
+			}
+			objState.Memory = b.Value
+		}
+
+		rep.States = append(rep.States, objState)
+		supersededIn = stateID.Pulse()
+		stateID = state.PrevStateID()
+	}
+	rep.NextFrom = stateID
+
+	return &rep, nil
+}
+
+// isPruned checks if a missing state could be removed by retention. States are removed, when their successors are
+// behind the retention horizon. If successor is unknown, the state itself must be behind the horizon.
+func (h *Handler) isPruned(ctx context.Context, stateID insolar.ID, supersededIn insolar.PulseNumber) (bool, error) {
+	horizon, err := h.RetentionHorizon.Horizon(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to fetch retention horizon")
+	}
+	if supersededIn == 0 {
+		return stateID.Pulse() < horizon, nil
+	}
+	return supersededIn <= horizon, nil
+}
+
+func (h *Handler) handleGetObjectRequests(
+	ctx context.Context, parcel insolar.Parcel,
+) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectRequests)
+
+	pairs, next, err := h.RequestIndexAccessor.ForObject(ctx, *msg.Object.Record(), msg.FromRequest, msg.Amount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch requests for %s", msg.Object.Record().DebugString())
+	}
+
+	rep := reply.ObjectRequests{NextFrom: next}
+	for _, p := range pairs {
+		rr := reply.RequestResult{RequestID: p.Request}
+		rr.Request, err = h.rawRecord(ctx, p.Request)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch request %s", p.Request.DebugString())
+		}
+		if p.Result != nil {
+			rr.ResultID = p.Result
+			rr.Result, err = h.rawRecord(ctx, *p.Result)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch result %s", p.Result.DebugString())
+			}
+		}
+		rep.Requests = append(rep.Requests, rr)
+	}
+
+	return &rep, nil
+}
+
+// eventsScanChunk is how many requests are fetched from requests index at once while looking for events.
+const eventsScanChunk = 100
+
+func (h *Handler) handleGetObjectEvents(
+	ctx context.Context, parcel insolar.Parcel,
+) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectEvents)
+	objID := *msg.Object.Record()
+
+	from := msg.FromRequest
+	if from == nil && msg.ToPulse != 0 {
+		// The last possible request of the pulse, requests of newer pulses are skipped.
+		from = insolar.NewID(msg.ToPulse, bytes.Repeat([]byte{0xFF}, insolar.RecordHashSize))
+	}
+
+	rep := reply.ObjectEvents{}
+	for {
+		pairs, next, err := h.RequestIndexAccessor.ForObject(ctx, objID, from, eventsScanChunk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch requests for %s", objID.DebugString())
+		}
+
+		for _, p := range pairs {
+			pn := p.Request.Pulse()
+			if msg.ToPulse != 0 && pn > msg.ToPulse {
+				continue
+			}
+			if msg.FromPulse != 0 && pn < msg.FromPulse {
+				return &rep, nil
+			}
+			if len(rep.Events) >= msg.Amount {
+				reqID := p.Request
+				rep.NextFrom = &reqID
+				return &rep, nil
+			}
+			if p.Result == nil {
+				continue
+			}
+
+			events, method, err := h.resultEvents(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			if msg.Method != "" && method != msg.Method {
+				continue
+			}
+			for _, e := range events {
+				rep.Events = append(rep.Events, reply.ObjectEvent{
+					RequestID: p.Request,
+					ResultID:  *p.Result,
+					Method:    method,
+					Name:      e.Name,
+					Payload:   e.Payload,
+				})
+			}
+		}
+
+		if next == nil {
+			return &rep, nil
+		}
+		from = next
+	}
+}
+
+// resultEvents returns events saved with the result of the request and the called method.
+func (h *Handler) resultEvents(ctx context.Context, p object.RequestResult) ([]insolar.ContractEvent, string, error) {
+	rec, err := h.RecordAccessor.ForID(ctx, *p.Result)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to fetch result %s", p.Result.DebugString())
+	}
+	res, ok := record.Unwrap(rec.Virtual).(*record.Result)
+	if !ok {
+		return nil, "", errors.Errorf("unexpected result record %s", p.Result.DebugString())
+	}
+	if len(res.Events) == 0 {
+		return nil, "", nil
+	}
+	var events []insolar.ContractEvent
+	err = insolar.Deserialize(res.Events, &events)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to decode events of result %s", p.Result.DebugString())
+	}
+
+	rec, err = h.RecordAccessor.ForID(ctx, p.Request)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to fetch request %s", p.Request.DebugString())
+	}
+	req, ok := record.Unwrap(rec.Virtual).(*record.Request)
+	if !ok {
+		return nil, "", errors.Errorf("unexpected request record %s", p.Request.DebugString())
+	}
+	return events, req.Method, nil
+}
+
+func (h *Handler) rawRecord(ctx context.Context, id insolar.ID) ([]byte, error) {
+	rec, err := h.RecordAccessor.ForID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return rec.Virtual.Marshal()
+}
+
 func (h *Handler) handleGetRequest(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg := parcel.Message().(*message.GetRequest)
 
@@ -353,14 +571,13 @@ func (h *Handler) getCode(ctx context.Context, id *insolar.ID) (record.Code, err
 func (h *Handler) handleHeavyPayload(ctx context.Context, genericMsg insolar.Parcel) (insolar.Reply, error) {
 	msg := genericMsg.Message().(*message.HeavyPayload)
 
-	storeRecords(ctx, h.RecordModifier, h.PCS, msg.PulseNum, msg.Records)
-	if err := storeIndexBuckets(ctx, h.IndexBucketModifier, msg.IndexBuckets, msg.PulseNum); err != nil {
-		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
-	}
-	if err := storeDrop(ctx, h.DropModifier, msg.Drop); err != nil {
+	err := h.DB.Update(func(tx store.DB) error {
+		return storePayload(ctx, tx, h.PCS, msg)
+	})
+	if err != nil {
+		inslogger.FromContext(ctx).Error(err)
 		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
 	}
-	storeBlobs(ctx, h.BlobModifier, h.PCS, msg.PulseNum, msg.Blobs)
 
 	stats.Record(ctx,
 		statReceivedHeavyPayloadCount.M(1),
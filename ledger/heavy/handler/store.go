@@ -20,31 +20,99 @@ import (
 	"context"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/pkg/errors"
 )
 
+// storePayload saves all the data from a replicated payload to a provided db.
+func storePayload(
+	ctx context.Context,
+	db store.DB,
+	pcs insolar.PlatformCryptographyScheme,
+	msg *message.HeavyPayload,
+) error {
+	records, err := storeRecords(ctx, object.NewRecordDB(db), object.NewRequestIndexDB(db), pcs, msg.PulseNum, msg.Records)
+	if err != nil {
+		return err
+	}
+	indexes, err := storeIndexBuckets(ctx, object.NewIndexDB(db), msg.IndexBuckets, msg.PulseNum)
+	if err != nil {
+		return err
+	}
+	dropStored, err := storeDrop(ctx, drop.NewDB(db), msg.Drop)
+	if err != nil {
+		return err
+	}
+	blobs, err := storeBlobs(ctx, blob.NewDB(db), pcs, msg.PulseNum, msg.Blobs)
+	if err != nil {
+		return err
+	}
+	if err := storeTypeIndex(ctx, object.NewTypeIndexDB(db), msg.TypeIndex); err != nil {
+		return err
+	}
+	if !dropStored {
+		// payload is re-sent, its jet is already in the catalog
+		return nil
+	}
+
+	err = exporter.NewCatalogDB(db).Add(ctx, msg.PulseNum, exporter.Entry{
+		Jets:    []insolar.JetID{msg.JetID},
+		Records: records,
+		Blobs:   blobs,
+		Indexes: indexes,
+	})
+	if err != nil {
+		return errors.Wrap(err, "heavyserver: catalog storing failed")
+	}
+
+	return nil
+}
+
 func storeIndexBuckets(
 	ctx context.Context,
 	indexes object.IndexBucketModifier,
 	rawBuckets [][]byte,
 	pn insolar.PulseNumber,
-) error {
+) ([]insolar.ID, error) {
+	var stored []insolar.ID
 	for _, rwb := range rawBuckets {
 		buck := object.IndexBucket{}
 		err := buck.Unmarshal(rwb)
 		if err != nil {
-			inslogger.FromContext(ctx).Error(err)
-			continue
+			return nil, errors.Wrap(err, "heavyserver: deserialize index bucket failed")
 		}
 
 		err = indexes.SetBucket(ctx, pn, buck)
 		if err != nil {
-			return errors.Wrapf(err, "heavyserver: index storing failed")
+			return nil, errors.Wrapf(err, "heavyserver: index storing failed")
+		}
+		stored = append(stored, buck.ObjID)
+	}
+
+	return stored, nil
+}
+
+func storeTypeIndex(
+	ctx context.Context,
+	types object.TypeIndexModifier,
+	rawEntries [][]byte,
+) error {
+	for _, raw := range rawEntries {
+		e, err := object.DecodeTypeIndexEntry(raw)
+		if err != nil {
+			return errors.Wrap(err, "heavyserver: deserialize type index entry failed")
+		}
+
+		err = types.Add(ctx, e)
+		if err != nil {
+			return errors.Wrap(err, "heavyserver: type index storing failed")
 		}
 	}
 
@@ -55,18 +123,22 @@ func storeDrop(
 	ctx context.Context,
 	drops drop.Modifier,
 	rawDrop []byte,
-) error {
+) (bool, error) {
 	d, err := drop.Decode(rawDrop)
 	if err != nil {
-		inslogger.FromContext(ctx).Error(err)
-		return err
+		return false, errors.Wrap(err, "heavyserver: deserialize drop failed")
 	}
 	err = drops.Set(ctx, *d)
+	if err == drop.ErrOverride {
+		// payload is re-sent, drop is already stored
+		inslogger.FromContext(ctx).Debugf("heavyserver: drop of jet %v is already stored", d.JetID.DebugString())
+		return false, nil
+	}
 	if err != nil {
-		return errors.Wrapf(err, "heavyserver: drop storing failed")
+		return false, errors.Wrapf(err, "heavyserver: drop storing failed")
 	}
 
-	return nil
+	return true, nil
 }
 
 func storeBlobs(
@@ -75,49 +147,67 @@ func storeBlobs(
 	pcs insolar.PlatformCryptographyScheme,
 	pn insolar.PulseNumber,
 	rawBlobs [][]byte,
-) {
-	inslog := inslogger.FromContext(ctx)
-
+) ([]insolar.ID, error) {
+	var stored []insolar.ID
 	for _, rwb := range rawBlobs {
 		b, err := blob.Decode(rwb)
 		if err != nil {
-			inslog.Error(err, "heavyserver: deserialize blob failed")
-			continue
+			return nil, errors.Wrap(err, "heavyserver: deserialize blob failed")
 		}
 
 		blobID := object.CalculateIDForBlob(pcs, pn, b.Value)
 		err = blobs.Set(ctx, *blobID, *b)
-		if err != nil {
-			inslog.Error(err, "heavyserver: blob storing failed")
+		if err == blob.ErrOverride {
+			// payload is re-sent, blob is already stored
+			inslogger.FromContext(ctx).Debugf("heavyserver: blob %v is already stored", blobID)
 			continue
 		}
+		if err != nil {
+			return nil, errors.Wrap(err, "heavyserver: blob storing failed")
+		}
+		stored = append(stored, *blobID)
 	}
+
+	return stored, nil
 }
 
 func storeRecords(
 	ctx context.Context,
 	records object.RecordModifier,
+	requests object.RequestIndexModifier,
 	pcs insolar.PlatformCryptographyScheme,
 	pn insolar.PulseNumber,
 	rawRecords [][]byte,
-) {
-	inslog := inslogger.FromContext(ctx)
-
+) ([]insolar.ID, error) {
+	var stored []insolar.ID
 	for _, rawRec := range rawRecords {
 		rec := record.Material{}
 		err := rec.Unmarshal(rawRec)
 		if err != nil {
-			inslog.Error(err, "heavyserver: deserialize record failed")
-			continue
+			return nil, errors.Wrap(err, "heavyserver: deserialize record failed")
+		}
+		if rec.Virtual == nil {
+			return nil, errors.New("heavyserver: record is empty")
 		}
 
 		virtRec := *rec.Virtual
 		hash := record.HashVirtual(pcs.ReferenceHasher(), virtRec)
 		id := insolar.NewID(pn, hash)
 		err = records.Set(ctx, *id, rec)
-		if err != nil {
-			inslog.Error(err, "heavyserver: store record failed")
+		if err == object.ErrOverride {
+			// payload is re-sent, record is already stored
+			inslogger.FromContext(ctx).Debugf("heavyserver: record %v is already stored", id)
 			continue
 		}
+		if err != nil {
+			return nil, errors.Wrap(err, "heavyserver: store record failed")
+		}
+		err = requests.IndexRecord(ctx, *id, rec)
+		if err != nil {
+			return nil, errors.Wrap(err, "heavyserver: request indexing failed")
+		}
+		stored = append(stored, *id)
 	}
+
+	return stored, nil
 }
@@ -0,0 +1,136 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestHandler_HeavyPayload_BrokenRecordStoresNothing(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+	db, err := store.NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	pcs := testutils.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+	jetID := *insolar.NewJetID(0, nil)
+
+	virtual := record.Wrap(record.Genesis{Hash: []byte("genesis")})
+	rec := record.Material{Virtual: &virtual, JetID: jetID}
+	rawRec, err := rec.Marshal()
+	require.NoError(t, err)
+	recID := insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), virtual))
+
+	b := blob.Blob{Value: []byte("blob value"), JetID: jetID}
+	blobID := object.CalculateIDForBlob(pcs, pn, b.Value)
+
+	msg := &message.HeavyPayload{
+		JetID:    jetID,
+		PulseNum: pn,
+		Drop:     drop.MustEncode(&drop.Drop{Pulse: pn, JetID: jetID}),
+		Blobs:    [][]byte{blob.MustEncode(&b)},
+		Records:  [][]byte{rawRec, []byte("not a record")},
+	}
+	parcel := testutils.NewParcelMock(mc)
+	parcel.MessageMock.Return(msg)
+
+	h := New()
+	h.DB = db
+	h.PCS = pcs
+	rep, err := h.handleHeavyPayload(ctx, parcel)
+	require.NoError(t, err)
+	require.IsType(t, &reply.HeavyError{}, rep)
+
+	_, err = object.NewRecordDB(db).ForID(ctx, *recID)
+	require.Equal(t, object.ErrNotFound, err)
+	_, err = blob.NewDB(db).ForID(ctx, *blobID)
+	require.Equal(t, blob.ErrNotFound, err)
+	_, err = drop.NewDB(db).ForPulse(ctx, jetID, pn)
+	require.Equal(t, store.ErrNotFound, err)
+}
+
+func TestHandler_HeavyPayload_ResentPayloadDoesntDuplicateCatalog(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+	db, err := store.NewBadgerDB(configuration.Storage{DataDirectory: tmpdir})
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	pcs := testutils.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+	jetID := *insolar.NewJetID(0, nil)
+
+	virtual := record.Wrap(record.Genesis{Hash: []byte("genesis")})
+	rec := record.Material{Virtual: &virtual, JetID: jetID}
+	rawRec, err := rec.Marshal()
+	require.NoError(t, err)
+	recID := insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), virtual))
+
+	msg := &message.HeavyPayload{
+		JetID:    jetID,
+		PulseNum: pn,
+		Drop:     drop.MustEncode(&drop.Drop{Pulse: pn, JetID: jetID}),
+		Records:  [][]byte{rawRec},
+	}
+	parcel := testutils.NewParcelMock(mc)
+	parcel.MessageMock.Return(msg)
+
+	h := New()
+	h.DB = db
+	h.PCS = pcs
+	for i := 0; i < 2; i++ {
+		rep, err := h.handleHeavyPayload(ctx, parcel)
+		require.NoError(t, err)
+		require.IsType(t, &reply.OK{}, rep)
+	}
+
+	entry, err := exporter.NewCatalogDB(db).ForPulse(ctx, pn)
+	require.NoError(t, err)
+	require.Equal(t, []insolar.JetID{jetID}, entry.Jets)
+	require.Equal(t, []insolar.ID{*recID}, entry.Records)
+}
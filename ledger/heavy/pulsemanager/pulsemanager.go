@@ -25,6 +25,7 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/ledger/heavy/retention"
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
 )
@@ -43,6 +44,8 @@ type PulseManager struct {
 	NodeSetter        node.Modifier             `inject:""`
 	Nodes             node.Accessor             `inject:""`
 	PulseAppender     pulse.Appender            `inject:""`
+	// Pruner is optional, it's set when retention is enabled.
+	Pruner retention.Pruner
 
 	currentPulse insolar.Pulse
 
@@ -90,6 +93,10 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse, persist
 		inslogger.FromContext(ctx).Error(errors.Wrap(err, "MessageBus OnPulse() returns error"))
 	}
 
+	if m.Pruner != nil {
+		go m.Pruner.NotifyAboutPulse(ctx, newPulse.PulseNumber)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,75 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package retention
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// HorizonAccessor provides info about data removed by retention.
+type HorizonAccessor interface {
+	// Horizon returns the latest pruned pulse. States, that were superseded by states of this pulse or earlier pulses,
+	// may be removed. Zero is returned if nothing was pruned.
+	Horizon(ctx context.Context) (insolar.PulseNumber, error)
+}
+
+// HorizonDB is a db-based storage of retention horizon.
+type HorizonDB struct {
+	db store.DB
+}
+
+type horizonKey struct{}
+
+func (horizonKey) Scope() store.Scope {
+	return store.ScopeRetention
+}
+
+func (horizonKey) ID() []byte {
+	return []byte("horizon")
+}
+
+// NewHorizonDB creates a new horizon storage, that holds data in a db.
+func NewHorizonDB(db store.DB) *HorizonDB {
+	return &HorizonDB{db: db}
+}
+
+// Horizon returns the latest pruned pulse. Zero is returned if nothing was pruned.
+func (h *HorizonDB) Horizon(ctx context.Context) (insolar.PulseNumber, error) {
+	buf, err := h.db.Get(horizonKey{})
+	if err == store.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return insolar.NewPulseNumber(buf), nil
+}
+
+// SetHorizon moves horizon to provided pulse. Horizon never moves backwards.
+func (h *HorizonDB) SetHorizon(ctx context.Context, pn insolar.PulseNumber) error {
+	current, err := h.Horizon(ctx)
+	if err != nil {
+		return err
+	}
+	if pn <= current {
+		return nil
+	}
+	return h.db.Set(horizonKey{}, pn.Bytes())
+}
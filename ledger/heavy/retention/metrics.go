@@ -0,0 +1,77 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package retention
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	statPrunedRecordsCount = stats.Int64(
+		"heavy/retention/records/count",
+		"How many superseded states were removed",
+		stats.UnitDimensionless,
+	)
+	statPrunedBlobsCount = stats.Int64(
+		"heavy/retention/blobs/count",
+		"How many blobs were removed",
+		stats.UnitDimensionless,
+	)
+	statReclaimedBytes = stats.Int64(
+		"heavy/retention/reclaimed/bytes",
+		"How many bytes of records and blobs were removed",
+		stats.UnitBytes,
+	)
+	statErrPruneCount = stats.Int64(
+		"heavy/retention/failed/count",
+		"How many pulses were failed to prune",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Name:        statPrunedRecordsCount.Name(),
+			Description: statPrunedRecordsCount.Description(),
+			Measure:     statPrunedRecordsCount,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statPrunedBlobsCount.Name(),
+			Description: statPrunedBlobsCount.Description(),
+			Measure:     statPrunedBlobsCount,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statReclaimedBytes.Name(),
+			Description: statReclaimedBytes.Description(),
+			Measure:     statReclaimedBytes,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statErrPruneCount.Name(),
+			Description: statErrPruneCount.Description(),
+			Measure:     statErrPruneCount,
+			Aggregation: view.Count(),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,320 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package retention
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/heavy/retention.Pruner -o ./ -s _mock.go
+
+// Pruner is an interface that represents a pruner-component.
+// It removes outdated data from a heavy node in background.
+type Pruner interface {
+	// NotifyAboutPulse notifies a component about a pulse
+	NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber)
+}
+
+// HeavyPruner is an implementation of Pruner interface. It removes object states, that were superseded more than
+// Depth pulses ago, with their memory blobs. Lifelines, drops and the latest states of objects are never removed,
+// so the chain stays verifiable.
+type HeavyPruner struct {
+	once          sync.Once
+	pulseForPrune chan insolar.PulseNumber
+
+	db              store.DB
+	pulseCalculator pulse.Calculator
+
+	depth int
+}
+
+// NewPruner creates a new instance of HeavyPruner.
+func NewPruner(cfg configuration.Retention, db store.DB, pulseCalculator pulse.Calculator) *HeavyPruner {
+	return &HeavyPruner{
+		db:              db,
+		pulseCalculator: pulseCalculator,
+		depth:           cfg.Depth,
+		pulseForPrune:   make(chan insolar.PulseNumber),
+	}
+}
+
+// NotifyAboutPulse prunes a heavy's data. When it's called, it tries to fetch pulse, which is backwards by depth.
+// If a pulse is fetched successfully, states superseded in it are removed.
+func (p *HeavyPruner) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
+	p.once.Do(func() {
+		go p.prune(ctx)
+	})
+	inslogger.FromContext(ctx).Debugf("[Pruner][NotifyAboutPulse] received pulse - %v", pn)
+	p.pulseForPrune <- pn
+}
+
+func (p *HeavyPruner) prune(ctx context.Context) {
+	logger := inslogger.FromContext(ctx)
+	for pn := range p.pulseForPrune {
+		expired, err := p.pulseCalculator.Backwards(ctx, pn, p.depth)
+		if err == pulse.ErrNotFound {
+			logger.Debugf("[Pruner][prune] expired pulse for pn - %v doesn't exist. depth - %v", pn, p.depth)
+			continue
+		}
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[Pruner][prune] failed to calculate expired pulse for pn - %v", pn))
+			stats.Record(ctx, statErrPruneCount.M(1))
+			continue
+		}
+
+		res, err := p.prunePulse(ctx, expired.PulseNumber, pn)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[Pruner][prune] failed to prune pulse - %v", expired.PulseNumber))
+			stats.Record(ctx, statErrPruneCount.M(1))
+			continue
+		}
+		stats.Record(ctx,
+			statPrunedRecordsCount.M(int64(res.records)),
+			statPrunedBlobsCount.M(int64(res.blobs)),
+			statReclaimedBytes.M(res.bytes),
+		)
+		logger.Debugf(
+			"[Pruner][prune] pulse - %v pruned. records - %v, blobs - %v, bytes - %v",
+			expired.PulseNumber, res.records, res.blobs, res.bytes,
+		)
+	}
+}
+
+type pruneResult struct {
+	records int
+	blobs   int
+	bytes   int64
+}
+
+// prunePulse removes states, that were superseded by states from expired pulse. Every superseded state has exactly one
+// successor, so each state is removed once, when its successor expires. States, that are still referenced by
+// lifelines at current pulse, are kept. A memory blob is removed with its state only if no other stored record
+// references it. Expired pulse becomes the retention horizon.
+func (p *HeavyPruner) prunePulse(ctx context.Context, expired, current insolar.PulseNumber) (pruneResult, error) {
+	var res pruneResult
+	err := p.db.Update(func(tx store.DB) error {
+		res = pruneResult{}
+		records := object.NewRecordDB(tx)
+		blobs := blob.NewDB(tx)
+		indexes := object.NewIndexDB(tx)
+		catalog := exporter.NewCatalogDB(tx)
+		refs := newBlobRefs(catalog, records)
+
+		err := NewHorizonDB(tx).SetHorizon(ctx, expired)
+		if err != nil {
+			return errors.Wrap(err, "failed to update horizon")
+		}
+
+		entry, err := catalog.ForPulse(ctx, expired)
+		if err == exporter.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch catalog")
+		}
+
+		protected := map[insolar.ID]struct{}{}
+		for _, objID := range entry.Indexes {
+			lifeline, err := indexes.ForID(ctx, current, objID)
+			if err != nil {
+				return errors.Wrapf(err, "failed to fetch lifeline for %v", objID.DebugString())
+			}
+			if lifeline.LatestState != nil {
+				protected[*lifeline.LatestState] = struct{}{}
+			}
+			if lifeline.LatestStateApproved != nil {
+				protected[*lifeline.LatestStateApproved] = struct{}{}
+			}
+		}
+
+		for _, id := range entry.Records {
+			rec, err := records.ForID(ctx, id)
+			if err == object.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to fetch record %v", id.DebugString())
+			}
+			prevState, memory := stateLinks(rec)
+			if prevState == nil {
+				continue
+			}
+			if _, ok := protected[*prevState]; ok {
+				continue
+			}
+
+			prev, err := records.ForID(ctx, *prevState)
+			if err == object.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to fetch record %v", prevState.DebugString())
+			}
+			_, prevMemory := stateLinks(prev)
+			unreferenced := false
+			if prevMemory != nil {
+				unreferenced, err = refs.release(ctx, *prevMemory)
+				if err != nil {
+					return errors.Wrapf(err, "failed to count references to blob %v", prevMemory.DebugString())
+				}
+				// Successor may reference memory of previous state, if memory is not changed.
+				unreferenced = unreferenced && (memory == nil || *memory != *prevMemory)
+			}
+
+			removed := []insolar.ID{*prevState}
+			err = records.Delete(ctx, *prevState)
+			if err != nil {
+				return errors.Wrapf(err, "failed to remove record %v", prevState.DebugString())
+			}
+			res.records++
+			res.bytes += int64(prev.Size())
+
+			if unreferenced {
+				b, err := blobs.ForID(ctx, *prevMemory)
+				if err != nil && err != blob.ErrNotFound {
+					return errors.Wrapf(err, "failed to fetch blob %v", prevMemory.DebugString())
+				}
+				if err == nil {
+					err = blobs.Delete(ctx, *prevMemory)
+					if err != nil {
+						return errors.Wrapf(err, "failed to remove blob %v", prevMemory.DebugString())
+					}
+					removed = append(removed, *prevMemory)
+					res.blobs++
+					res.bytes += int64(len(b.Value))
+				}
+			}
+
+			err = catalog.Remove(ctx, prevState.Pulse(), removed...)
+			if err != nil {
+				return errors.Wrap(err, "failed to update catalog")
+			}
+		}
+		return nil
+	})
+	return res, err
+}
+
+// blobRefs counts references to blobs from stored records. Blob ids are calculated with pulse of records, that
+// reference them, so only records from catalog of blob's pulse are counted. Records of one pulse with equal memory
+// share a blob.
+type blobRefs struct {
+	catalog *exporter.CatalogDB
+	records *object.RecordDB
+
+	// counts holds number of references per blob for counted pulses. Nil map means pulse has no catalog, so
+	// references are unknown.
+	counts map[insolar.PulseNumber]map[insolar.ID]int
+}
+
+func newBlobRefs(catalog *exporter.CatalogDB, records *object.RecordDB) *blobRefs {
+	return &blobRefs{
+		catalog: catalog,
+		records: records,
+		counts:  map[insolar.PulseNumber]map[insolar.ID]int{},
+	}
+}
+
+// release drops a reference of a record, that is going to be removed, and returns true if nothing else references
+// the blob. It must be called before the record is removed.
+func (r *blobRefs) release(ctx context.Context, id insolar.ID) (bool, error) {
+	counts, ok := r.counts[id.Pulse()]
+	if !ok {
+		var err error
+		counts, err = r.count(ctx, id.Pulse())
+		if err != nil {
+			return false, err
+		}
+		r.counts[id.Pulse()] = counts
+	}
+	if counts == nil {
+		return false, nil
+	}
+
+	counts[id]--
+	return counts[id] <= 0, nil
+}
+
+func (r *blobRefs) count(ctx context.Context, pn insolar.PulseNumber) (map[insolar.ID]int, error) {
+	entry, err := r.catalog.ForPulse(ctx, pn)
+	if err == exporter.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch catalog")
+	}
+
+	counts := map[insolar.ID]int{}
+	for _, id := range entry.Records {
+		rec, err := r.records.ForID(ctx, id)
+		if err == object.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch record %v", id.DebugString())
+		}
+		if b := blobLink(rec); b != nil {
+			counts[*b]++
+		}
+	}
+	return counts, nil
+}
+
+// blobLink returns id of a blob, that a record references. Nil is returned for records without blobs.
+func blobLink(rec record.Material) *insolar.ID {
+	if code, ok := record.Unwrap(rec.Virtual).(*record.Code); ok {
+		if code.Code.IsEmpty() {
+			return nil
+		}
+		return &code.Code
+	}
+	_, memory := stateLinks(rec)
+	return memory
+}
+
+// stateLinks returns previous state and memory of a state record. Nils are returned for other records.
+func stateLinks(rec record.Material) (prevState *insolar.ID, memory *insolar.ID) {
+	switch r := record.Unwrap(rec.Virtual).(type) {
+	case *record.Activate:
+		memory = &r.Memory
+	case *record.Amend:
+		prevState, memory = &r.PrevState, &r.Memory
+	case *record.Deactivate:
+		prevState = &r.PrevState
+	}
+	if prevState != nil && prevState.IsEmpty() {
+		prevState = nil
+	}
+	if memory != nil && memory.IsEmpty() {
+		memory = nil
+	}
+	return prevState, memory
+}
@@ -0,0 +1,235 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type testStates struct {
+	db       *store.MockDB
+	objID    insolar.ID
+	states   []insolar.ID
+	memories []insolar.ID
+	pulses   []insolar.PulseNumber
+}
+
+// newTestStates saves an object with activate and two amend states. The last amend doesn't change memory.
+func newTestStates(t *testing.T) testStates {
+	ctx := inslogger.TestContext(t)
+	s := testStates{
+		db:    store.NewMemoryMockDB(),
+		objID: gen.ID(),
+		pulses: []insolar.PulseNumber{
+			insolar.FirstPulseNumber + 10,
+			insolar.FirstPulseNumber + 20,
+			insolar.FirstPulseNumber + 30,
+		},
+	}
+	records := object.NewRecordDB(s.db)
+	blobs := blob.NewDB(s.db)
+	indexes := object.NewIndexDB(s.db)
+	catalog := exporter.NewCatalogDB(s.db)
+	jetID := gen.JetID()
+
+	for i, pn := range s.pulses {
+		stateID := *insolar.NewID(pn, []byte{1})
+		memory := *insolar.NewID(pn, []byte{2})
+		entry := exporter.Entry{Records: []insolar.ID{stateID}, Indexes: []insolar.ID{s.objID}}
+
+		var virtual record.Virtual
+		switch i {
+		case 0:
+			virtual = record.Wrap(record.Activate{Memory: memory})
+		case 1:
+			virtual = record.Wrap(record.Amend{Memory: memory, PrevState: s.states[0]})
+		default:
+			memory = s.memories[1]
+			virtual = record.Wrap(record.Amend{Memory: memory, PrevState: s.states[1]})
+		}
+		if i < 2 {
+			require.NoError(t, blobs.Set(ctx, memory, blob.Blob{Value: []byte{1, 2, 3}, JetID: jetID}))
+			entry.Blobs = []insolar.ID{memory}
+		}
+		require.NoError(t, records.Set(ctx, stateID, record.Material{Virtual: &virtual, JetID: jetID}))
+		require.NoError(t, indexes.Set(ctx, pn, s.objID, object.Lifeline{LatestState: &stateID, JetID: jetID}))
+		require.NoError(t, catalog.Add(ctx, pn, entry))
+
+		s.states = append(s.states, stateID)
+		s.memories = append(s.memories, memory)
+	}
+	return s
+}
+
+func TestPruner_prunePulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	s := newTestStates(t)
+	pruner := NewPruner(configuration.Retention{}, s.db, nil)
+	records := object.NewRecordDB(s.db)
+	blobs := blob.NewDB(s.db)
+	catalog := exporter.NewCatalogDB(s.db)
+
+	res, err := pruner.prunePulse(ctx, s.pulses[1], s.pulses[2])
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.records)
+	assert.Equal(t, 1, res.blobs)
+	assert.True(t, res.bytes > 3)
+
+	_, err = records.ForID(ctx, s.states[0])
+	assert.Equal(t, object.ErrNotFound, err)
+	_, err = blobs.ForID(ctx, s.memories[0])
+	assert.Equal(t, blob.ErrNotFound, err)
+	entry, err := catalog.ForPulse(ctx, s.pulses[0])
+	require.NoError(t, err)
+	assert.Empty(t, entry.Records)
+	assert.Empty(t, entry.Blobs)
+
+	// Memory is shared with the latest state, so it's kept.
+	res, err = pruner.prunePulse(ctx, s.pulses[2], s.pulses[2])
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.records)
+	assert.Equal(t, 0, res.blobs)
+	_, err = records.ForID(ctx, s.states[1])
+	assert.Equal(t, object.ErrNotFound, err)
+	_, err = blobs.ForID(ctx, s.memories[1])
+	assert.NoError(t, err)
+
+	// The latest state is never removed.
+	_, err = records.ForID(ctx, s.states[2])
+	assert.NoError(t, err)
+	lifeline, err := object.NewIndexDB(s.db).ForID(ctx, s.pulses[2], s.objID)
+	require.NoError(t, err)
+	assert.Equal(t, &s.states[2], lifeline.LatestState)
+}
+
+func TestPruner_prunePulse_KeepsApprovedState(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	s := newTestStates(t)
+	indexes := object.NewIndexDB(s.db)
+	lifeline := object.Lifeline{LatestState: &s.states[2], LatestStateApproved: &s.states[1]}
+	require.NoError(t, indexes.Set(ctx, s.pulses[2], s.objID, lifeline))
+
+	pruner := NewPruner(configuration.Retention{}, s.db, nil)
+	res, err := pruner.prunePulse(ctx, s.pulses[2], s.pulses[2])
+	require.NoError(t, err)
+	assert.Equal(t, 0, res.records)
+
+	_, err = object.NewRecordDB(s.db).ForID(ctx, s.states[1])
+	assert.NoError(t, err)
+}
+
+func TestHeavyPruner_NotifyAboutPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	s := newTestStates(t)
+	depth := 2
+	current := s.pulses[2] + 10
+
+	ctrl := minimock.NewController(t)
+	pc := pulse.NewCalculatorMock(ctrl)
+	pc.BackwardsMock.Expect(ctx, current, depth).Return(insolar.Pulse{PulseNumber: s.pulses[1]}, nil)
+
+	pruner := NewPruner(configuration.Retention{Depth: depth}, s.db, pc)
+	defer close(pruner.pulseForPrune)
+
+	pruner.NotifyAboutPulse(ctx, current)
+	ctrl.Wait(time.Minute)
+
+	records := object.NewRecordDB(s.db)
+	deadline := time.Now().Add(time.Minute)
+	for {
+		_, err := records.ForID(ctx, s.states[0])
+		if err == object.ErrNotFound {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "superseded state is not pruned")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPruner_prunePulse_KeepsSharedBlob(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	s := newTestStates(t)
+
+	// Another object was activated with the same memory in the same pulse, so it shares the blob.
+	otherState := *insolar.NewID(s.pulses[0], []byte{3})
+	virtual := record.Wrap(record.Activate{Memory: s.memories[0]})
+	require.NoError(t, object.NewRecordDB(s.db).Set(ctx, otherState, record.Material{Virtual: &virtual}))
+	catalog := exporter.NewCatalogDB(s.db)
+	require.NoError(t, catalog.Add(ctx, s.pulses[0], exporter.Entry{Records: []insolar.ID{otherState}}))
+
+	pruner := NewPruner(configuration.Retention{}, s.db, nil)
+	res, err := pruner.prunePulse(ctx, s.pulses[1], s.pulses[2])
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.records)
+	assert.Equal(t, 0, res.blobs)
+
+	_, err = object.NewRecordDB(s.db).ForID(ctx, s.states[0])
+	assert.Equal(t, object.ErrNotFound, err)
+	_, err = blob.NewDB(s.db).ForID(ctx, s.memories[0])
+	assert.NoError(t, err)
+	entry, err := catalog.ForPulse(ctx, s.pulses[0])
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.ID{s.memories[0]}, entry.Blobs)
+}
+
+func TestPruner_prunePulse_MovesHorizon(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	s := newTestStates(t)
+	horizon := NewHorizonDB(s.db)
+	pruner := NewPruner(configuration.Retention{}, s.db, nil)
+
+	pn, err := horizon.Horizon(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, insolar.PulseNumber(0), pn)
+
+	_, err = pruner.prunePulse(ctx, s.pulses[1], s.pulses[2])
+	require.NoError(t, err)
+	pn, err = horizon.Horizon(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, s.pulses[1], pn)
+
+	// Horizon never moves backwards.
+	_, err = pruner.prunePulse(ctx, s.pulses[0], s.pulses[2])
+	require.NoError(t, err)
+	pn, err = horizon.Horizon(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, s.pulses[1], pn)
+}
@@ -73,10 +73,12 @@ type MessageHandler struct {
 	LifelineIndex         object.LifelineIndex
 	IndexBucketModifier   object.IndexBucketModifier
 	LifelineStateModifier object.LifelineStateModifier
+	RequestIndex          object.RequestIndexAccessor
 
 	conf           *configuration.Ledger
 	middleware     *middleware
 	jetTreeUpdater jet.Fetcher
+	hotDataParts   *hot.PartsCounter
 
 	FlowDispatcher *dispatcher.Dispatcher
 	handlers       map[insolar.MessageType]insolar.MessageHandler
@@ -93,6 +95,7 @@ func NewMessageHandler(
 	h := &MessageHandler{
 		handlers:              map[insolar.MessageType]insolar.MessageHandler{},
 		conf:                  conf,
+		hotDataParts:          hot.NewPartsCounter(),
 		LifelineIndex:         index,
 		IndexBucketModifier:   indexBucketModifier,
 		LifelineStateModifier: indexStateModifier,
@@ -134,6 +137,20 @@ func NewMessageHandler(
 			p.Dep.Bus = h.Bus
 			p.Dep.RecordAccessor = h.RecordAccessor
 		},
+		SendObjectHistory: func(p *proc.SendObjectHistory) {
+			p.Dep.Jets = h.JetStorage
+			p.Dep.Blobs = h.BlobAccessor
+			p.Dep.Coordinator = h.JetCoordinator
+			p.Dep.JetUpdater = h.jetTreeUpdater
+			p.Dep.Bus = h.Bus
+			p.Dep.RecordAccessor = h.RecordAccessor
+		},
+		SendObjectRequests: func(p *proc.SendObjectRequests) {
+			p.Dep.Coordinator = h.JetCoordinator
+			p.Dep.RequestIndex = h.RequestIndex
+			p.Dep.RecordAccessor = h.RecordAccessor
+			p.Dep.Bus = h.Bus
+		},
 		GetCode: func(p *proc.GetCode) {
 			p.Dep.Bus = h.Bus
 			p.Dep.RecordAccessor = h.RecordAccessor
@@ -186,6 +203,7 @@ func NewMessageHandler(
 			p.Dep.JetStorage = h.JetStorage
 			p.Dep.JetFetcher = h.jetTreeUpdater
 			p.Dep.JetReleaser = h.JetReleaser
+			p.Dep.PartsCounter = h.hotDataParts
 		},
 	}
 
@@ -258,6 +276,8 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 	h.Bus.MustRegister(insolar.TypeGetDelegate, h.FlowDispatcher.WrapBusHandle)
 
 	h.Bus.MustRegister(insolar.TypeGetChildren, h.FlowDispatcher.WrapBusHandle)
+	h.Bus.MustRegister(insolar.TypeGetObjectHistory, h.FlowDispatcher.WrapBusHandle)
+	h.Bus.MustRegister(insolar.TypeGetObjectRequests, h.FlowDispatcher.WrapBusHandle)
 
 	h.Bus.MustRegister(insolar.TypeSetRecord, h.FlowDispatcher.WrapBusHandle)
 	h.Bus.MustRegister(insolar.TypeRegisterChild, h.FlowDispatcher.WrapBusHandle)
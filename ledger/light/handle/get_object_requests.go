@@ -0,0 +1,59 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handle
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/proc"
+)
+
+type GetObjectRequests struct {
+	dep     *proc.Dependencies
+	message bus.Message
+}
+
+func NewGetObjectRequests(dep *proc.Dependencies, msg bus.Message) *GetObjectRequests {
+	return &GetObjectRequests{
+		dep:     dep,
+		message: msg,
+	}
+}
+
+func (s *GetObjectRequests) Present(ctx context.Context, f flow.Flow) error {
+	msg := s.message.Parcel.Message().(*message.GetObjectRequests)
+	ctx, _ = inslogger.WithField(ctx, "object", msg.Object.Record().DebugString())
+
+	jet := proc.NewFetchJet(*msg.Object.Record(), flow.Pulse(ctx), s.message.ReplyTo)
+	s.dep.FetchJet(jet)
+	if err := f.Procedure(ctx, jet, false); err != nil {
+		return err
+	}
+	hot := proc.NewWaitHot(jet.Result.Jet, flow.Pulse(ctx), s.message.ReplyTo)
+	s.dep.WaitHot(hot)
+	if err := f.Procedure(ctx, hot, false); err != nil {
+		return err
+	}
+
+	send := proc.NewSendObjectRequests(s.message)
+	s.dep.SendObjectRequests(send)
+	return f.Procedure(ctx, send, false)
+}
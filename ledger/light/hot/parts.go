@@ -0,0 +1,64 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package hot
+
+import (
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// PartsCounter counts hot data messages received for jets. Merged jet receives hot data from both siblings, so it
+// should be released only when the last part arrives.
+type PartsCounter struct {
+	lock     sync.Mutex
+	pulse    insolar.PulseNumber
+	received map[insolar.ID]int
+}
+
+// NewPartsCounter creates new counter instance.
+func NewPartsCounter() *PartsCounter {
+	return &PartsCounter{
+		received: map[insolar.ID]int{},
+	}
+}
+
+// Receive registers a hot data part for a jet and returns true if all parts for the jet are received. Counts of
+// previous pulses are dropped, when parts for a newer pulse arrive.
+func (c *PartsCounter) Receive(jetID insolar.ID, pulse insolar.PulseNumber, parts int) bool {
+	if parts <= 1 {
+		return true
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if pulse > c.pulse {
+		c.pulse = pulse
+		c.received = map[insolar.ID]int{}
+	}
+	if pulse < c.pulse {
+		return false
+	}
+
+	c.received[jetID]++
+	if c.received[jetID] < parts {
+		return false
+	}
+	delete(c.received, jetID)
+	return true
+}
@@ -45,6 +45,7 @@ type HotData struct {
 		JetStorage            jet.Storage
 		JetFetcher            jet.Fetcher
 		JetReleaser           hot.JetReleaser
+		PartsCounter          *hot.PartsCounter
 	}
 }
 
@@ -136,6 +137,13 @@ func (p *HotData) process(ctx context.Context) error {
 		logger.Debugf("[handleHotRecords] lifeline with id - %v saved", meta.ObjID.DebugString())
 	}
 
+	// Merged jet is released only when hot data from both siblings is received.
+	if !p.Dep.PartsCounter.Receive(insolar.ID(jetID), p.msg.PulseNumber, p.msg.Parts) {
+		logger.Debug("waiting for the rest of hot data parts")
+		p.replyTo <- bus.Reply{Reply: &reply.OK{}}
+		return nil
+	}
+
 	p.Dep.JetStorage.Update(
 		ctx, p.msg.PulseNumber, true, jetID,
 	)
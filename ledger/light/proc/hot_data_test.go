@@ -0,0 +1,117 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/hot"
+	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/testutils"
+)
+
+type releaseCounter struct {
+	released int
+}
+
+func (f *releaseCounter) Fetch(ctx context.Context, target insolar.ID, pulse insolar.PulseNumber) (*insolar.ID, error) {
+	panic("not implemented")
+}
+
+func (f *releaseCounter) Release(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) {
+	f.released++
+}
+
+func TestHotData_Proceed_MergedSiblingsOutOfOrder(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	ctx := inslogger.TestContext(t)
+
+	pn := gen.PulseNumber()
+	parent := insolar.ZeroJetID
+	left := *insolar.NewJetID(1, nil)
+	right := jet.Sibling(left)
+
+	drops := drop.NewStorageMemory()
+	jets := jet.NewStore()
+	fetcher := &releaseCounter{}
+	counter := hot.NewPartsCounter()
+	pending := recentstorage.NewPendingStorageMock(mc)
+	provider := recentstorage.NewProviderMock(mc)
+	provider.GetPendingStorageMock.Return(pending)
+	unlocked := 0
+	releaser := testutils.NewJetReleaserMock(mc)
+	releaser.UnlockFunc = func(ctx context.Context, jetID insolar.ID) error {
+		assert.Equal(t, insolar.ID(parent), jetID)
+		unlocked++
+		return nil
+	}
+
+	proceed := func(sibling insolar.JetID) {
+		replyTo := make(chan bus.Reply, 1)
+		p := proc.NewHotData(&message.HotData{
+			Jet:         *insolar.NewReference(insolar.DomainID, insolar.ID(parent)),
+			Drop:        drop.Drop{Pulse: pn, JetID: sibling},
+			PulseNumber: pn,
+			Parts:       2,
+		}, replyTo)
+		p.Dep.DropModifier = drops
+		p.Dep.RecentStorageProvider = provider
+		p.Dep.IndexBucketModifier = object.NewIndexBucketModifierMock(mc)
+		p.Dep.JetStorage = jets
+		p.Dep.JetFetcher = fetcher
+		p.Dep.JetReleaser = releaser
+		p.Dep.PartsCounter = counter
+
+		require.NoError(t, p.Proceed(ctx))
+		assert.Equal(t, bus.Reply{Reply: &reply.OK{}}, <-replyTo)
+	}
+
+	// Right sibling comes first.
+	proceed(right)
+	_, actual := jets.ForID(ctx, pn, gen.ID())
+	assert.False(t, actual, "parent is actual before left sibling hot data")
+	assert.Equal(t, 0, fetcher.released)
+	assert.Equal(t, 0, unlocked)
+
+	proceed(left)
+	jetID, actual := jets.ForID(ctx, pn, gen.ID())
+	assert.True(t, actual)
+	assert.Equal(t, parent, jetID)
+	assert.Equal(t, 1, fetcher.released)
+	assert.Equal(t, 1, unlocked)
+
+	for _, sibling := range []insolar.JetID{left, right} {
+		_, err := drops.ForPulse(ctx, sibling, pn)
+		assert.NoError(t, err)
+	}
+}
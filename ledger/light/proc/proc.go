@@ -28,6 +28,8 @@ type Dependencies struct {
 	WaitHot             func(*WaitHot)
 	GetIndex            func(*GetIndex)
 	SendObject          func(*SendObject)
+	SendObjectHistory   func(*SendObjectHistory)
+	SendObjectRequests  func(*SendObjectRequests)
 	GetCode             func(*GetCode)
 	GetRequest          func(*GetRequest)
 	UpdateObject        func(*UpdateObject)
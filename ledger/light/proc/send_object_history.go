@@ -0,0 +1,226 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/delegationtoken"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/messagebus"
+	"github.com/pkg/errors"
+)
+
+type SendObjectHistory struct {
+	message bus.Message
+	index   object.Lifeline
+
+	Dep struct {
+		Coordinator    jet.Coordinator
+		Jets           jet.Storage
+		JetUpdater     jet.Fetcher
+		RecordAccessor object.RecordAccessor
+		Blobs          blob.Accessor
+		Bus            insolar.MessageBus
+	}
+}
+
+func NewSendObjectHistory(msg bus.Message, idx object.Lifeline) *SendObjectHistory {
+	return &SendObjectHistory{
+		message: msg,
+		index:   idx,
+	}
+}
+
+func (p *SendObjectHistory) Proceed(ctx context.Context) error {
+	r := bus.Reply{}
+	r.Reply, r.Err = p.handle(ctx, p.message.Parcel)
+	p.message.ReplyTo <- r
+	return r.Err
+}
+
+func (p *SendObjectHistory) handle(
+	ctx context.Context, parcel insolar.Parcel,
+) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectHistory)
+	logger := inslogger.FromContext(ctx)
+
+	var stateID *insolar.ID
+	if msg.FromState != nil {
+		stateID = msg.FromState
+	} else {
+		stateID = p.index.LatestState
+	}
+	if stateID == nil {
+		return &reply.Error{ErrType: reply.ErrStateNotAvailable}, nil
+	}
+
+	rep := &reply.ObjectHistory{}
+	for stateID != nil && len(rep.States) < msg.Amount {
+		onHeavy, err := p.Dep.Coordinator.IsBeyondLimit(ctx, parcel.Pulse(), stateID.Pulse())
+		if err != nil && err != pulse.ErrNotFound {
+			return nil, err
+		}
+
+		var node *insolar.Reference
+		if onHeavy {
+			node, err = p.Dep.Coordinator.Heavy(ctx, parcel.Pulse())
+		} else {
+			var (
+				state *reply.ObjectState
+				prev  *insolar.ID
+			)
+			state, prev, err = p.localState(ctx, *stateID)
+			switch err {
+			case nil:
+				rep.States = append(rep.States, *state)
+				stateID = prev
+				continue
+			case object.ErrNotFound:
+				// The record wasn't found on the current node. The rest of the chain is on the node that contains it.
+				node, err = p.nodeForState(ctx, msg.Head, *stateID, parcel.Pulse())
+			case blob.ErrNotFound:
+				node, err = p.Dep.Coordinator.Heavy(ctx, parcel.Pulse())
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		logger.WithFields(map[string]interface{}{
+			"state":    stateID.DebugString(),
+			"going_to": node.String(),
+		}).Debug("fetching object history")
+
+		rest, err := p.fetchHistory(ctx, msg.Head, *node, stateID, msg.Amount-len(rep.States))
+		if err != nil {
+			if err == insolar.ErrStateNotAvailable {
+				return &reply.Error{ErrType: reply.ErrStateNotAvailable}, nil
+			}
+			return nil, err
+		}
+		rep.States = append(rep.States, rest.States...)
+		rep.NextFrom = rest.NextFrom
+		return rep, nil
+	}
+	rep.NextFrom = stateID
+
+	return rep, nil
+}
+
+// localState returns state stored on the current node and id of the previous one.
+func (p *SendObjectHistory) localState(
+	ctx context.Context, stateID insolar.ID,
+) (*reply.ObjectState, *insolar.ID, error) {
+	rec, err := p.Dep.RecordAccessor.ForID(ctx, stateID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	virtRec := rec.Virtual
+	state, ok := record.Unwrap(virtRec).(record.State)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid object record %#v", virtRec)
+	}
+	data, err := virtRec.Marshal()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "can't serialize record")
+	}
+
+	objState := reply.ObjectState{
+		ID:     stateID,
+		Record: data,
+	}
+	if state.GetMemory() != nil && state.GetMemory().NotEmpty() {
+		b, err := p.Dep.Blobs.ForID(ctx, *state.GetMemory())
+		if err != nil {
+			return nil, nil, err
+		}
+		objState.Memory = b.Value
+	}
+
+	return &objState, state.PrevStateID(), nil
+}
+
+func (p *SendObjectHistory) nodeForState(
+	ctx context.Context, head insolar.Reference, stateID insolar.ID, pn insolar.PulseNumber,
+) (*insolar.Reference, error) {
+	stateJetID, actual := p.Dep.Jets.ForID(ctx, stateID.Pulse(), *head.Record())
+	stateJet := (*insolar.ID)(&stateJetID)
+
+	if !actual {
+		actualJet, err := p.Dep.JetUpdater.Fetch(ctx, *head.Record(), stateID.Pulse())
+		if err != nil {
+			return nil, err
+		}
+		stateJet = actualJet
+	}
+
+	node, err := p.Dep.Coordinator.NodeForJet(ctx, *stateJet, pn, stateID.Pulse())
+	if err != nil {
+		return nil, err
+	}
+	// We already know the record is not here, so heavy is the only option left.
+	if *node == p.Dep.Coordinator.Me() {
+		return p.Dep.Coordinator.Heavy(ctx, pn)
+	}
+	return node, nil
+}
+
+func (p *SendObjectHistory) fetchHistory(
+	ctx context.Context, obj insolar.Reference, node insolar.Reference, stateID *insolar.ID, amount int,
+) (*reply.ObjectHistory, error) {
+	sender := messagebus.BuildSender(
+		p.Dep.Bus.Send,
+		messagebus.FollowRedirectSender(p.Dep.Bus),
+		messagebus.RetryJetSender(p.Dep.Jets),
+	)
+	genericReply, err := sender(
+		ctx,
+		&message.GetObjectHistory{
+			Head:      obj,
+			FromState: stateID,
+			Amount:    amount,
+		},
+		&insolar.MessageSendOptions{
+			Receiver: &node,
+			Token:    &delegationtoken.GetObjectRedirectToken{},
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch object history")
+	}
+	if rep, ok := genericReply.(*reply.Error); ok {
+		return nil, rep.Error()
+	}
+
+	rep, ok := genericReply.(*reply.ObjectHistory)
+	if !ok {
+		return nil, fmt.Errorf("failed to fetch object history: unexpected reply type %T (reply=%+v)", genericReply, genericReply)
+	}
+	return rep, nil
+}
@@ -0,0 +1,102 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendObjectHistory_Proceed(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	a := require.New(t)
+	ctx := inslogger.TestContext(t)
+
+	activateID, amendID, latestID := gen.ID(), gen.ID(), gen.ID()
+	memoryID := gen.ID()
+	memory := []byte{1, 2, 3}
+	states := map[insolar.ID]record.Material{
+		activateID: {Virtual: &record.Virtual{Union: &record.Virtual_Activate{
+			Activate: &record.Activate{Memory: memoryID},
+		}}},
+		amendID: {Virtual: &record.Virtual{Union: &record.Virtual_Amend{
+			Amend: &record.Amend{Memory: memoryID, PrevState: activateID},
+		}}},
+		latestID: {Virtual: &record.Virtual{Union: &record.Virtual_Amend{
+			Amend: &record.Amend{Memory: memoryID, PrevState: amendID},
+		}}},
+	}
+
+	replyTo := make(chan bus.Reply, 1)
+	msg := bus.Message{
+		ReplyTo: replyTo,
+		Parcel: &message.Parcel{
+			Msg: &message.GetObjectHistory{
+				Head:   gen.Reference(),
+				Amount: 2,
+			},
+			PulseNumber: gen.PulseNumber(),
+		},
+	}
+	send := proc.NewSendObjectHistory(msg, object.Lifeline{LatestState: &latestID})
+
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.IsBeyondLimitMock.Return(false, nil)
+	records := object.NewRecordAccessorMock(mc)
+	records.ForIDFunc = func(_ context.Context, id insolar.ID) (record.Material, error) {
+		rec, ok := states[id]
+		a.True(ok)
+		return rec, nil
+	}
+	blobs := blob.NewAccessorMock(mc)
+	blobs.ForIDMock.Expect(ctx, memoryID).Return(blob.Blob{Value: memory}, nil)
+	send.Dep.Coordinator = coordinator
+	send.Dep.RecordAccessor = records
+	send.Dep.Blobs = blobs
+
+	err := send.Proceed(ctx)
+	a.NoError(err)
+
+	rep := <-replyTo
+	a.NoError(rep.Err)
+	history, ok := rep.Reply.(*reply.ObjectHistory)
+	a.True(ok)
+	a.Len(history.States, 2)
+	a.Equal(latestID, history.States[0].ID)
+	a.Equal(amendID, history.States[1].ID)
+	a.Equal(memory, history.States[1].Memory)
+	a.Equal(&activateID, history.NextFrom)
+
+	virtual := record.Virtual{}
+	a.NoError(virtual.Unmarshal(history.States[1].Record))
+	a.Equal(activateID, *record.Unwrap(&virtual).(record.State).PrevStateID())
+}
@@ -0,0 +1,167 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/pkg/errors"
+)
+
+// SendObjectRequests replies with object requests merged from the ones stored on the current node and the ones
+// replicated to heavy.
+type SendObjectRequests struct {
+	message bus.Message
+
+	Dep struct {
+		Coordinator    jet.Coordinator
+		RequestIndex   object.RequestIndexAccessor
+		RecordAccessor object.RecordAccessor
+		Bus            insolar.MessageBus
+	}
+}
+
+func NewSendObjectRequests(msg bus.Message) *SendObjectRequests {
+	return &SendObjectRequests{
+		message: msg,
+	}
+}
+
+func (p *SendObjectRequests) Proceed(ctx context.Context) error {
+	r := bus.Reply{}
+	r.Reply, r.Err = p.handle(ctx, p.message.Parcel)
+	p.message.ReplyTo <- r
+	return r.Err
+}
+
+func (p *SendObjectRequests) handle(
+	ctx context.Context, parcel insolar.Parcel,
+) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectRequests)
+	objID := *msg.Object.Record()
+
+	pairs, localNext, err := p.Dep.RequestIndex.ForObject(ctx, objID, msg.FromRequest, msg.Amount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch requests for %s", objID.DebugString())
+	}
+	heavy, err := p.fetchFromHeavy(ctx, msg, parcel.Pulse())
+	if err != nil {
+		return nil, err
+	}
+
+	merged := map[insolar.ID]reply.RequestResult{}
+	for _, rr := range heavy.Requests {
+		merged[rr.RequestID] = rr
+	}
+	for _, pair := range pairs {
+		rr := merged[pair.Request]
+		rr.RequestID = pair.Request
+		if rr.Request == nil {
+			rr.Request, err = p.rawRecord(ctx, pair.Request)
+			if err == object.ErrNotFound {
+				// Request is already replicated and cleaned. It will be returned with the chunk from heavy.
+				continue
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch request %s", pair.Request.DebugString())
+			}
+		}
+		if rr.ResultID == nil && pair.Result != nil {
+			rr.ResultID = pair.Result
+			rr.Result, err = p.rawRecord(ctx, *pair.Result)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch result %s", pair.Result.DebugString())
+			}
+		}
+		merged[pair.Request] = rr
+	}
+
+	// Both sources returned every request down to their next ones. Requests below the greater of them may be missing
+	// in the other source, so they are left for the next chunk.
+	bound := greaterID(localNext, heavy.NextFrom)
+
+	rep := &reply.ObjectRequests{}
+	for _, rr := range merged {
+		if bound != nil && rr.RequestID.Compare(*bound) <= 0 {
+			continue
+		}
+		rep.Requests = append(rep.Requests, rr)
+	}
+	sort.Slice(rep.Requests, func(i, j int) bool {
+		return rep.Requests[i].RequestID.Compare(rep.Requests[j].RequestID) > 0
+	})
+	if len(rep.Requests) > msg.Amount {
+		next := rep.Requests[msg.Amount].RequestID
+		rep.Requests = rep.Requests[:msg.Amount]
+		rep.NextFrom = &next
+		return rep, nil
+	}
+	rep.NextFrom = bound
+
+	return rep, nil
+}
+
+func (p *SendObjectRequests) fetchFromHeavy(
+	ctx context.Context, msg *message.GetObjectRequests, pn insolar.PulseNumber,
+) (*reply.ObjectRequests, error) {
+	heavy, err := p.Dep.Coordinator.Heavy(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate heavy")
+	}
+	genericReply, err := p.Dep.Bus.Send(ctx, msg, &insolar.MessageSendOptions{
+		Receiver: heavy,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch object requests from heavy")
+	}
+	if rep, ok := genericReply.(*reply.Error); ok {
+		return nil, rep.Error()
+	}
+
+	rep, ok := genericReply.(*reply.ObjectRequests)
+	if !ok {
+		return nil, fmt.Errorf("failed to fetch object requests: unexpected reply type %T (reply=%+v)", genericReply, genericReply)
+	}
+	return rep, nil
+}
+
+func (p *SendObjectRequests) rawRecord(ctx context.Context, id insolar.ID) ([]byte, error) {
+	rec, err := p.Dep.RecordAccessor.ForID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return rec.Virtual.Marshal()
+}
+
+// greaterID returns the greater of provided ids, nil is lower than any id.
+func greaterID(a, b *insolar.ID) *insolar.ID {
+	if a == nil {
+		return b
+	}
+	if b == nil || a.Compare(*b) >= 0 {
+		return a
+	}
+	return b
+}
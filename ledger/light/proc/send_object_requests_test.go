@@ -0,0 +1,121 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendObjectRequests_Proceed(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	a := require.New(t)
+	ctx := inslogger.TestContext(t)
+
+	pn := gen.PulseNumber()
+	objRef := gen.Reference()
+	objID := *objRef.Record()
+	material := func(rec record.Record) record.Material {
+		virtual := record.Wrap(rec)
+		return record.Material{Virtual: &virtual}
+	}
+	newID := func(pn insolar.PulseNumber) insolar.ID {
+		id := gen.ID()
+		return *insolar.NewID(pn, id.Hash())
+	}
+
+	// Requests of the first two pulses are replicated, the result of the second one is registered on light.
+	heavyReqs := []insolar.ID{newID(pn + 2), newID(pn + 1)}
+	lightReqs := []insolar.ID{newID(pn + 4), newID(pn + 3)}
+	lateResID := newID(pn + 3)
+	resID := newID(pn + 3)
+
+	records := object.NewRecordMemory()
+	for _, id := range lightReqs {
+		a.NoError(records.Set(ctx, id, material(record.Request{CallType: record.CTMethod, Object: &objRef})))
+	}
+	a.NoError(records.Set(ctx, resID, material(record.Result{
+		Object: objID, Request: *insolar.NewReference(insolar.DomainID, lightReqs[1]),
+	})))
+	a.NoError(records.Set(ctx, lateResID, material(record.Result{
+		Object: objID, Request: *insolar.NewReference(insolar.DomainID, heavyReqs[0]),
+	})))
+
+	replyTo := make(chan bus.Reply, 1)
+	msg := bus.Message{
+		ReplyTo: replyTo,
+		Parcel: &message.Parcel{
+			Msg: &message.GetObjectRequests{
+				Object: objRef,
+				Amount: 3,
+			},
+			PulseNumber: pn + 5,
+		},
+	}
+	send := proc.NewSendObjectRequests(msg)
+
+	heavyRef := gen.Reference()
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.HeavyMock.Return(&heavyRef, nil)
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(_ context.Context, m insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		a.Equal(&heavyRef, o.Receiver)
+		a.Equal(3, m.(*message.GetObjectRequests).Amount)
+		return &reply.ObjectRequests{Requests: []reply.RequestResult{
+			{RequestID: heavyReqs[0], Request: []byte{1}},
+			{RequestID: heavyReqs[1], Request: []byte{2}},
+		}}, nil
+	}
+	send.Dep.Coordinator = coordinator
+	send.Dep.RequestIndex = records
+	send.Dep.RecordAccessor = records
+	send.Dep.Bus = mb
+
+	err := send.Proceed(ctx)
+	a.NoError(err)
+
+	rep := <-replyTo
+	a.NoError(rep.Err)
+	requests, ok := rep.Reply.(*reply.ObjectRequests)
+	a.True(ok)
+	a.Len(requests.Requests, 3)
+	a.Equal(lightReqs[0], requests.Requests[0].RequestID)
+	a.Nil(requests.Requests[0].ResultID)
+	a.Equal(lightReqs[1], requests.Requests[1].RequestID)
+	a.Equal(&resID, requests.Requests[1].ResultID)
+	a.NotEmpty(requests.Requests[1].Result)
+	// Result from light is attached to the request from heavy.
+	a.Equal(heavyReqs[0], requests.Requests[2].RequestID)
+	a.Equal([]byte{1}, requests.Requests[2].Request)
+	a.Equal(&lateResID, requests.Requests[2].ResultID)
+	a.Equal(&heavyReqs[1], requests.NextFrom)
+}
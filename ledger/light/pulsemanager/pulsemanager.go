@@ -105,6 +105,7 @@ type jetInfo struct {
 	mineNext bool
 	left     *jetInfo
 	right    *jetInfo
+	parent   *jetInfo
 	split    bool
 }
 
@@ -112,6 +113,7 @@ type jetInfo struct {
 type pmOptions struct {
 	// enableSync            bool
 	splitThreshold   uint64
+	mergeThreshold   uint64
 	storeLightPulses int
 	// heavySyncMessageLimit int
 	lightChainLimit int
@@ -135,6 +137,7 @@ func NewPulseManager(
 		currentPulse: *insolar.GenesisPulse,
 		options: pmOptions{
 			splitThreshold:   pmconf.SplitThreshold,
+			mergeThreshold:   pmconf.MergeThreshold,
 			storeLightPulses: conf.LightChainLimit,
 			lightChainLimit:  conf.LightChainLimit,
 		},
@@ -188,7 +191,17 @@ func (m *PulseManager) processEndPulse(
 				}
 			}
 
-			if info.left == nil && info.right == nil {
+			if info.parent != nil {
+				msg, err := m.getExecutorHotData(
+					ctx, info.id, currentPulse.PulseNumber, newPulse.PulseNumber, drop, dropSerialized,
+				)
+				if err != nil {
+					return errors.Wrapf(err, "getExecutorData failed for jet id %v", info.id)
+				}
+				// Merge happened. Parent is released, when hot data from both siblings is received.
+				msg.Parts = 2
+				go sender(*msg, info.parent.id)
+			} else if info.left == nil && info.right == nil {
 				msg, err := m.getExecutorHotData(
 					ctx, info.id, currentPulse.PulseNumber, newPulse.PulseNumber, drop, dropSerialized,
 				)
@@ -236,6 +249,7 @@ func (m *PulseManager) createDrop(
 		Pulse: currentPulse,
 		JetID: info.id,
 		Split: info.split,
+		Size:  m.dropSize(ctx, info.id, currentPulse),
 	}
 
 	err = m.DropModifier.Set(ctx, *block)
@@ -247,6 +261,18 @@ func (m *PulseManager) createDrop(
 	return
 }
 
+// dropSize returns a size of records and blobs of a jet in a pulse.
+func (m *PulseManager) dropSize(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) uint64 {
+	var size uint64
+	for _, rec := range m.RecSyncAccessor.ForPulse(ctx, jetID, pn) {
+		size += uint64(rec.Size())
+	}
+	for _, b := range m.BlobSyncAccessor.ForPulse(ctx, jetID, pn) {
+		size += uint64(len(b.Value))
+	}
+	return size
+}
+
 func (m *PulseManager) getExecutorHotData(
 	ctx context.Context,
 	jetID insolar.JetID,
@@ -540,9 +566,42 @@ func (m *PulseManager) splitJets(ctx context.Context, jets []jetInfo, previous,
 		"new_pulse":     new,
 	})
 
+	merges := m.mergeCandidates(ctx, jets, previous)
+	merged := map[insolar.JetID]*jetInfo{}
 	for i, jet := range jets {
 		info := jetInfo{id: jet.id}
-		if m.hasSplitIntention(ctx, previous, jet.id) {
+		if sibling, ok := merges[jet.id]; ok {
+			// Sibling was processed first and jets are already merged.
+			if parent, ok := merged[sibling]; ok {
+				jets[i].parent = parent
+				continue
+			}
+
+			parentID, err := m.JetModifier.Merge(ctx, new, jet.id, sibling)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to merge jet tree")
+			}
+
+			// Set actual because we are the last executor for both jets.
+			m.JetModifier.Update(ctx, new, true, parentID)
+			parent := &jetInfo{id: parentID}
+
+			nextExecutor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(parentID), new)
+			if err != nil {
+				return nil, err
+			}
+			if *nextExecutor == me {
+				parent.mineNext = true
+			}
+
+			logger.WithFields(map[string]interface{}{
+				"sibling": sibling.DebugString(),
+				"parent":  parentID.DebugString(),
+			}).Info("jet merge performed")
+
+			merged[jet.id] = parent
+			jets[i].parent = parent
+		} else if m.hasSplitIntention(ctx, previous, jet.id) {
 			leftJetID, rightJetID, err := m.JetModifier.Split(
 				ctx,
 				new,
@@ -596,6 +655,37 @@ func (m *PulseManager) splitJets(ctx context.Context, jets []jetInfo, previous,
 	return jets, nil
 }
 
+// mergeCandidates returns sibling jets, which drops in previous pulse are both smaller than merge threshold.
+// Jets of a pair are mapped to each other.
+func (m *PulseManager) mergeCandidates(
+	ctx context.Context, jets []jetInfo, previous insolar.PulseNumber,
+) map[insolar.JetID]insolar.JetID {
+	res := map[insolar.JetID]insolar.JetID{}
+	if m.options.mergeThreshold == 0 {
+		return res
+	}
+
+	small := map[insolar.JetID]bool{}
+	for _, info := range jets {
+		if info.split || info.id.Depth() == 0 {
+			continue
+		}
+		// Jets, that were split in previous pulse, have no drop there and are not merged back immediately.
+		d, err := m.DropAccessor.ForPulse(ctx, info.id, previous)
+		if err != nil || d.Split || d.Size >= m.options.mergeThreshold {
+			continue
+		}
+		small[info.id] = true
+	}
+	for id := range small {
+		sibling := jet.Sibling(id)
+		if small[sibling] {
+			res[id] = sibling
+		}
+	}
+	return res
+}
+
 func (m *PulseManager) hasSplitIntention(ctx context.Context, previous insolar.PulseNumber, id insolar.JetID) bool {
 	drop, err := m.DropAccessor.ForPulse(ctx, id, previous)
 	if err != nil {
@@ -42,6 +42,7 @@ type LightDataGatherer struct {
 	blobsAccessor        blob.CollectionAccessor
 	recsAccessor         object.RecordCollectionAccessor
 	indexReplicaAccessor object.IndexBucketAccessor
+	typesAccessor        object.TypeIndexCollectionAccessor
 }
 
 // NewDataGatherer creates a new instance of LightDataGatherer
@@ -50,12 +51,14 @@ func NewDataGatherer(
 	blobsAccessor blob.CollectionAccessor,
 	recsAccessor object.RecordCollectionAccessor,
 	indexReplicaAccessor object.IndexBucketAccessor,
+	typesAccessor object.TypeIndexCollectionAccessor,
 ) *LightDataGatherer {
 	return &LightDataGatherer{
 		dropAccessor:         dropAccessor,
 		blobsAccessor:        blobsAccessor,
 		recsAccessor:         recsAccessor,
 		indexReplicaAccessor: indexReplicaAccessor,
+		typesAccessor:        typesAccessor,
 	}
 }
 
@@ -75,6 +78,7 @@ func (d *LightDataGatherer) ForPulseAndJet(
 	records := d.recsAccessor.ForPulse(ctx, jetID, pn)
 
 	indexes := d.indexReplicaAccessor.ForPNAndJet(ctx, pn, jetID)
+	types := d.typesAccessor.TypeIndexForPulse(ctx, jetID, pn)
 
 	return &message.HeavyPayload{
 		JetID:        jetID,
@@ -83,6 +87,7 @@ func (d *LightDataGatherer) ForPulseAndJet(
 		Drop:         drop.MustEncode(&dr),
 		Blobs:        convertBlobs(bls),
 		Records:      convertRecords(ctx, records),
+		TypeIndex:    convertTypeIndex(types),
 	}, nil
 }
 
@@ -120,3 +125,11 @@ func convertRecords(ctx context.Context, records []record.Material) [][]byte {
 	}
 	return res
 }
+
+func convertTypeIndex(entries []object.TypeIndexEntry) [][]byte {
+	res := make([][]byte, len(entries))
+	for i, e := range entries {
+		res[i] = object.EncodeTypeIndexEntry(e)
+	}
+	return res
+}
@@ -74,6 +74,14 @@ func TestDataGatherer_ForPulseAndJet(t *testing.T) {
 	}
 	ia.ForPNAndJetMock.Return(bucks)
 
+	ta := object.NewTypeIndexCollectionAccessorMock(t)
+	entry := object.TypeIndexEntry{
+		Kind:  object.KindCode,
+		ID:    gen.ID(),
+		JetID: jetID,
+	}
+	ta.TypeIndexForPulseMock.Expect(ctx, jetID, pn).Return([]object.TypeIndexEntry{entry})
+
 	recData, _ := rec.Marshal()
 
 	expectedMsg := &message.HeavyPayload{
@@ -83,9 +91,10 @@ func TestDataGatherer_ForPulseAndJet(t *testing.T) {
 		Drop:         drop.MustEncode(&d),
 		Blobs:        [][]byte{blob.MustEncode(&b)},
 		Records:      [][]byte{recData},
+		TypeIndex:    [][]byte{object.EncodeTypeIndexEntry(entry)},
 	}
 
-	dataGatherer := NewDataGatherer(da, ba, ra, ia)
+	dataGatherer := NewDataGatherer(da, ba, ra, ia, ta)
 
 	msg, err := dataGatherer.ForPulseAndJet(ctx, pn, jetID)
 
@@ -97,7 +106,7 @@ func TestDataGatherer_ForPulseAndJet_DropFetchingFailed(t *testing.T) {
 	da := drop.NewAccessorMock(t)
 	da.ForPulseMock.Return(drop.Drop{}, errors.New("everything is broken"))
 
-	dataGatherer := NewDataGatherer(da, nil, nil, nil)
+	dataGatherer := NewDataGatherer(da, nil, nil, nil, nil)
 	_, err := dataGatherer.ForPulseAndJet(inslogger.TestContext(t), gen.PulseNumber(), gen.JetID())
 
 	require.Error(t, err, errors.New("everything is broken"))
@@ -7,6 +7,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 )
 
@@ -352,7 +353,7 @@ func (i *IndexDB) Set(ctx context.Context, pn insolar.PulseNumber, objID insolar
 
 	buc, err := i.getBucket(pn, objID)
 	if err == ErrIndexBucketNotFound {
-		buc = &IndexBucket{}
+		buc = &IndexBucket{ObjID: objID}
 	} else if err != nil {
 		return err
 	}
@@ -395,6 +396,36 @@ func (i *IndexDB) SetBucket(ctx context.Context, pn insolar.PulseNumber, bucket
 	return i.setLastKnownPN(pn, bucket.ObjID)
 }
 
+// ForPNAndJet returns a collection of buckets for a provided pn and jetID
+func (i *IndexDB) ForPNAndJet(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID) []IndexBucket {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	it := i.db.NewIterator(indexKey{pn: pn}, pn.Bytes(), false)
+	defer it.Close()
+
+	res := []IndexBucket{}
+	for it.Next() {
+		buff, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read index bucket"))
+			continue
+		}
+		bucket := IndexBucket{}
+		err = bucket.Unmarshal(buff)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to decode index bucket"))
+			continue
+		}
+		if bucket.Lifeline.JetID != jetID {
+			continue
+		}
+		res = append(res, bucket)
+	}
+
+	return res
+}
+
 // ForID returns a lifeline from a bucket with provided PN and ObjID
 func (i *IndexDB) ForID(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) (Lifeline, error) {
 	var buck *IndexBucket
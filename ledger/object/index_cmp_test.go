@@ -123,3 +123,52 @@ func TestIndex_Components(t *testing.T) {
 		}
 	})
 }
+
+func TestIndex_Components_ForPNAndJet(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	indexMemory := object.NewInMemoryIndex()
+	indexDB := object.NewIndexDB(store.NewMemoryMockDB())
+
+	pn := gen.PulseNumber()
+	jetID := gen.JetID()
+	otherJetID := gen.JetID()
+	gen.UniqueJetIDs(&jetID, &otherJetID)
+
+	var expected []insolar.ID
+	for i := 0; i < 10; i++ {
+		objID := gen.ID()
+		ls := gen.ID()
+		lifeline := object.Lifeline{
+			LatestState: &ls,
+			JetID:       jetID,
+			Delegates:   []object.LifelineDelegate{},
+		}
+		bucketPN := pn
+		switch i % 3 {
+		case 0:
+			// Another pulse.
+			bucketPN = pn + 1
+		case 1:
+			// Another jet.
+			lifeline.JetID = otherJetID
+		default:
+			expected = append(expected, objID)
+		}
+		require.NoError(t, indexMemory.Set(ctx, bucketPN, objID, lifeline))
+		require.NoError(t, indexDB.Set(ctx, bucketPN, objID, lifeline))
+	}
+
+	objIDs := func(buckets []object.IndexBucket) []insolar.ID {
+		var res []insolar.ID
+		for _, b := range buckets {
+			res = append(res, b.ObjID)
+		}
+		return res
+	}
+
+	assert.ElementsMatch(t, expected, objIDs(indexMemory.ForPNAndJet(ctx, pn, jetID)))
+	assert.ElementsMatch(t, expected, objIDs(indexDB.ForPNAndJet(ctx, pn, jetID)))
+}
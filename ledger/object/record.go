@@ -24,7 +24,9 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/pkg/errors"
 )
 
 // TypeID encodes a record object type.
@@ -72,6 +74,8 @@ type RecordMemory struct {
 
 	lock     sync.RWMutex
 	recsStor map[insolar.ID]record.Material
+	types    typeIndexMemory
+	requests requestIndexMemory
 }
 
 // NewRecordMemory creates a new instance of RecordMemory storage.
@@ -79,6 +83,8 @@ func NewRecordMemory() *RecordMemory {
 	ji := store.NewJetIndex()
 	return &RecordMemory{
 		recsStor:         map[insolar.ID]record.Material{},
+		types:            typeIndexMemory{},
+		requests:         requestIndexMemory{},
 		jetIndex:         ji,
 		jetIndexAccessor: ji,
 	}
@@ -96,6 +102,10 @@ func (m *RecordMemory) Set(ctx context.Context, id insolar.ID, rec record.Materi
 
 	m.recsStor[id] = rec
 	m.jetIndex.Add(id, rec.JetID)
+	if e, ok := NewTypeIndexEntry(id, rec); ok {
+		m.types[id] = e
+	}
+	m.requests.add(id, rec)
 
 	stats.Record(ctx,
 		statRecordInMemoryAddedCount.M(1),
@@ -137,6 +147,53 @@ func (m *RecordMemory) ForPulse(
 	return res
 }
 
+// ForKind returns up to limit ids of a provided kind and attribute starting from provided id (included) and
+// registered not later than provided pulse. Ids are sorted by pulse, ids of the same pulse are sorted by their
+// hashes. Returned id is the one to fetch the next chunk from, nil means there are no more ids.
+func (m *RecordMemory) ForKind(
+	ctx context.Context, kind RecordKind, attribute string, from insolar.ID, to insolar.PulseNumber, limit int,
+) ([]insolar.ID, *insolar.ID, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	res, next := m.types.forKind(kind, attribute, from, to, limit)
+	return res, next, nil
+}
+
+// ForObject returns up to limit requests of an object ordered by id from greater to lower, starting from provided
+// request. Only requests and results stored in memory are indexed, a request can be returned without its record
+// if only its result is stored.
+func (m *RecordMemory) ForObject(
+	ctx context.Context, objID insolar.ID, from *insolar.ID, limit int,
+) ([]RequestResult, *insolar.ID, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	res, next := m.requests.forObject(objID, from, limit)
+	return res, next, nil
+}
+
+// TypeIndexForPulse returns type index entries of records of a provided jet and pulse.
+func (m *RecordMemory) TypeIndexForPulse(
+	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
+) []TypeIndexEntry {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	ids := m.jetIndexAccessor.For(jetID)
+	var res []TypeIndexEntry
+	for id := range ids {
+		if id.Pulse() != pn {
+			continue
+		}
+		if e, ok := m.types[id]; ok {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
 // DeleteForPN method removes records from a storage for all pulses until pulse (pulse included)
 func (m *RecordMemory) DeleteForPN(ctx context.Context, pulse insolar.PulseNumber) {
 	m.lock.Lock()
@@ -149,14 +206,16 @@ func (m *RecordMemory) DeleteForPN(ctx context.Context, pulse insolar.PulseNumbe
 
 		m.jetIndex.Delete(id, rec.JetID)
 		delete(m.recsStor, id)
+		delete(m.types, id)
 
 		stats.Record(ctx,
 			statRecordInMemoryRemovedCount.M(1),
 		)
 	}
+	m.requests.deleteUntil(pulse)
 }
 
-// RecordDB is a DB storage implementation. It saves records to disk and does not allow removal.
+// RecordDB is a DB storage implementation. It saves records to disk. Records are removed only by retention.
 type RecordDB struct {
 	lock sync.RWMutex
 	db   store.DB
@@ -173,6 +232,16 @@ func (k recordKey) ID() []byte {
 	return (&res).Bytes()
 }
 
+type recordPulseKey insolar.PulseNumber
+
+func (k recordPulseKey) Scope() store.Scope {
+	return store.ScopeRecord
+}
+
+func (k recordPulseKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
 // NewRecordDB creates new DB storage instance.
 func NewRecordDB(db store.DB) *RecordDB {
 	return &RecordDB{db: db}
@@ -194,6 +263,45 @@ func (r *RecordDB) ForID(ctx context.Context, id insolar.ID) (record.Material, e
 	return r.get(id)
 }
 
+// ForPulse returns []MaterialRecord for a provided jetID and a pulse number.
+func (r *RecordDB) ForPulse(
+	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
+) []record.Material {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	it := r.db.NewIterator(recordPulseKey(pn), pn.Bytes(), false)
+	defer it.Close()
+
+	var res []record.Material
+	for it.Next() {
+		buff, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read record"))
+			continue
+		}
+		rec := record.Material{}
+		err = rec.Unmarshal(buff)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to decode record"))
+			continue
+		}
+		if rec.JetID != jetID {
+			continue
+		}
+		res = append(res, rec)
+	}
+	return res
+}
+
+// Delete removes record for provided id. Removing a missing record is not an error.
+func (r *RecordDB) Delete(ctx context.Context, id insolar.ID) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.db.Delete(recordKey(id))
+}
+
 func (r *RecordDB) set(id insolar.ID, rec record.Material) error {
 	key := recordKey(id)
 
@@ -150,3 +150,41 @@ func slice() []byte {
 	size := rand.Int31n(1024)
 	return sizedSlice(size)
 }
+
+func TestRecord_Components_ForPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	memStorage := object.NewRecordMemory()
+	dbStorage := object.NewRecordDB(store.NewMemoryMockDB())
+
+	pn := gen.PulseNumber()
+	jetID := gen.JetID()
+	otherJetID := gen.JetID()
+	gen.UniqueJetIDs(&jetID, &otherJetID)
+
+	var expected []record.Material
+	for i := 0; i < 10; i++ {
+		rec := getMaterialRecord()
+		id := gen.ID()
+		switch i % 3 {
+		case 0:
+			// Another pulse.
+			id = *insolar.NewID(pn+1, id.Hash())
+			rec.JetID = jetID
+		case 1:
+			// Another jet.
+			id = *insolar.NewID(pn, id.Hash())
+			rec.JetID = otherJetID
+		default:
+			id = *insolar.NewID(pn, id.Hash())
+			rec.JetID = jetID
+			expected = append(expected, rec)
+		}
+		require.NoError(t, memStorage.Set(ctx, id, rec))
+		require.NoError(t, dbStorage.Set(ctx, id, rec))
+	}
+
+	assert.ElementsMatch(t, expected, memStorage.ForPulse(ctx, jetID, pn))
+	assert.ElementsMatch(t, expected, dbStorage.ForPulse(ctx, jetID, pn))
+}
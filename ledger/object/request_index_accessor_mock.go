@@ -0,0 +1,271 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "RequestIndexAccessor" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RequestIndexAccessorMock implements github.com/insolar/insolar/ledger/object.RequestIndexAccessor
+type RequestIndexAccessorMock struct {
+	t minimock.Tester
+
+	ForObjectFunc       func(p context.Context, p1 insolar.ID, p2 *insolar.ID, p3 int) (r []RequestResult, r1 *insolar.ID, r2 error)
+	ForObjectCounter    uint64
+	ForObjectPreCounter uint64
+	ForObjectMock       mRequestIndexAccessorMockForObject
+}
+
+//NewRequestIndexAccessorMock returns a mock for github.com/insolar/insolar/ledger/object.RequestIndexAccessor
+func NewRequestIndexAccessorMock(t minimock.Tester) *RequestIndexAccessorMock {
+	m := &RequestIndexAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForObjectMock = mRequestIndexAccessorMockForObject{mock: m}
+
+	return m
+}
+
+type mRequestIndexAccessorMockForObject struct {
+	mock              *RequestIndexAccessorMock
+	mainExpectation   *RequestIndexAccessorMockForObjectExpectation
+	expectationSeries []*RequestIndexAccessorMockForObjectExpectation
+}
+
+type RequestIndexAccessorMockForObjectExpectation struct {
+	input  *RequestIndexAccessorMockForObjectInput
+	result *RequestIndexAccessorMockForObjectResult
+}
+
+type RequestIndexAccessorMockForObjectInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 *insolar.ID
+	p3 int
+}
+
+type RequestIndexAccessorMockForObjectResult struct {
+	r  []RequestResult
+	r1 *insolar.ID
+	r2 error
+}
+
+//Expect specifies that invocation of RequestIndexAccessor.ForObject is expected from 1 to Infinity times
+func (m *mRequestIndexAccessorMockForObject) Expect(p context.Context, p1 insolar.ID, p2 *insolar.ID, p3 int) *mRequestIndexAccessorMockForObject {
+	m.mock.ForObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RequestIndexAccessorMockForObjectExpectation{}
+	}
+	m.mainExpectation.input = &RequestIndexAccessorMockForObjectInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of RequestIndexAccessor.ForObject
+func (m *mRequestIndexAccessorMockForObject) Return(r []RequestResult, r1 *insolar.ID, r2 error) *RequestIndexAccessorMock {
+	m.mock.ForObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RequestIndexAccessorMockForObjectExpectation{}
+	}
+	m.mainExpectation.result = &RequestIndexAccessorMockForObjectResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of RequestIndexAccessor.ForObject is expected once
+func (m *mRequestIndexAccessorMockForObject) ExpectOnce(p context.Context, p1 insolar.ID, p2 *insolar.ID, p3 int) *RequestIndexAccessorMockForObjectExpectation {
+	m.mock.ForObjectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RequestIndexAccessorMockForObjectExpectation{}
+	expectation.input = &RequestIndexAccessorMockForObjectInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RequestIndexAccessorMockForObjectExpectation) Return(r []RequestResult, r1 *insolar.ID, r2 error) {
+	e.result = &RequestIndexAccessorMockForObjectResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of RequestIndexAccessor.ForObject method
+func (m *mRequestIndexAccessorMockForObject) Set(f func(p context.Context, p1 insolar.ID, p2 *insolar.ID, p3 int) (r []RequestResult, r1 *insolar.ID, r2 error)) *RequestIndexAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForObjectFunc = f
+	return m.mock
+}
+
+//ForObject implements github.com/insolar/insolar/ledger/object.RequestIndexAccessor interface
+func (m *RequestIndexAccessorMock) ForObject(p context.Context, p1 insolar.ID, p2 *insolar.ID, p3 int) (r []RequestResult, r1 *insolar.ID, r2 error) {
+	counter := atomic.AddUint64(&m.ForObjectPreCounter, 1)
+	defer atomic.AddUint64(&m.ForObjectCounter, 1)
+
+	if len(m.ForObjectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForObjectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RequestIndexAccessorMock.ForObject. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.ForObjectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RequestIndexAccessorMockForObjectInput{p, p1, p2, p3}, "RequestIndexAccessor.ForObject got unexpected parameters")
+
+		result := m.ForObjectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RequestIndexAccessorMock.ForObject")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.ForObjectMock.mainExpectation != nil {
+
+		input := m.ForObjectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RequestIndexAccessorMockForObjectInput{p, p1, p2, p3}, "RequestIndexAccessor.ForObject got unexpected parameters")
+		}
+
+		result := m.ForObjectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RequestIndexAccessorMock.ForObject")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.ForObjectFunc == nil {
+		m.t.Fatalf("Unexpected call to RequestIndexAccessorMock.ForObject. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.ForObjectFunc(p, p1, p2, p3)
+}
+
+//ForObjectMinimockCounter returns a count of RequestIndexAccessorMock.ForObjectFunc invocations
+func (m *RequestIndexAccessorMock) ForObjectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForObjectCounter)
+}
+
+//ForObjectMinimockPreCounter returns the value of RequestIndexAccessorMock.ForObject invocations
+func (m *RequestIndexAccessorMock) ForObjectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForObjectPreCounter)
+}
+
+//ForObjectFinished returns true if mock invocations count is ok
+func (m *RequestIndexAccessorMock) ForObjectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForObjectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForObjectCounter) == uint64(len(m.ForObjectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForObjectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForObjectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForObjectFunc != nil {
+		return atomic.LoadUint64(&m.ForObjectCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RequestIndexAccessorMock) ValidateCallCounters() {
+
+	if !m.ForObjectFinished() {
+		m.t.Fatal("Expected call to RequestIndexAccessorMock.ForObject")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RequestIndexAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RequestIndexAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RequestIndexAccessorMock) MinimockFinish() {
+
+	if !m.ForObjectFinished() {
+		m.t.Fatal("Expected call to RequestIndexAccessorMock.ForObject")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RequestIndexAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RequestIndexAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForObjectFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForObjectFinished() {
+				m.t.Error("Expected call to RequestIndexAccessorMock.ForObject")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RequestIndexAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForObjectFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,265 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "RequestIndexModifier" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+	record "github.com/insolar/insolar/insolar/record"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RequestIndexModifierMock implements github.com/insolar/insolar/ledger/object.RequestIndexModifier
+type RequestIndexModifierMock struct {
+	t minimock.Tester
+
+	IndexRecordFunc       func(p context.Context, p1 insolar.ID, p2 record.Material) (r error)
+	IndexRecordCounter    uint64
+	IndexRecordPreCounter uint64
+	IndexRecordMock       mRequestIndexModifierMockIndexRecord
+}
+
+//NewRequestIndexModifierMock returns a mock for github.com/insolar/insolar/ledger/object.RequestIndexModifier
+func NewRequestIndexModifierMock(t minimock.Tester) *RequestIndexModifierMock {
+	m := &RequestIndexModifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.IndexRecordMock = mRequestIndexModifierMockIndexRecord{mock: m}
+
+	return m
+}
+
+type mRequestIndexModifierMockIndexRecord struct {
+	mock              *RequestIndexModifierMock
+	mainExpectation   *RequestIndexModifierMockIndexRecordExpectation
+	expectationSeries []*RequestIndexModifierMockIndexRecordExpectation
+}
+
+type RequestIndexModifierMockIndexRecordExpectation struct {
+	input  *RequestIndexModifierMockIndexRecordInput
+	result *RequestIndexModifierMockIndexRecordResult
+}
+
+type RequestIndexModifierMockIndexRecordInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 record.Material
+}
+
+type RequestIndexModifierMockIndexRecordResult struct {
+	r error
+}
+
+//Expect specifies that invocation of RequestIndexModifier.IndexRecord is expected from 1 to Infinity times
+func (m *mRequestIndexModifierMockIndexRecord) Expect(p context.Context, p1 insolar.ID, p2 record.Material) *mRequestIndexModifierMockIndexRecord {
+	m.mock.IndexRecordFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RequestIndexModifierMockIndexRecordExpectation{}
+	}
+	m.mainExpectation.input = &RequestIndexModifierMockIndexRecordInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of RequestIndexModifier.IndexRecord
+func (m *mRequestIndexModifierMockIndexRecord) Return(r error) *RequestIndexModifierMock {
+	m.mock.IndexRecordFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RequestIndexModifierMockIndexRecordExpectation{}
+	}
+	m.mainExpectation.result = &RequestIndexModifierMockIndexRecordResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of RequestIndexModifier.IndexRecord is expected once
+func (m *mRequestIndexModifierMockIndexRecord) ExpectOnce(p context.Context, p1 insolar.ID, p2 record.Material) *RequestIndexModifierMockIndexRecordExpectation {
+	m.mock.IndexRecordFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RequestIndexModifierMockIndexRecordExpectation{}
+	expectation.input = &RequestIndexModifierMockIndexRecordInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RequestIndexModifierMockIndexRecordExpectation) Return(r error) {
+	e.result = &RequestIndexModifierMockIndexRecordResult{r}
+}
+
+//Set uses given function f as a mock of RequestIndexModifier.IndexRecord method
+func (m *mRequestIndexModifierMockIndexRecord) Set(f func(p context.Context, p1 insolar.ID, p2 record.Material) (r error)) *RequestIndexModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IndexRecordFunc = f
+	return m.mock
+}
+
+//IndexRecord implements github.com/insolar/insolar/ledger/object.RequestIndexModifier interface
+func (m *RequestIndexModifierMock) IndexRecord(p context.Context, p1 insolar.ID, p2 record.Material) (r error) {
+	counter := atomic.AddUint64(&m.IndexRecordPreCounter, 1)
+	defer atomic.AddUint64(&m.IndexRecordCounter, 1)
+
+	if len(m.IndexRecordMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IndexRecordMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RequestIndexModifierMock.IndexRecord. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.IndexRecordMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RequestIndexModifierMockIndexRecordInput{p, p1, p2}, "RequestIndexModifier.IndexRecord got unexpected parameters")
+
+		result := m.IndexRecordMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RequestIndexModifierMock.IndexRecord")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IndexRecordMock.mainExpectation != nil {
+
+		input := m.IndexRecordMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RequestIndexModifierMockIndexRecordInput{p, p1, p2}, "RequestIndexModifier.IndexRecord got unexpected parameters")
+		}
+
+		result := m.IndexRecordMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RequestIndexModifierMock.IndexRecord")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IndexRecordFunc == nil {
+		m.t.Fatalf("Unexpected call to RequestIndexModifierMock.IndexRecord. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.IndexRecordFunc(p, p1, p2)
+}
+
+//IndexRecordMinimockCounter returns a count of RequestIndexModifierMock.IndexRecordFunc invocations
+func (m *RequestIndexModifierMock) IndexRecordMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IndexRecordCounter)
+}
+
+//IndexRecordMinimockPreCounter returns the value of RequestIndexModifierMock.IndexRecord invocations
+func (m *RequestIndexModifierMock) IndexRecordMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IndexRecordPreCounter)
+}
+
+//IndexRecordFinished returns true if mock invocations count is ok
+func (m *RequestIndexModifierMock) IndexRecordFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IndexRecordMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IndexRecordCounter) == uint64(len(m.IndexRecordMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IndexRecordMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IndexRecordCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IndexRecordFunc != nil {
+		return atomic.LoadUint64(&m.IndexRecordCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RequestIndexModifierMock) ValidateCallCounters() {
+
+	if !m.IndexRecordFinished() {
+		m.t.Fatal("Expected call to RequestIndexModifierMock.IndexRecord")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RequestIndexModifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RequestIndexModifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RequestIndexModifierMock) MinimockFinish() {
+
+	if !m.IndexRecordFinished() {
+		m.t.Fatal("Expected call to RequestIndexModifierMock.IndexRecord")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RequestIndexModifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RequestIndexModifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.IndexRecordFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.IndexRecordFinished() {
+				m.t.Error("Expected call to RequestIndexModifierMock.IndexRecord")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RequestIndexModifierMock) AllMocksCalled() bool {
+
+	if !m.IndexRecordFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,241 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/pkg/errors"
+)
+
+// RequestResult is a request id with id of its result from requests index.
+type RequestResult struct {
+	Request insolar.ID
+	// Result is nil until the request is processed.
+	Result *insolar.ID
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.RequestIndexAccessor -o ./ -s _mock.go
+
+// RequestIndexAccessor provides methods for fetching requests of an object.
+type RequestIndexAccessor interface {
+	// ForObject returns up to limit requests of an object ordered by id from greater to lower, starting from provided
+	// request. I.e. requests of newer pulses go first, requests of the same pulse are ordered by their hashes.
+	// If from is nil, the latest request will be the first one. Returned id is a request to fetch the next chunk from,
+	// nil means there are no more requests.
+	ForObject(ctx context.Context, objID insolar.ID, from *insolar.ID, limit int) ([]RequestResult, *insolar.ID, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.RequestIndexModifier -o ./ -s _mock.go
+
+// RequestIndexModifier provides methods for indexing requests and results by their objects.
+type RequestIndexModifier interface {
+	// IndexRecord adds a request or a result record to the index of its object. Other records are ignored.
+	IndexRecord(ctx context.Context, id insolar.ID, rec record.Material) error
+}
+
+// requestIndexEntry returns the object, the request and the result (for result records) a record is indexed by.
+// False is returned for records that are not indexed.
+func requestIndexEntry(id insolar.ID, rec record.Material) (objID, reqID insolar.ID, resID *insolar.ID, ok bool) {
+	if rec.Virtual == nil {
+		return objID, reqID, nil, false
+	}
+
+	switch r := record.Unwrap(rec.Virtual).(type) {
+	case *record.Request:
+		objID = id
+		if r.CallType == record.CTMethod {
+			if r.Object == nil {
+				return objID, reqID, nil, false
+			}
+			objID = *r.Object.Record()
+		}
+		return objID, id, nil, true
+	case *record.Result:
+		res := id
+		return r.Object, *r.Request.Record(), &res, true
+	}
+	return objID, reqID, nil, false
+}
+
+// requestIndexMemory is an in-memory requests index. It's maintained by RecordMemory.
+// Requests are mapped to their results by objects.
+type requestIndexMemory map[insolar.ID]map[insolar.ID]*insolar.ID
+
+func (m requestIndexMemory) add(id insolar.ID, rec record.Material) {
+	objID, reqID, resID, ok := requestIndexEntry(id, rec)
+	if !ok {
+		return
+	}
+
+	reqs, ok := m[objID]
+	if !ok {
+		reqs = map[insolar.ID]*insolar.ID{}
+		m[objID] = reqs
+	}
+	if resID != nil || reqs[reqID] == nil {
+		reqs[reqID] = resID
+	}
+}
+
+// deleteUntil removes entries which request and result are both registered not later than provided pulse.
+func (m requestIndexMemory) deleteUntil(pn insolar.PulseNumber) {
+	for objID, reqs := range m {
+		for reqID, resID := range reqs {
+			if reqID.Pulse() > pn || (resID != nil && resID.Pulse() > pn) {
+				continue
+			}
+			delete(reqs, reqID)
+		}
+		if len(reqs) == 0 {
+			delete(m, objID)
+		}
+	}
+}
+
+func (m requestIndexMemory) forObject(objID insolar.ID, from *insolar.ID, limit int) ([]RequestResult, *insolar.ID) {
+	reqs := m[objID]
+	ids := make([]insolar.ID, 0, len(reqs))
+	for reqID := range reqs {
+		if from != nil && reqID.Compare(*from) > 0 {
+			continue
+		}
+		ids = append(ids, reqID)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Compare(ids[j]) > 0
+	})
+
+	var res []RequestResult
+	for _, reqID := range ids {
+		if len(res) == limit {
+			next := reqID
+			return res, &next
+		}
+		res = append(res, RequestResult{Request: reqID, Result: reqs[reqID]})
+	}
+	return res, nil
+}
+
+// RequestIndexDB is a DB storage of requests indexed by their objects.
+// Object is the one request is called on. Requests of constructors are indexed by the created object,
+// which id is the same as request id.
+type RequestIndexDB struct {
+	lock sync.Mutex
+	db   store.DB
+}
+
+type requestIndexKey struct {
+	objID insolar.ID
+	reqID insolar.ID
+}
+
+func (k requestIndexKey) Scope() store.Scope {
+	return store.ScopeRequestIndex
+}
+
+func (k requestIndexKey) ID() []byte {
+	return append(k.objID.Bytes(), k.reqID.Bytes()...)
+}
+
+type requestIndexObjectKey insolar.ID
+
+func (k requestIndexObjectKey) Scope() store.Scope {
+	return store.ScopeRequestIndex
+}
+
+func (k requestIndexObjectKey) ID() []byte {
+	return insolar.ID(k).Bytes()
+}
+
+// NewRequestIndexDB creates new DB storage instance.
+func NewRequestIndexDB(db store.DB) *RequestIndexDB {
+	return &RequestIndexDB{db: db}
+}
+
+// IndexRecord adds a request or a result record to the index of its object. Other records are ignored.
+// Requests and results can be indexed in any order.
+func (i *RequestIndexDB) IndexRecord(ctx context.Context, id insolar.ID, rec record.Material) error {
+	if rec.Virtual == nil {
+		return nil
+	}
+
+	objID, reqID, resID, ok := requestIndexEntry(id, rec)
+	if !ok {
+		return nil
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	key := requestIndexKey{objID: objID, reqID: reqID}
+	if resID != nil {
+		return i.db.Set(key, resID.Bytes())
+	}
+	_, err := i.db.Get(key)
+	if err == nil {
+		// Result is already indexed.
+		return nil
+	}
+	if err != store.ErrNotFound {
+		return err
+	}
+	return i.db.Set(key, nil)
+}
+
+// ForObject returns up to limit requests of an object ordered by id from greater to lower, starting from provided
+// request. I.e. requests of newer pulses go first, requests of the same pulse are ordered by their hashes.
+// If from is nil, the latest request will be the first one. Returned id is a request to fetch the next chunk from,
+// nil means there are no more requests.
+func (i *RequestIndexDB) ForObject(
+	ctx context.Context, objID insolar.ID, from *insolar.ID, limit int,
+) ([]RequestResult, *insolar.ID, error) {
+	var pivot store.Key = requestIndexObjectKey(objID)
+	if from != nil {
+		pivot = requestIndexKey{objID: objID, reqID: *from}
+	}
+	it := i.db.NewIterator(pivot, objID.Bytes(), true)
+	defer it.Close()
+
+	var res []RequestResult
+	for it.Next() {
+		var reqID insolar.ID
+		copy(reqID[:], it.Key()[insolar.RecordIDSize:])
+		if len(res) == limit {
+			return res, &reqID, nil
+		}
+
+		value, err := it.Value()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to read request index")
+		}
+		rr := RequestResult{Request: reqID}
+		if len(value) != 0 {
+			var resID insolar.ID
+			copy(resID[:], value)
+			rr.Result = &resID
+		}
+		res = append(res, rr)
+	}
+
+	return res, nil, nil
+}
@@ -0,0 +1,148 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object_test
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestIndexDB_ForObject(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	requests := object.NewRequestIndexDB(store.NewMemoryMockDB())
+
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+	objID := *insolar.NewID(pn, []byte{0})
+	objRef := *insolar.NewReference(insolar.DomainID, objID)
+
+	material := func(rec record.Record) record.Material {
+		virtual := record.Wrap(rec)
+		return record.Material{Virtual: &virtual}
+	}
+
+	// Constructor request is indexed by the object it creates.
+	require.NoError(t, requests.IndexRecord(ctx, objID, material(record.Request{CallType: record.CTSaveAsChild})))
+
+	var reqIDs []insolar.ID
+	for i := 1; i <= 3; i++ {
+		reqID := *insolar.NewID(pn+insolar.PulseNumber(i), []byte{byte(i)})
+		reqIDs = append(reqIDs, reqID)
+		require.NoError(t, requests.IndexRecord(
+			ctx, reqID, material(record.Request{CallType: record.CTMethod, Object: &objRef, Method: "Transfer"}),
+		))
+	}
+
+	// Result indexed before the request is kept.
+	resID := gen.ID()
+	lateReq := *insolar.NewID(pn+10, []byte{10})
+	require.NoError(t, requests.IndexRecord(ctx, resID, material(record.Result{
+		Object: objID, Request: *insolar.NewReference(insolar.DomainID, lateReq),
+	})))
+	require.NoError(t, requests.IndexRecord(
+		ctx, lateReq, material(record.Request{CallType: record.CTMethod, Object: &objRef}),
+	))
+
+	// Other objects and records are not mixed in.
+	otherRef := gen.Reference()
+	require.NoError(t, requests.IndexRecord(
+		ctx, gen.ID(), material(record.Request{CallType: record.CTMethod, Object: &otherRef}),
+	))
+	require.NoError(t, requests.IndexRecord(ctx, gen.ID(), material(record.Code{})))
+
+	page, next, err := requests.ForObject(ctx, objID, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, lateReq, page[0].Request)
+	assert.Equal(t, &resID, page[0].Result)
+	assert.Equal(t, reqIDs[2], page[1].Request)
+	assert.Nil(t, page[1].Result)
+	require.NotNil(t, next)
+	assert.Equal(t, reqIDs[1], *next)
+
+	page, next, err = requests.ForObject(ctx, objID, next, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	assert.Equal(t, reqIDs[1], page[0].Request)
+	assert.Equal(t, reqIDs[0], page[1].Request)
+	assert.Equal(t, objID, page[2].Request)
+	assert.Nil(t, next)
+}
+
+func TestRecordMemory_ForObject(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	records := object.NewRecordMemory()
+
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+	objID := *insolar.NewID(pn, []byte{0})
+	objRef := *insolar.NewReference(insolar.DomainID, objID)
+
+	material := func(rec record.Record) record.Material {
+		virtual := record.Wrap(rec)
+		return record.Material{Virtual: &virtual}
+	}
+
+	var reqIDs []insolar.ID
+	for i := 1; i <= 3; i++ {
+		reqID := *insolar.NewID(pn+insolar.PulseNumber(i), []byte{byte(i)})
+		reqIDs = append(reqIDs, reqID)
+		require.NoError(t, records.Set(
+			ctx, reqID, material(record.Request{CallType: record.CTMethod, Object: &objRef}),
+		))
+	}
+	// The first request is processed in a later pulse.
+	resID := *insolar.NewID(pn+3, []byte{10})
+	require.NoError(t, records.Set(ctx, resID, material(record.Result{
+		Object: objID, Request: *insolar.NewReference(insolar.DomainID, reqIDs[0]),
+	})))
+
+	page, next, err := records.ForObject(ctx, objID, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, reqIDs[2], page[0].Request)
+	assert.Equal(t, reqIDs[1], page[1].Request)
+	require.NotNil(t, next)
+	assert.Equal(t, reqIDs[0], *next)
+
+	page, next, err = records.ForObject(ctx, objID, next, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, reqIDs[0], page[0].Request)
+	assert.Equal(t, &resID, page[0].Result)
+	assert.Nil(t, next)
+
+	// The result keeps its request in the index until it's removed as well.
+	records.DeleteForPN(ctx, pn+1)
+	records.DeleteForPN(ctx, pn+2)
+	page, _, err = records.ForObject(ctx, objID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, reqIDs[2], page[0].Request)
+	assert.Equal(t, reqIDs[0], page[1].Request)
+
+	records.DeleteForPN(ctx, pn+3)
+	page, _, err = records.ForObject(ctx, objID, nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, page)
+}
@@ -0,0 +1,273 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "TypeIndexAccessor" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//TypeIndexAccessorMock implements github.com/insolar/insolar/ledger/object.TypeIndexAccessor
+type TypeIndexAccessorMock struct {
+	t minimock.Tester
+
+	ForKindFunc       func(p context.Context, p1 RecordKind, p2 string, p3 insolar.ID, p4 insolar.PulseNumber, p5 int) (r []insolar.ID, r1 *insolar.ID, r2 error)
+	ForKindCounter    uint64
+	ForKindPreCounter uint64
+	ForKindMock       mTypeIndexAccessorMockForKind
+}
+
+//NewTypeIndexAccessorMock returns a mock for github.com/insolar/insolar/ledger/object.TypeIndexAccessor
+func NewTypeIndexAccessorMock(t minimock.Tester) *TypeIndexAccessorMock {
+	m := &TypeIndexAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForKindMock = mTypeIndexAccessorMockForKind{mock: m}
+
+	return m
+}
+
+type mTypeIndexAccessorMockForKind struct {
+	mock              *TypeIndexAccessorMock
+	mainExpectation   *TypeIndexAccessorMockForKindExpectation
+	expectationSeries []*TypeIndexAccessorMockForKindExpectation
+}
+
+type TypeIndexAccessorMockForKindExpectation struct {
+	input  *TypeIndexAccessorMockForKindInput
+	result *TypeIndexAccessorMockForKindResult
+}
+
+type TypeIndexAccessorMockForKindInput struct {
+	p  context.Context
+	p1 RecordKind
+	p2 string
+	p3 insolar.ID
+	p4 insolar.PulseNumber
+	p5 int
+}
+
+type TypeIndexAccessorMockForKindResult struct {
+	r  []insolar.ID
+	r1 *insolar.ID
+	r2 error
+}
+
+//Expect specifies that invocation of TypeIndexAccessor.ForKind is expected from 1 to Infinity times
+func (m *mTypeIndexAccessorMockForKind) Expect(p context.Context, p1 RecordKind, p2 string, p3 insolar.ID, p4 insolar.PulseNumber, p5 int) *mTypeIndexAccessorMockForKind {
+	m.mock.ForKindFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TypeIndexAccessorMockForKindExpectation{}
+	}
+	m.mainExpectation.input = &TypeIndexAccessorMockForKindInput{p, p1, p2, p3, p4, p5}
+	return m
+}
+
+//Return specifies results of invocation of TypeIndexAccessor.ForKind
+func (m *mTypeIndexAccessorMockForKind) Return(r []insolar.ID, r1 *insolar.ID, r2 error) *TypeIndexAccessorMock {
+	m.mock.ForKindFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TypeIndexAccessorMockForKindExpectation{}
+	}
+	m.mainExpectation.result = &TypeIndexAccessorMockForKindResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TypeIndexAccessor.ForKind is expected once
+func (m *mTypeIndexAccessorMockForKind) ExpectOnce(p context.Context, p1 RecordKind, p2 string, p3 insolar.ID, p4 insolar.PulseNumber, p5 int) *TypeIndexAccessorMockForKindExpectation {
+	m.mock.ForKindFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TypeIndexAccessorMockForKindExpectation{}
+	expectation.input = &TypeIndexAccessorMockForKindInput{p, p1, p2, p3, p4, p5}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TypeIndexAccessorMockForKindExpectation) Return(r []insolar.ID, r1 *insolar.ID, r2 error) {
+	e.result = &TypeIndexAccessorMockForKindResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of TypeIndexAccessor.ForKind method
+func (m *mTypeIndexAccessorMockForKind) Set(f func(p context.Context, p1 RecordKind, p2 string, p3 insolar.ID, p4 insolar.PulseNumber, p5 int) (r []insolar.ID, r1 *insolar.ID, r2 error)) *TypeIndexAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForKindFunc = f
+	return m.mock
+}
+
+//ForKind implements github.com/insolar/insolar/ledger/object.TypeIndexAccessor interface
+func (m *TypeIndexAccessorMock) ForKind(p context.Context, p1 RecordKind, p2 string, p3 insolar.ID, p4 insolar.PulseNumber, p5 int) (r []insolar.ID, r1 *insolar.ID, r2 error) {
+	counter := atomic.AddUint64(&m.ForKindPreCounter, 1)
+	defer atomic.AddUint64(&m.ForKindCounter, 1)
+
+	if len(m.ForKindMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForKindMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TypeIndexAccessorMock.ForKind. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
+			return
+		}
+
+		input := m.ForKindMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TypeIndexAccessorMockForKindInput{p, p1, p2, p3, p4, p5}, "TypeIndexAccessor.ForKind got unexpected parameters")
+
+		result := m.ForKindMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TypeIndexAccessorMock.ForKind")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.ForKindMock.mainExpectation != nil {
+
+		input := m.ForKindMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TypeIndexAccessorMockForKindInput{p, p1, p2, p3, p4, p5}, "TypeIndexAccessor.ForKind got unexpected parameters")
+		}
+
+		result := m.ForKindMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TypeIndexAccessorMock.ForKind")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.ForKindFunc == nil {
+		m.t.Fatalf("Unexpected call to TypeIndexAccessorMock.ForKind. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
+		return
+	}
+
+	return m.ForKindFunc(p, p1, p2, p3, p4, p5)
+}
+
+//ForKindMinimockCounter returns a count of TypeIndexAccessorMock.ForKindFunc invocations
+func (m *TypeIndexAccessorMock) ForKindMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForKindCounter)
+}
+
+//ForKindMinimockPreCounter returns the value of TypeIndexAccessorMock.ForKind invocations
+func (m *TypeIndexAccessorMock) ForKindMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForKindPreCounter)
+}
+
+//ForKindFinished returns true if mock invocations count is ok
+func (m *TypeIndexAccessorMock) ForKindFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForKindMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForKindCounter) == uint64(len(m.ForKindMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForKindMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForKindCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForKindFunc != nil {
+		return atomic.LoadUint64(&m.ForKindCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TypeIndexAccessorMock) ValidateCallCounters() {
+
+	if !m.ForKindFinished() {
+		m.t.Fatal("Expected call to TypeIndexAccessorMock.ForKind")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TypeIndexAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *TypeIndexAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *TypeIndexAccessorMock) MinimockFinish() {
+
+	if !m.ForKindFinished() {
+		m.t.Fatal("Expected call to TypeIndexAccessorMock.ForKind")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *TypeIndexAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *TypeIndexAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForKindFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForKindFinished() {
+				m.t.Error("Expected call to TypeIndexAccessorMock.ForKind")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *TypeIndexAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForKindFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,264 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "TypeIndexCollectionAccessor" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//TypeIndexCollectionAccessorMock implements github.com/insolar/insolar/ledger/object.TypeIndexCollectionAccessor
+type TypeIndexCollectionAccessorMock struct {
+	t minimock.Tester
+
+	TypeIndexForPulseFunc       func(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) (r []TypeIndexEntry)
+	TypeIndexForPulseCounter    uint64
+	TypeIndexForPulsePreCounter uint64
+	TypeIndexForPulseMock       mTypeIndexCollectionAccessorMockTypeIndexForPulse
+}
+
+//NewTypeIndexCollectionAccessorMock returns a mock for github.com/insolar/insolar/ledger/object.TypeIndexCollectionAccessor
+func NewTypeIndexCollectionAccessorMock(t minimock.Tester) *TypeIndexCollectionAccessorMock {
+	m := &TypeIndexCollectionAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.TypeIndexForPulseMock = mTypeIndexCollectionAccessorMockTypeIndexForPulse{mock: m}
+
+	return m
+}
+
+type mTypeIndexCollectionAccessorMockTypeIndexForPulse struct {
+	mock              *TypeIndexCollectionAccessorMock
+	mainExpectation   *TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation
+	expectationSeries []*TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation
+}
+
+type TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation struct {
+	input  *TypeIndexCollectionAccessorMockTypeIndexForPulseInput
+	result *TypeIndexCollectionAccessorMockTypeIndexForPulseResult
+}
+
+type TypeIndexCollectionAccessorMockTypeIndexForPulseInput struct {
+	p  context.Context
+	p1 insolar.JetID
+	p2 insolar.PulseNumber
+}
+
+type TypeIndexCollectionAccessorMockTypeIndexForPulseResult struct {
+	r []TypeIndexEntry
+}
+
+//Expect specifies that invocation of TypeIndexCollectionAccessor.TypeIndexForPulse is expected from 1 to Infinity times
+func (m *mTypeIndexCollectionAccessorMockTypeIndexForPulse) Expect(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) *mTypeIndexCollectionAccessorMockTypeIndexForPulse {
+	m.mock.TypeIndexForPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation{}
+	}
+	m.mainExpectation.input = &TypeIndexCollectionAccessorMockTypeIndexForPulseInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of TypeIndexCollectionAccessor.TypeIndexForPulse
+func (m *mTypeIndexCollectionAccessorMockTypeIndexForPulse) Return(r []TypeIndexEntry) *TypeIndexCollectionAccessorMock {
+	m.mock.TypeIndexForPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation{}
+	}
+	m.mainExpectation.result = &TypeIndexCollectionAccessorMockTypeIndexForPulseResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TypeIndexCollectionAccessor.TypeIndexForPulse is expected once
+func (m *mTypeIndexCollectionAccessorMockTypeIndexForPulse) ExpectOnce(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) *TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation {
+	m.mock.TypeIndexForPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation{}
+	expectation.input = &TypeIndexCollectionAccessorMockTypeIndexForPulseInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TypeIndexCollectionAccessorMockTypeIndexForPulseExpectation) Return(r []TypeIndexEntry) {
+	e.result = &TypeIndexCollectionAccessorMockTypeIndexForPulseResult{r}
+}
+
+//Set uses given function f as a mock of TypeIndexCollectionAccessor.TypeIndexForPulse method
+func (m *mTypeIndexCollectionAccessorMockTypeIndexForPulse) Set(f func(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) (r []TypeIndexEntry)) *TypeIndexCollectionAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.TypeIndexForPulseFunc = f
+	return m.mock
+}
+
+//TypeIndexForPulse implements github.com/insolar/insolar/ledger/object.TypeIndexCollectionAccessor interface
+func (m *TypeIndexCollectionAccessorMock) TypeIndexForPulse(p context.Context, p1 insolar.JetID, p2 insolar.PulseNumber) (r []TypeIndexEntry) {
+	counter := atomic.AddUint64(&m.TypeIndexForPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.TypeIndexForPulseCounter, 1)
+
+	if len(m.TypeIndexForPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.TypeIndexForPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TypeIndexCollectionAccessorMock.TypeIndexForPulse. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.TypeIndexForPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TypeIndexCollectionAccessorMockTypeIndexForPulseInput{p, p1, p2}, "TypeIndexCollectionAccessor.TypeIndexForPulse got unexpected parameters")
+
+		result := m.TypeIndexForPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TypeIndexCollectionAccessorMock.TypeIndexForPulse")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.TypeIndexForPulseMock.mainExpectation != nil {
+
+		input := m.TypeIndexForPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TypeIndexCollectionAccessorMockTypeIndexForPulseInput{p, p1, p2}, "TypeIndexCollectionAccessor.TypeIndexForPulse got unexpected parameters")
+		}
+
+		result := m.TypeIndexForPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TypeIndexCollectionAccessorMock.TypeIndexForPulse")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.TypeIndexForPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to TypeIndexCollectionAccessorMock.TypeIndexForPulse. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.TypeIndexForPulseFunc(p, p1, p2)
+}
+
+//TypeIndexForPulseMinimockCounter returns a count of TypeIndexCollectionAccessorMock.TypeIndexForPulseFunc invocations
+func (m *TypeIndexCollectionAccessorMock) TypeIndexForPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.TypeIndexForPulseCounter)
+}
+
+//TypeIndexForPulseMinimockPreCounter returns the value of TypeIndexCollectionAccessorMock.TypeIndexForPulse invocations
+func (m *TypeIndexCollectionAccessorMock) TypeIndexForPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.TypeIndexForPulsePreCounter)
+}
+
+//TypeIndexForPulseFinished returns true if mock invocations count is ok
+func (m *TypeIndexCollectionAccessorMock) TypeIndexForPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.TypeIndexForPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.TypeIndexForPulseCounter) == uint64(len(m.TypeIndexForPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.TypeIndexForPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.TypeIndexForPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.TypeIndexForPulseFunc != nil {
+		return atomic.LoadUint64(&m.TypeIndexForPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TypeIndexCollectionAccessorMock) ValidateCallCounters() {
+
+	if !m.TypeIndexForPulseFinished() {
+		m.t.Fatal("Expected call to TypeIndexCollectionAccessorMock.TypeIndexForPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TypeIndexCollectionAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *TypeIndexCollectionAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *TypeIndexCollectionAccessorMock) MinimockFinish() {
+
+	if !m.TypeIndexForPulseFinished() {
+		m.t.Fatal("Expected call to TypeIndexCollectionAccessorMock.TypeIndexForPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *TypeIndexCollectionAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *TypeIndexCollectionAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.TypeIndexForPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.TypeIndexForPulseFinished() {
+				m.t.Error("Expected call to TypeIndexCollectionAccessorMock.TypeIndexForPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *TypeIndexCollectionAccessorMock) AllMocksCalled() bool {
+
+	if !m.TypeIndexForPulseFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,261 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "TypeIndexModifier" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//TypeIndexModifierMock implements github.com/insolar/insolar/ledger/object.TypeIndexModifier
+type TypeIndexModifierMock struct {
+	t minimock.Tester
+
+	AddFunc       func(p context.Context, p1 TypeIndexEntry) (r error)
+	AddCounter    uint64
+	AddPreCounter uint64
+	AddMock       mTypeIndexModifierMockAdd
+}
+
+//NewTypeIndexModifierMock returns a mock for github.com/insolar/insolar/ledger/object.TypeIndexModifier
+func NewTypeIndexModifierMock(t minimock.Tester) *TypeIndexModifierMock {
+	m := &TypeIndexModifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mTypeIndexModifierMockAdd{mock: m}
+
+	return m
+}
+
+type mTypeIndexModifierMockAdd struct {
+	mock              *TypeIndexModifierMock
+	mainExpectation   *TypeIndexModifierMockAddExpectation
+	expectationSeries []*TypeIndexModifierMockAddExpectation
+}
+
+type TypeIndexModifierMockAddExpectation struct {
+	input  *TypeIndexModifierMockAddInput
+	result *TypeIndexModifierMockAddResult
+}
+
+type TypeIndexModifierMockAddInput struct {
+	p  context.Context
+	p1 TypeIndexEntry
+}
+
+type TypeIndexModifierMockAddResult struct {
+	r error
+}
+
+//Expect specifies that invocation of TypeIndexModifier.Add is expected from 1 to Infinity times
+func (m *mTypeIndexModifierMockAdd) Expect(p context.Context, p1 TypeIndexEntry) *mTypeIndexModifierMockAdd {
+	m.mock.AddFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TypeIndexModifierMockAddExpectation{}
+	}
+	m.mainExpectation.input = &TypeIndexModifierMockAddInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of TypeIndexModifier.Add
+func (m *mTypeIndexModifierMockAdd) Return(r error) *TypeIndexModifierMock {
+	m.mock.AddFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TypeIndexModifierMockAddExpectation{}
+	}
+	m.mainExpectation.result = &TypeIndexModifierMockAddResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TypeIndexModifier.Add is expected once
+func (m *mTypeIndexModifierMockAdd) ExpectOnce(p context.Context, p1 TypeIndexEntry) *TypeIndexModifierMockAddExpectation {
+	m.mock.AddFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TypeIndexModifierMockAddExpectation{}
+	expectation.input = &TypeIndexModifierMockAddInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TypeIndexModifierMockAddExpectation) Return(r error) {
+	e.result = &TypeIndexModifierMockAddResult{r}
+}
+
+//Set uses given function f as a mock of TypeIndexModifier.Add method
+func (m *mTypeIndexModifierMockAdd) Set(f func(p context.Context, p1 TypeIndexEntry) (r error)) *TypeIndexModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddFunc = f
+	return m.mock
+}
+
+//Add implements github.com/insolar/insolar/ledger/object.TypeIndexModifier interface
+func (m *TypeIndexModifierMock) Add(p context.Context, p1 TypeIndexEntry) (r error) {
+	counter := atomic.AddUint64(&m.AddPreCounter, 1)
+	defer atomic.AddUint64(&m.AddCounter, 1)
+
+	if len(m.AddMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TypeIndexModifierMock.Add. %v %v", p, p1)
+			return
+		}
+
+		input := m.AddMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TypeIndexModifierMockAddInput{p, p1}, "TypeIndexModifier.Add got unexpected parameters")
+
+		result := m.AddMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TypeIndexModifierMock.Add")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddMock.mainExpectation != nil {
+
+		input := m.AddMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TypeIndexModifierMockAddInput{p, p1}, "TypeIndexModifier.Add got unexpected parameters")
+		}
+
+		result := m.AddMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TypeIndexModifierMock.Add")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddFunc == nil {
+		m.t.Fatalf("Unexpected call to TypeIndexModifierMock.Add. %v %v", p, p1)
+		return
+	}
+
+	return m.AddFunc(p, p1)
+}
+
+//AddMinimockCounter returns a count of TypeIndexModifierMock.AddFunc invocations
+func (m *TypeIndexModifierMock) AddMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddCounter)
+}
+
+//AddMinimockPreCounter returns the value of TypeIndexModifierMock.Add invocations
+func (m *TypeIndexModifierMock) AddMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddPreCounter)
+}
+
+//AddFinished returns true if mock invocations count is ok
+func (m *TypeIndexModifierMock) AddFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddCounter) == uint64(len(m.AddMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddFunc != nil {
+		return atomic.LoadUint64(&m.AddCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TypeIndexModifierMock) ValidateCallCounters() {
+
+	if !m.AddFinished() {
+		m.t.Fatal("Expected call to TypeIndexModifierMock.Add")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TypeIndexModifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *TypeIndexModifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *TypeIndexModifierMock) MinimockFinish() {
+
+	if !m.AddFinished() {
+		m.t.Fatal("Expected call to TypeIndexModifierMock.Add")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *TypeIndexModifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *TypeIndexModifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.AddFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.AddFinished() {
+				m.t.Error("Expected call to TypeIndexModifierMock.Add")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *TypeIndexModifierMock) AllMocksCalled() bool {
+
+	if !m.AddFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,210 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/pkg/errors"
+)
+
+// RecordKind is a kind of records in the record type index.
+type RecordKind byte
+
+const (
+	// KindCode is a kind of code records. They are indexed by code record id.
+	KindCode RecordKind = iota + 1
+	// KindPrototype is a kind of prototype activation records. They are indexed by prototype head id.
+	KindPrototype
+	// KindRequest is a kind of request records. They are indexed by request id with called method as an attribute.
+	KindRequest
+)
+
+// TypeIndexEntry is an entry of the record type index.
+type TypeIndexEntry struct {
+	Kind RecordKind
+	// Attribute narrows the kind. It's a method name for requests and empty for other kinds.
+	Attribute string
+	ID        insolar.ID
+	JetID     insolar.JetID
+}
+
+// NewTypeIndexEntry returns type index entry for a record with provided id. It returns false for records, that are
+// not indexed.
+func NewTypeIndexEntry(id insolar.ID, rec record.Material) (TypeIndexEntry, bool) {
+	if rec.Virtual == nil {
+		return TypeIndexEntry{}, false
+	}
+
+	switch r := record.Unwrap(rec.Virtual).(type) {
+	case *record.Code:
+		return TypeIndexEntry{Kind: KindCode, ID: id, JetID: rec.JetID}, true
+	case *record.Activate:
+		if !r.IsPrototype {
+			return TypeIndexEntry{}, false
+		}
+		return TypeIndexEntry{Kind: KindPrototype, ID: *r.Request.Record(), JetID: rec.JetID}, true
+	case *record.Request:
+		return TypeIndexEntry{Kind: KindRequest, Attribute: r.Method, ID: id, JetID: rec.JetID}, true
+	}
+	return TypeIndexEntry{}, false
+}
+
+// EncodeTypeIndexEntry serializes type index entry.
+func EncodeTypeIndexEntry(e TypeIndexEntry) []byte {
+	buf := make([]byte, 0, 1+insolar.RecordIDSize+len(e.JetID)+len(e.Attribute))
+	buf = append(buf, byte(e.Kind))
+	buf = append(buf, e.ID.Bytes()...)
+	buf = append(buf, e.JetID[:]...)
+	return append(buf, e.Attribute...)
+}
+
+// DecodeTypeIndexEntry deserializes type index entry.
+func DecodeTypeIndexEntry(buf []byte) (TypeIndexEntry, error) {
+	var e TypeIndexEntry
+	if len(buf) < 1+insolar.RecordIDSize+len(e.JetID) {
+		return e, errors.New("type index entry is too short")
+	}
+	e.Kind = RecordKind(buf[0])
+	buf = buf[1:]
+	copy(e.ID[:], buf[:insolar.RecordIDSize])
+	buf = buf[insolar.RecordIDSize:]
+	copy(e.JetID[:], buf[:len(e.JetID)])
+	e.Attribute = string(buf[len(e.JetID):])
+	return e, nil
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.TypeIndexAccessor -o ./ -s _mock.go
+
+// TypeIndexAccessor provides methods for querying records by their types.
+type TypeIndexAccessor interface {
+	// ForKind returns up to limit ids of a provided kind and attribute starting from provided id (included) and
+	// registered not later than provided pulse. Ids are sorted by pulse, ids of the same pulse are sorted by their
+	// hashes. Returned id is the one to fetch the next chunk from, nil means there are no more ids.
+	ForKind(
+		ctx context.Context, kind RecordKind, attribute string, from insolar.ID, to insolar.PulseNumber, limit int,
+	) ([]insolar.ID, *insolar.ID, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.TypeIndexCollectionAccessor -o ./ -s _mock.go
+
+// TypeIndexCollectionAccessor provides methods for fetching type index entries for replication.
+type TypeIndexCollectionAccessor interface {
+	// TypeIndexForPulse returns type index entries of records of a provided jet and pulse.
+	TypeIndexForPulse(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []TypeIndexEntry
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.TypeIndexModifier -o ./ -s _mock.go
+
+// TypeIndexModifier provides methods for adding entries to the record type index.
+type TypeIndexModifier interface {
+	// Add adds provided entry to the index.
+	Add(ctx context.Context, entry TypeIndexEntry) error
+}
+
+// typeIndexMemory is an in-memory record type index. It's maintained by RecordMemory.
+type typeIndexMemory map[insolar.ID]TypeIndexEntry
+
+func (m typeIndexMemory) forKind(
+	kind RecordKind, attribute string, from insolar.ID, to insolar.PulseNumber, limit int,
+) ([]insolar.ID, *insolar.ID) {
+	var res []insolar.ID
+	for _, e := range m {
+		if e.Kind != kind || e.Attribute != attribute {
+			continue
+		}
+		if e.ID.Compare(from) < 0 || e.ID.Pulse() > to {
+			continue
+		}
+		res = append(res, e.ID)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Compare(res[j]) < 0
+	})
+	if len(res) > limit {
+		next := res[limit]
+		return res[:limit], &next
+	}
+	return res, nil
+}
+
+// TypeIndexDB is a DB storage of the record type index.
+type TypeIndexDB struct {
+	db store.DB
+}
+
+type typeIndexKey struct {
+	prefix []byte
+	id     insolar.ID
+}
+
+func (k typeIndexKey) Scope() store.Scope {
+	return store.ScopeTypeIndex
+}
+
+func (k typeIndexKey) ID() []byte {
+	return append(append([]byte{}, k.prefix...), k.id.Bytes()...)
+}
+
+// typeIndexPrefix returns a key prefix for a kind and attribute. Attribute is terminated with zero byte, so
+// attributes, that are prefixes of each other, don't mix.
+func typeIndexPrefix(kind RecordKind, attribute string) []byte {
+	buf := bytes.NewBuffer([]byte{byte(kind)})
+	buf.WriteString(attribute)
+	buf.WriteByte(0)
+	return buf.Bytes()
+}
+
+// NewTypeIndexDB creates new DB storage instance.
+func NewTypeIndexDB(db store.DB) *TypeIndexDB {
+	return &TypeIndexDB{db: db}
+}
+
+// Add adds provided entry to the index.
+func (i *TypeIndexDB) Add(ctx context.Context, entry TypeIndexEntry) error {
+	return i.db.Set(typeIndexKey{prefix: typeIndexPrefix(entry.Kind, entry.Attribute), id: entry.ID}, nil)
+}
+
+// ForKind returns up to limit ids of a provided kind and attribute starting from provided id (included) and
+// registered not later than provided pulse. Ids are sorted by pulse, ids of the same pulse are sorted by their
+// hashes. Returned id is the one to fetch the next chunk from, nil means there are no more ids.
+func (i *TypeIndexDB) ForKind(
+	ctx context.Context, kind RecordKind, attribute string, from insolar.ID, to insolar.PulseNumber, limit int,
+) ([]insolar.ID, *insolar.ID, error) {
+	prefix := typeIndexPrefix(kind, attribute)
+	it := i.db.NewIterator(typeIndexKey{prefix: prefix, id: from}, prefix, false)
+	defer it.Close()
+
+	var res []insolar.ID
+	for it.Next() {
+		var id insolar.ID
+		copy(id[:], it.Key()[len(prefix):])
+		if id.Pulse() > to {
+			break
+		}
+		if len(res) == limit {
+			return res, &id, nil
+		}
+		res = append(res, id)
+	}
+	return res, nil, nil
+}
@@ -0,0 +1,132 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object_test
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeIndexDB_ForKind(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	types := object.NewTypeIndexDB(store.NewMemoryMockDB())
+
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+	var transfers []insolar.ID
+	for i := 0; i < 3; i++ {
+		id := *insolar.NewID(pn+insolar.PulseNumber(i), []byte{byte(i)})
+		transfers = append(transfers, id)
+		require.NoError(t, types.Add(ctx, object.TypeIndexEntry{Kind: object.KindRequest, Attribute: "Transfer", ID: id}))
+	}
+	// Method with a common prefix is not mixed in.
+	require.NoError(t, types.Add(ctx, object.TypeIndexEntry{
+		Kind: object.KindRequest, Attribute: "TransferAll", ID: *insolar.NewID(pn+1, []byte{10}),
+	}))
+	code := *insolar.NewID(pn+1, []byte{20})
+	require.NoError(t, types.Add(ctx, object.TypeIndexEntry{Kind: object.KindCode, ID: code}))
+
+	ids, next, err := types.ForKind(ctx, object.KindRequest, "Transfer", *insolar.NewID(pn, nil), pn+2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, transfers, ids)
+	assert.Nil(t, next)
+
+	ids, next, err = types.ForKind(ctx, object.KindRequest, "Transfer", *insolar.NewID(pn+1, nil), pn+1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, transfers[1:2], ids)
+	assert.Nil(t, next)
+
+	ids, next, err = types.ForKind(ctx, object.KindRequest, "Transfer", *insolar.NewID(pn, nil), pn+2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, transfers[:2], ids)
+	require.NotNil(t, next)
+	assert.Equal(t, transfers[2], *next)
+
+	ids, next, err = types.ForKind(ctx, object.KindRequest, "Transfer", *next, pn+2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, transfers[2:], ids)
+	assert.Nil(t, next)
+
+	ids, _, err = types.ForKind(ctx, object.KindCode, "", *insolar.NewID(pn, nil), pn+2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.ID{code}, ids)
+
+	ids, _, err = types.ForKind(ctx, object.KindPrototype, "", *insolar.NewID(pn, nil), pn+2, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestRecordMemory_TypeIndex(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	records := object.NewRecordMemory()
+
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+	jetID := gen.JetID()
+	material := func(rec record.Record) record.Material {
+		virtual := record.Wrap(rec)
+		return record.Material{Virtual: &virtual, JetID: jetID}
+	}
+
+	codeID := *insolar.NewID(pn, []byte{1})
+	require.NoError(t, records.Set(ctx, codeID, material(record.Code{})))
+
+	protoHead := *insolar.NewID(pn, []byte{2})
+	protoID := *insolar.NewID(pn+1, []byte{3})
+	require.NoError(t, records.Set(ctx, protoID, material(record.Activate{
+		Request: *insolar.NewReference(insolar.DomainID, protoHead), IsPrototype: true,
+	})))
+	require.NoError(t, records.Set(ctx, *insolar.NewID(pn+1, []byte{4}), material(record.Activate{})))
+
+	reqID := *insolar.NewID(pn+1, []byte{5})
+	require.NoError(t, records.Set(ctx, reqID, material(record.Request{Method: "Transfer"})))
+
+	from := *insolar.NewID(pn, nil)
+	ids, _, err := records.ForKind(ctx, object.KindPrototype, "", from, pn+1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.ID{protoHead}, ids)
+
+	ids, _, err = records.ForKind(ctx, object.KindRequest, "Transfer", from, pn+1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.ID{reqID}, ids)
+
+
+	entries := records.TypeIndexForPulse(ctx, jetID, pn+1)
+	assert.ElementsMatch(t, []object.TypeIndexEntry{
+		{Kind: object.KindPrototype, ID: protoHead, JetID: jetID},
+		{Kind: object.KindRequest, Attribute: "Transfer", ID: reqID, JetID: jetID},
+	}, entries)
+
+	reqID2 := *insolar.NewID(pn+1, []byte{6})
+	require.NoError(t, records.Set(ctx, reqID2, material(record.Request{Method: "Transfer"})))
+	ids, next, err := records.ForKind(ctx, object.KindRequest, "Transfer", from, pn+1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.ID{reqID}, ids)
+	require.NotNil(t, next)
+	assert.Equal(t, reqID2, *next)
+
+	records.DeleteForPN(ctx, pn)
+	ids, _, err = records.ForKind(ctx, object.KindCode, "", from, pn+1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
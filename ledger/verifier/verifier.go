@@ -0,0 +1,411 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/heavy/retention"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// ProblemKind is a kind of integrity problem.
+type ProblemKind string
+
+const (
+	// ProblemMissingDrop is reported when a jet has data for a pulse, but no drop.
+	ProblemMissingDrop ProblemKind = "missing drop"
+	// ProblemBadDrop is reported when a drop can't be decoded or has wrong pulse or jet.
+	ProblemBadDrop ProblemKind = "bad drop"
+	// ProblemBrokenChain is reported when a drop's previous hash doesn't match any drop of the previous pulse.
+	ProblemBrokenChain ProblemKind = "broken drop chain"
+	// ProblemBadRecord is reported when a stored record can't be decoded.
+	ProblemBadRecord ProblemKind = "bad record"
+	// ProblemMissingRecord is reported when a registered record is not stored.
+	ProblemMissingRecord ProblemKind = "missing record"
+	// ProblemHashMismatch is reported when a record ID differs from the one calculated from its content.
+	ProblemHashMismatch ProblemKind = "hash mismatch"
+	// ProblemMissingBlob is reported when a referenced blob is not stored.
+	ProblemMissingBlob ProblemKind = "missing blob"
+	// ProblemBadBlob is reported when a stored blob can't be decoded.
+	ProblemBadBlob ProblemKind = "bad blob"
+	// ProblemMissingLifeline is reported when a registered index is not stored.
+	ProblemMissingLifeline ProblemKind = "missing lifeline"
+	// ProblemMissingState is reported when a lifeline points to a state, that is not stored. States of lifelines
+	// before the retention horizon are not checked, because superseded states are removed by retention.
+	ProblemMissingState ProblemKind = "missing state"
+	// ProblemMissingRequest is reported when a lifeline points to a request, that is not stored.
+	ProblemMissingRequest ProblemKind = "missing request"
+	// ProblemMissingChild is reported when a lifeline points to a child, that is not stored.
+	ProblemMissingChild ProblemKind = "missing child"
+)
+
+// Problem describes a broken link found by verifier.
+type Problem struct {
+	PulseNumber insolar.PulseNumber
+	Kind        ProblemKind
+	// ID is an id of a record, blob or object, the problem is found for.
+	ID      insolar.ID
+	Details string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("pulse %v: %s %s: %s", p.PulseNumber, p.Kind, p.ID.DebugString(), p.Details)
+}
+
+// Report is a result of verification.
+type Report struct {
+	Pulses   int
+	Drops    int
+	Records  int
+	Blobs    int
+	Indexes  int
+	Problems []Problem
+}
+
+// OK returns true if no problems were found.
+func (r *Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *Report) add(pn insolar.PulseNumber, kind ProblemKind, id insolar.ID, details string) {
+	r.Problems = append(r.Problems, Problem{PulseNumber: pn, Kind: kind, ID: id, Details: details})
+}
+
+// Verifier checks integrity of stored data.
+type Verifier struct {
+	pcs        insolar.PlatformCryptographyScheme
+	db         store.DB
+	pulses     pulse.Accessor
+	calculator pulse.Calculator
+	catalog    exporter.CatalogAccessor
+	records    object.RecordAccessor
+	blobs      blob.Accessor
+	indexes    object.LifelineAccessor
+	horizon    retention.HorizonAccessor
+}
+
+// NewVerifier creates new verifier instance. Drops, records and blobs are read from db directly, so data missing
+// from the export catalog is verified too.
+func NewVerifier(
+	pcs insolar.PlatformCryptographyScheme,
+	db store.DB,
+	pulses pulse.Accessor,
+	calculator pulse.Calculator,
+	catalog exporter.CatalogAccessor,
+	records object.RecordAccessor,
+	blobs blob.Accessor,
+	indexes object.LifelineAccessor,
+	horizon retention.HorizonAccessor,
+) *Verifier {
+	return &Verifier{
+		pcs:        pcs,
+		db:         db,
+		pulses:     pulses,
+		calculator: calculator,
+		catalog:    catalog,
+		records:    records,
+		blobs:      blobs,
+		indexes:    indexes,
+		horizon:    horizon,
+	}
+}
+
+// Verify walks stored pulses from the latest one backwards and checks drops and their hash chain, record hashes,
+// blob references and lifeline links of every pulse. Broken links are collected to the report, an error is returned
+// only when the walk itself fails.
+func (v *Verifier) Verify(ctx context.Context) (*Report, error) {
+	report := &Report{}
+	drops := v.storedDrops(report)
+
+	horizon, err := v.horizon.Horizon(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch retention horizon")
+	}
+
+	current, err := v.pulses.Latest(ctx)
+	if err == pulse.ErrNotFound {
+		return report, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch latest pulse")
+	}
+
+	for {
+		err = v.verifyPulse(ctx, current.PulseNumber, horizon, drops[current.PulseNumber], report)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to verify pulse %v", current.PulseNumber)
+		}
+		report.Pulses++
+
+		prev, err := v.calculator.Backwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate previous pulse")
+		}
+		verifyChain(current.PulseNumber, drops[current.PulseNumber], drops[prev.PulseNumber], report)
+		current = prev
+	}
+
+	inslogger.FromContext(ctx).Infof(
+		"[ Verifier.Verify ] verified %d pulses, found %d problems", report.Pulses, len(report.Problems),
+	)
+	return report, nil
+}
+
+// scopeKey is a pivot for iterating over keys of a scope, which start with prefix.
+type scopeKey struct {
+	scope  store.Scope
+	prefix []byte
+}
+
+func (k scopeKey) Scope() store.Scope {
+	return k.scope
+}
+
+func (k scopeKey) ID() []byte {
+	return k.prefix
+}
+
+// storedDrops reads all stored drops and groups them by pulse. Drop keys start with a jet prefix, so the whole scope
+// is walked once instead of per pulse.
+func (v *Verifier) storedDrops(report *Report) map[insolar.PulseNumber][]drop.Drop {
+	res := map[insolar.PulseNumber][]drop.Drop{}
+	it := v.db.NewIterator(scopeKey{scope: store.ScopeJetDrop}, nil, false)
+	defer it.Close()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) < insolar.PulseNumberSize {
+			continue
+		}
+		pn := insolar.NewPulseNumber(key[len(key)-insolar.PulseNumberSize:])
+		report.Drops++
+
+		buf, err := it.Value()
+		if err != nil {
+			report.add(pn, ProblemBadDrop, insolar.ID{}, err.Error())
+			continue
+		}
+		d, err := drop.Decode(buf)
+		if err != nil {
+			report.add(pn, ProblemBadDrop, insolar.ID{}, errors.Wrap(err, "failed to decode drop").Error())
+			continue
+		}
+		if d.Pulse != pn || !bytes.Equal(d.JetID.Prefix(), key[:len(key)-insolar.PulseNumberSize]) {
+			report.add(
+				pn, ProblemBadDrop, insolar.ID(d.JetID),
+				fmt.Sprintf("drop has pulse %v and jet %v", d.Pulse, d.JetID.DebugString()),
+			)
+			continue
+		}
+		res[pn] = append(res[pn], *d)
+	}
+	return res
+}
+
+// verifyChain checks, that every drop with previous hash has a matching drop in the previous pulse.
+func verifyChain(pn insolar.PulseNumber, drops, prevDrops []drop.Drop, report *Report) {
+	for _, d := range drops {
+		if len(d.PrevHash) == 0 {
+			continue
+		}
+		found := false
+		for _, prev := range prevDrops {
+			if bytes.Equal(prev.Hash, d.PrevHash) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report.add(pn, ProblemBrokenChain, insolar.ID(d.JetID), "previous drop is not found")
+		}
+	}
+}
+
+func (v *Verifier) verifyPulse(
+	ctx context.Context, pn, horizon insolar.PulseNumber, drops []drop.Drop, report *Report,
+) error {
+	jets := map[insolar.JetID]struct{}{}
+	records := map[insolar.ID]struct{}{}
+	blobs := map[insolar.ID]struct{}{}
+
+	it := v.db.NewIterator(scopeKey{scope: store.ScopeRecord, prefix: pn.Bytes()}, pn.Bytes(), false)
+	for it.Next() {
+		var id insolar.ID
+		copy(id[:], it.Key())
+		records[id] = struct{}{}
+		if jetID, ok := v.verifyRecord(ctx, pn, id, it, report); ok {
+			jets[jetID] = struct{}{}
+		}
+	}
+	it.Close()
+
+	it = v.db.NewIterator(scopeKey{scope: store.ScopeBlob, prefix: pn.Bytes()}, pn.Bytes(), false)
+	for it.Next() {
+		var id insolar.ID
+		copy(id[:], it.Key())
+		blobs[id] = struct{}{}
+		report.Blobs++
+		buf, err := it.Value()
+		if err == nil {
+			_, err = blob.Decode(buf)
+		}
+		if err != nil {
+			report.add(pn, ProblemBadBlob, id, err.Error())
+		}
+	}
+	it.Close()
+
+	// Catalog is cross-checked with stored data to find entries, that are registered but lost.
+	entry, err := v.catalog.ForPulse(ctx, pn)
+	if err != nil && err != exporter.ErrNotFound {
+		return errors.Wrap(err, "failed to fetch catalog")
+	}
+	for _, jetID := range entry.Jets {
+		jets[jetID] = struct{}{}
+	}
+	for _, id := range entry.Records {
+		if _, ok := records[id]; !ok {
+			report.add(pn, ProblemMissingRecord, id, "record is registered in catalog, but not stored")
+		}
+	}
+	for _, id := range entry.Blobs {
+		if _, ok := blobs[id]; !ok {
+			report.add(pn, ProblemMissingBlob, id, "blob is registered in catalog, but not stored")
+		}
+	}
+	for _, objID := range entry.Indexes {
+		v.verifyLifeline(ctx, pn, horizon, objID, report)
+	}
+
+	for jetID := range jets {
+		if !hasDrop(drops, jetID) {
+			report.add(pn, ProblemMissingDrop, insolar.ID(jetID), "jet has data, but no drop")
+		}
+	}
+	return nil
+}
+
+func hasDrop(drops []drop.Drop, jetID insolar.JetID) bool {
+	for _, d := range drops {
+		if d.JetID == jetID {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyRecord checks the record under the iterator and returns its jet if the record is decoded.
+func (v *Verifier) verifyRecord(
+	ctx context.Context, pn insolar.PulseNumber, id insolar.ID, it store.Iterator, report *Report,
+) (insolar.JetID, bool) {
+	report.Records++
+	buf, err := it.Value()
+	if err != nil {
+		report.add(pn, ProblemBadRecord, id, err.Error())
+		return insolar.JetID{}, false
+	}
+	rec := record.Material{}
+	err = rec.Unmarshal(buf)
+	if err != nil {
+		report.add(pn, ProblemBadRecord, id, errors.Wrap(err, "failed to decode record").Error())
+		return insolar.JetID{}, false
+	}
+	if rec.Virtual == nil {
+		report.add(pn, ProblemHashMismatch, id, "record has no virtual part")
+		return rec.JetID, true
+	}
+
+	calculated := insolar.NewID(id.Pulse(), record.HashVirtual(v.pcs.ReferenceHasher(), *rec.Virtual))
+	if *calculated != id {
+		report.add(pn, ProblemHashMismatch, id, fmt.Sprintf("calculated id is %v", calculated.DebugString()))
+	}
+
+	switch r := record.Unwrap(rec.Virtual).(type) {
+	case *record.Activate:
+		v.checkMemory(ctx, pn, r.Memory, report)
+	case *record.Amend:
+		v.checkMemory(ctx, pn, r.Memory, report)
+	case *record.Code:
+		v.checkMemory(ctx, pn, r.Code, report)
+	}
+	return rec.JetID, true
+}
+
+func (v *Verifier) checkMemory(ctx context.Context, pn insolar.PulseNumber, id insolar.ID, report *Report) {
+	if id.IsEmpty() {
+		return
+	}
+	v.checkBlob(ctx, pn, id, report)
+}
+
+func (v *Verifier) checkBlob(ctx context.Context, pn insolar.PulseNumber, id insolar.ID, report *Report) {
+	_, err := v.blobs.ForID(ctx, id)
+	if err != nil {
+		report.add(pn, ProblemMissingBlob, id, err.Error())
+	}
+}
+
+func (v *Verifier) verifyLifeline(
+	ctx context.Context, pn, horizon insolar.PulseNumber, objID insolar.ID, report *Report,
+) {
+	report.Indexes++
+	lifeline, err := v.indexes.ForID(ctx, pn, objID)
+	if err != nil {
+		report.add(pn, ProblemMissingLifeline, objID, err.Error())
+		return
+	}
+	// States of the lifeline are superseded in later pulses, so they could be removed by retention.
+	pruned := pn < horizon
+
+	links := []struct {
+		id   *insolar.ID
+		kind ProblemKind
+	}{
+		{id: lifeline.LatestState, kind: ProblemMissingState},
+		{id: lifeline.LatestStateApproved, kind: ProblemMissingState},
+		{id: lifeline.LatestRequest, kind: ProblemMissingRequest},
+		{id: lifeline.ChildPointer, kind: ProblemMissingChild},
+	}
+	for _, link := range links {
+		if link.id == nil || link.id.IsEmpty() {
+			continue
+		}
+		_, err := v.records.ForID(ctx, *link.id)
+		if err == object.ErrNotFound && pruned && link.kind == ProblemMissingState {
+			continue
+		}
+		if err != nil {
+			report.add(pn, link.kind, objID, fmt.Sprintf("%v: %s", link.id.DebugString(), err))
+		}
+	}
+}
@@ -0,0 +1,197 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package verifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/heavy/retention"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	db := store.NewMemoryMockDB()
+	pulses := pulse.NewDB(db)
+	catalog := exporter.NewCatalogDB(db)
+	drops := drop.NewDB(db)
+	records := object.NewRecordDB(db)
+	blobs := blob.NewDB(db)
+	indexes := object.NewIndexDB(db)
+
+	first := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}
+	second := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 20}
+	require.NoError(t, pulses.Append(ctx, first))
+	require.NoError(t, pulses.Append(ctx, second))
+
+	jetID := gen.JetID()
+	codeID := *insolar.NewID(first.PulseNumber, []byte{1})
+	require.NoError(t, blobs.Set(ctx, codeID, blob.Blob{Value: []byte{1}, JetID: jetID}))
+	virtual := record.Wrap(record.Code{Code: codeID})
+	recID := *insolar.NewID(first.PulseNumber, record.HashVirtual(pcs.ReferenceHasher(), virtual))
+	require.NoError(t, records.Set(ctx, recID, record.Material{Virtual: &virtual, JetID: jetID}))
+	require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: first.PulseNumber, JetID: jetID}))
+	objID := gen.ID()
+	require.NoError(t, indexes.Set(ctx, first.PulseNumber, objID, object.Lifeline{LatestState: &recID}))
+	require.NoError(t, catalog.Add(ctx, first.PulseNumber, exporter.Entry{
+		Jets:    []insolar.JetID{jetID},
+		Records: []insolar.ID{recID},
+		Blobs:   []insolar.ID{codeID},
+		Indexes: []insolar.ID{objID},
+	}))
+
+	verifier := NewVerifier(pcs, db, pulses, pulses, catalog, records, blobs, indexes, retention.NewHorizonDB(db))
+
+	t.Run("consistent data", func(t *testing.T) {
+		report, err := verifier.Verify(ctx)
+		require.NoError(t, err)
+		assert.True(t, report.OK(), "%v", report.Problems)
+		assert.Equal(t, 2, report.Pulses)
+		assert.Equal(t, 1, report.Records)
+		assert.Equal(t, 1, report.Indexes)
+	})
+
+	t.Run("broken links", func(t *testing.T) {
+		otherJet := gen.JetID()
+		badVirtual := record.Wrap(record.Activate{Memory: gen.ID()})
+		badID := *insolar.NewID(second.PulseNumber, []byte{2})
+		require.NoError(t, records.Set(ctx, badID, record.Material{Virtual: &badVirtual, JetID: otherJet}))
+		missingState := gen.ID()
+		brokenObj := gen.ID()
+		lifeline := object.Lifeline{LatestState: &missingState}
+		require.NoError(t, indexes.Set(ctx, second.PulseNumber, brokenObj, lifeline))
+		require.NoError(t, catalog.Add(ctx, second.PulseNumber, exporter.Entry{
+			Jets:    []insolar.JetID{otherJet},
+			Records: []insolar.ID{badID},
+			Indexes: []insolar.ID{brokenObj},
+		}))
+
+		report, err := verifier.Verify(ctx)
+		require.NoError(t, err)
+
+		var kinds []ProblemKind
+		for _, p := range report.Problems {
+			assert.Equal(t, second.PulseNumber, p.PulseNumber)
+			kinds = append(kinds, p.Kind)
+		}
+		assert.ElementsMatch(t, []ProblemKind{
+			ProblemMissingDrop,
+			ProblemHashMismatch,
+			ProblemMissingBlob,
+			ProblemMissingState,
+		}, kinds)
+	})
+}
+
+func TestVerifier_Verify_NotInCatalog(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	db := store.NewMemoryMockDB()
+	pulses := pulse.NewDB(db)
+	drops := drop.NewDB(db)
+	records := object.NewRecordDB(db)
+
+	first := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}
+	second := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 20}
+	require.NoError(t, pulses.Append(ctx, first))
+	require.NoError(t, pulses.Append(ctx, second))
+
+	// Nothing is registered in catalog, so problems can only be found by walking the storage.
+	jetID := gen.JetID()
+	virtual := record.Wrap(record.Request{Method: "Call"})
+	corruptedID := *insolar.NewID(first.PulseNumber, []byte{3})
+	require.NoError(t, records.Set(ctx, corruptedID, record.Material{Virtual: &virtual, JetID: jetID}))
+	require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: first.PulseNumber, JetID: jetID, Hash: []byte{1}}))
+	require.NoError(t, drops.Set(ctx, drop.Drop{
+		Pulse: second.PulseNumber, JetID: jetID, Hash: []byte{2}, PrevHash: []byte{42},
+	}))
+
+	verifier := NewVerifier(
+		pcs, db, pulses, pulses, exporter.NewCatalogDB(db), records, blob.NewDB(db), object.NewIndexDB(db),
+		retention.NewHorizonDB(db),
+	)
+	report, err := verifier.Verify(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.Drops)
+	assert.Equal(t, 1, report.Records)
+	require.Len(t, report.Problems, 2)
+	assert.Equal(t, Problem{
+		PulseNumber: second.PulseNumber,
+		Kind:        ProblemBrokenChain,
+		ID:          insolar.ID(jetID),
+		Details:     "previous drop is not found",
+	}, report.Problems[0])
+	assert.Equal(t, first.PulseNumber, report.Problems[1].PulseNumber)
+	assert.Equal(t, ProblemHashMismatch, report.Problems[1].Kind)
+	assert.Equal(t, corruptedID, report.Problems[1].ID)
+}
+
+func TestVerifier_Verify_PrunedStates(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	db := store.NewMemoryMockDB()
+	pulses := pulse.NewDB(db)
+	catalog := exporter.NewCatalogDB(db)
+	indexes := object.NewIndexDB(db)
+	horizon := retention.NewHorizonDB(db)
+
+	first := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}
+	second := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 20}
+	require.NoError(t, pulses.Append(ctx, first))
+	require.NoError(t, pulses.Append(ctx, second))
+
+	// State was superseded in the second pulse and removed by retention.
+	prunedState := *insolar.NewID(first.PulseNumber, []byte{1})
+	objID := gen.ID()
+	require.NoError(t, indexes.Set(ctx, first.PulseNumber, objID, object.Lifeline{LatestState: &prunedState}))
+	require.NoError(t, catalog.Add(ctx, first.PulseNumber, exporter.Entry{Indexes: []insolar.ID{objID}}))
+
+	verifier := NewVerifier(
+		pcs, db, pulses, pulses, catalog, object.NewRecordDB(db), blob.NewDB(db), indexes, horizon,
+	)
+
+	report, err := verifier.Verify(ctx)
+	require.NoError(t, err)
+	require.Len(t, report.Problems, 1)
+	assert.Equal(t, ProblemMissingState, report.Problems[0].Kind)
+
+	require.NoError(t, horizon.SetHorizon(ctx, second.PulseNumber))
+	report, err = verifier.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.OK(), "%v", report.Problems)
+}
@@ -98,7 +98,7 @@ func (lr *LogicRunner) GetConsensus(ctx context.Context, ref Ref) *Consensus {
 			panic("cannot QueryRole")
 		}
 		// TODO INS-732 check pulse of message and ensure we deal with right validator
-		state.Consensus = newConsensus(lr, validators)
+		state.Consensus = newConsensus(lr, ref, validators)
 	}
 	return state.Consensus
 }
@@ -112,13 +112,40 @@ func (st *ObjectState) RefreshConsensus() {
 	st.Consensus = nil
 }
 
-func (st *ObjectState) StartValidation(ref Ref) *ExecutionState {
+func (st *ObjectState) StartValidation(ref Ref) (*ExecutionState, error) {
 	st.Lock()
 	defer st.Unlock()
 
 	if st.Validation != nil {
-		panic("Unexpected. Validation already in progress")
+		return nil, errors.New("validation already in progress")
 	}
 	st.Validation = &ExecutionState{Ref: ref}
-	return st.Validation
+	return st.Validation, nil
+}
+
+func (st *ObjectState) FinishValidation() {
+	st.Lock()
+	defer st.Unlock()
+
+	st.Validation = nil
+}
+
+// StartDryRun creates state for dry-run call of the request, it's removed with FinishDryRun
+func (st *ObjectState) StartDryRun(ref Ref, request Ref, overlay *dryRunOverlay) *ExecutionState {
+	st.Lock()
+	defer st.Unlock()
+
+	if st.DryRun == nil {
+		st.DryRun = make(map[Ref]*ExecutionState)
+	}
+	ds := &ExecutionState{Ref: ref, dryRun: true, overlay: overlay}
+	st.DryRun[request] = ds
+	return ds
+}
+
+func (st *ObjectState) FinishDryRun(request Ref) {
+	st.Lock()
+	defer st.Unlock()
+
+	delete(st.DryRun, request)
 }
@@ -38,6 +38,11 @@ type Client interface {
 	// RegisterResult saves VM method call result.
 	RegisterResult(ctx context.Context, object, request insolar.Reference, payload []byte) (*insolar.ID, error)
 
+	// RegisterResultWithEvents saves VM method call result with events emitted during the call.
+	RegisterResultWithEvents(
+		ctx context.Context, object, request insolar.Reference, payload []byte, events []insolar.ContractEvent,
+	) (*insolar.ID, error)
+
 	// GetCode returns code from code record by provided reference according to provided machine preference.
 	//
 	// This method is used by VM to fetch code for execution.
@@ -66,6 +71,28 @@ type Client interface {
 	// During iteration children refs will be fetched from remote source (parent object).
 	GetChildren(ctx context.Context, parent insolar.Reference, pulse *insolar.PulseNumber) (RefIterator, error)
 
+	// GetObjectHistory returns a chunk of object states ordered from newer to older.
+	//
+	// If provided state is nil, the chunk will start from the latest state. Returned id should be used to fetch the
+	// next chunk, nil means there are no more states.
+	GetObjectHistory(ctx context.Context, head insolar.Reference, from *insolar.ID, amount int) ([]ObjectState, *insolar.ID, error)
+
+	// GetObjectRequests returns a chunk of object requests with their results ordered from newer to older.
+	//
+	// If provided request is nil, the chunk will start from the latest request. Returned id should be used to fetch the
+	// next chunk, nil means there are no more requests. Requests are ordered by pulse, requests of the same pulse are
+	// ordered by their hashes. Requests registered on the current light executor are merged with the ones replicated
+	// to heavy.
+	GetObjectRequests(ctx context.Context, obj insolar.Reference, from *insolar.ID, amount int) ([]RequestResult, *insolar.ID, error)
+
+	// GetObjectEvents returns a chunk of events emitted by object ordered from newer to older requests.
+	//
+	// If provided request is nil, the chunk will start from the latest request. Returned id should be used to fetch the
+	// next chunk, nil means there are no more events. Events are available after their pulse is replicated to heavy.
+	GetObjectEvents(
+		ctx context.Context, obj insolar.Reference, filter EventsFilter, from *insolar.ID, amount int,
+	) ([]ObjectEvent, *insolar.ID, error)
+
 	// DeclareType creates new type record in storage.
 	//
 	// Type is a contract interface. It contains one method signature.
@@ -178,3 +205,50 @@ type RefIterator interface {
 	Next() (*insolar.Reference, error)
 	HasNext() bool
 }
+
+// ObjectState is a single object state from the object history.
+type ObjectState struct {
+	// ID is the state record id. Its pulse is the pulse the state was registered in.
+	ID insolar.ID
+	// State is the kind of the state record.
+	State record.StateID
+	// Prototype is the object prototype in this state.
+	Prototype *insolar.Reference
+	// Memory is the object memory in this state.
+	Memory []byte
+}
+
+// RequestResult is an object request with its result from the object requests history.
+type RequestResult struct {
+	// RequestID is the request record id. Its pulse is the pulse the request was registered in.
+	RequestID insolar.ID
+	// Request is the request record.
+	Request record.Request
+	// ResultID is the result record id. It's nil if the request is not processed yet.
+	ResultID *insolar.ID
+	// Result is the result record.
+	Result *record.Result
+}
+
+// EventsFilter selects events of an object.
+type EventsFilter struct {
+	// Method selects events emitted during calls of the method, all events are selected if it's empty.
+	Method string
+	// FromPulse and ToPulse select events of requests registered in the pulse range, zero value means no bound.
+	FromPulse insolar.PulseNumber
+	ToPulse   insolar.PulseNumber
+}
+
+// ObjectEvent is an event emitted by an object during processing of a request.
+type ObjectEvent struct {
+	// RequestID is the request record id. Its pulse is the pulse the request was registered in.
+	RequestID insolar.ID
+	// ResultID is the result record id the event is saved with.
+	ResultID insolar.ID
+	// Method is the called method or constructor.
+	Method string
+	// Name is the name of the event.
+	Name string
+	// Payload is the serialized payload of the event.
+	Payload []byte
+}
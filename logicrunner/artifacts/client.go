@@ -397,6 +397,222 @@ func (m *client) GetChildren(
 	return iter, err
 }
 
+// GetObjectHistory returns a chunk of object states ordered from newer to older.
+//
+// If provided state is nil, the chunk will start from the latest state. Returned id should be used to fetch the next
+// chunk, nil means there are no more states.
+func (m *client) GetObjectHistory(
+	ctx context.Context, head insolar.Reference, from *insolar.ID, amount int,
+) ([]ObjectState, *insolar.ID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetObjectHistory")
+	instrumenter := instrument(ctx, "GetObjectHistory").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	sender := messagebus.BuildSender(
+		m.DefaultBus.Send,
+		messagebus.RetryIncorrectPulse(m.PulseAccessor),
+		messagebus.FollowRedirectSender(m.DefaultBus),
+		messagebus.RetryJetSender(m.JetStorage),
+	)
+
+	genericReply, err := sender(ctx, &message.GetObjectHistory{
+		Head:      head,
+		FromState: from,
+		Amount:    amount,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch r := genericReply.(type) {
+	case *reply.ObjectHistory:
+		states := make([]ObjectState, 0, len(r.States))
+		for _, s := range r.States {
+			rec := record.Virtual{}
+			err = rec.Unmarshal(s.Record)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "GetObjectHistory: can't deserialize record")
+			}
+			state, ok := record.Unwrap(&rec).(record.State)
+			if !ok {
+				err = fmt.Errorf("GetObjectHistory: unexpected record: %#v", rec)
+				return nil, nil, err
+			}
+			states = append(states, ObjectState{
+				ID:        s.ID,
+				State:     state.ID(),
+				Prototype: state.GetImage(),
+				Memory:    s.Memory,
+			})
+		}
+		return states, r.NextFrom, nil
+	case *reply.Error:
+		err = r.Error()
+		return nil, nil, err
+	default:
+		err = fmt.Errorf("GetObjectHistory: unexpected reply: %#v", genericReply)
+		return nil, nil, err
+	}
+}
+
+// GetObjectRequests returns a chunk of object requests with their results ordered from newer to older.
+//
+// If provided request is nil, the chunk will start from the latest request. Returned id should be used to fetch the
+// next chunk, nil means there are no more requests. Requests are ordered by pulse, requests of the same pulse are
+// ordered by their hashes. Requests registered on the current light executor are merged with the ones replicated
+// to heavy.
+func (m *client) GetObjectRequests(
+	ctx context.Context, obj insolar.Reference, from *insolar.ID, amount int,
+) ([]RequestResult, *insolar.ID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetObjectRequests")
+	instrumenter := instrument(ctx, "GetObjectRequests").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	sender := messagebus.BuildSender(
+		m.DefaultBus.Send,
+		messagebus.RetryIncorrectPulse(m.PulseAccessor),
+		messagebus.FollowRedirectSender(m.DefaultBus),
+		messagebus.RetryJetSender(m.JetStorage),
+	)
+
+	genericReply, err := sender(ctx, &message.GetObjectRequests{
+		Object:      obj,
+		FromRequest: from,
+		Amount:      amount,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch r := genericReply.(type) {
+	case *reply.ObjectRequests:
+		res := make([]RequestResult, 0, len(r.Requests))
+		for _, rr := range r.Requests {
+			var request *record.Request
+			request, err = unwrapRequest(rr.Request)
+			if err != nil {
+				return nil, nil, err
+			}
+			entry := RequestResult{
+				RequestID: rr.RequestID,
+				Request:   *request,
+				ResultID:  rr.ResultID,
+			}
+			if rr.ResultID != nil {
+				entry.Result, err = unwrapResult(rr.Result)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			res = append(res, entry)
+		}
+		return res, r.NextFrom, nil
+	case *reply.Error:
+		err = r.Error()
+		return nil, nil, err
+	default:
+		err = fmt.Errorf("GetObjectRequests: unexpected reply: %#v", genericReply)
+		return nil, nil, err
+	}
+}
+
+// GetObjectEvents returns a chunk of events emitted by object ordered from newer to older requests.
+//
+// If provided request is nil, the chunk will start from the latest request. Returned id should be used to fetch the
+// next chunk, nil means there are no more events. Events are available after their pulse is replicated to heavy.
+func (m *client) GetObjectEvents(
+	ctx context.Context, obj insolar.Reference, filter EventsFilter, from *insolar.ID, amount int,
+) ([]ObjectEvent, *insolar.ID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetObjectEvents")
+	instrumenter := instrument(ctx, "GetObjectEvents").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	sender := messagebus.BuildSender(
+		m.DefaultBus.Send,
+		messagebus.RetryIncorrectPulse(m.PulseAccessor),
+	)
+
+	genericReply, err := sender(ctx, &message.GetObjectEvents{
+		Object:      obj,
+		Method:      filter.Method,
+		FromPulse:   filter.FromPulse,
+		ToPulse:     filter.ToPulse,
+		FromRequest: from,
+		Amount:      amount,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch r := genericReply.(type) {
+	case *reply.ObjectEvents:
+		res := make([]ObjectEvent, 0, len(r.Events))
+		for _, e := range r.Events {
+			res = append(res, ObjectEvent{
+				RequestID: e.RequestID,
+				ResultID:  e.ResultID,
+				Method:    e.Method,
+				Name:      e.Name,
+				Payload:   e.Payload,
+			})
+		}
+		return res, r.NextFrom, nil
+	case *reply.Error:
+		err = r.Error()
+		return nil, nil, err
+	default:
+		err = fmt.Errorf("GetObjectEvents: unexpected reply: %#v", genericReply)
+		return nil, nil, err
+	}
+}
+
+func unwrapRequest(buf []byte) (*record.Request, error) {
+	rec := record.Virtual{}
+	err := rec.Unmarshal(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetObjectRequests: can't deserialize request")
+	}
+	request, ok := record.Unwrap(&rec).(*record.Request)
+	if !ok {
+		return nil, fmt.Errorf("GetObjectRequests: unexpected request record: %#v", rec)
+	}
+	return request, nil
+}
+
+func unwrapResult(buf []byte) (*record.Result, error) {
+	rec := record.Virtual{}
+	err := rec.Unmarshal(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetObjectRequests: can't deserialize result")
+	}
+	result, ok := record.Unwrap(&rec).(*record.Result)
+	if !ok {
+		return nil, fmt.Errorf("GetObjectRequests: unexpected result record: %#v", rec)
+	}
+	return result, nil
+}
+
 // DeclareType creates new type record in storage.
 //
 // Type is a contract interface. It contains one method signature.
@@ -693,6 +909,42 @@ func (m *client) RegisterResult(
 	return recid, err
 }
 
+// RegisterResultWithEvents saves VM method call result with events emitted during the call.
+func (m *client) RegisterResultWithEvents(
+	ctx context.Context, obj, request insolar.Reference, payload []byte, events []insolar.ContractEvent,
+) (*insolar.ID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.RegisterResultWithEvents")
+	instrumenter := instrument(ctx, "RegisterResultWithEvents").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	serializedEvents, err := insolar.Serialize(events)
+	if err != nil {
+		return nil, errors.Wrap(err, "RegisterResultWithEvents: can't serialize events")
+	}
+
+	res := record.Result{
+		Object:  *obj.Record(),
+		Request: request,
+		Payload: payload,
+		Events:  serializedEvents,
+	}
+	virtRec := record.Wrap(res)
+
+	recid, err := m.setRecord(
+		ctx,
+		virtRec,
+		obj,
+	)
+	return recid, err
+}
+
 // pulse returns current PulseNumber for artifact manager
 func (m *client) pulse(ctx context.Context) (pn insolar.PulseNumber, err error) {
 	pulse, err := m.PulseAccessor.Latest(ctx)
@@ -66,6 +66,21 @@ type ClientMock struct {
 	GetObjectPreCounter uint64
 	GetObjectMock       mClientMockGetObject
 
+	GetObjectEventsFunc       func(p context.Context, p1 insolar.Reference, p2 EventsFilter, p3 *insolar.ID, p4 int) (r []ObjectEvent, r1 *insolar.ID, r2 error)
+	GetObjectEventsCounter    uint64
+	GetObjectEventsPreCounter uint64
+	GetObjectEventsMock       mClientMockGetObjectEvents
+
+	GetObjectHistoryFunc       func(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []ObjectState, r1 *insolar.ID, r2 error)
+	GetObjectHistoryCounter    uint64
+	GetObjectHistoryPreCounter uint64
+	GetObjectHistoryMock       mClientMockGetObjectHistory
+
+	GetObjectRequestsFunc       func(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []RequestResult, r1 *insolar.ID, r2 error)
+	GetObjectRequestsCounter    uint64
+	GetObjectRequestsPreCounter uint64
+	GetObjectRequestsMock       mClientMockGetObjectRequests
+
 	GetPendingRequestFunc       func(p context.Context, p1 insolar.ID) (r insolar.Parcel, r1 error)
 	GetPendingRequestCounter    uint64
 	GetPendingRequestPreCounter uint64
@@ -86,6 +101,11 @@ type ClientMock struct {
 	RegisterResultPreCounter uint64
 	RegisterResultMock       mClientMockRegisterResult
 
+	RegisterResultWithEventsFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) (r *insolar.ID, r1 error)
+	RegisterResultWithEventsCounter    uint64
+	RegisterResultWithEventsPreCounter uint64
+	RegisterResultWithEventsMock       mClientMockRegisterResultWithEvents
+
 	RegisterValidationFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.ID, p3 bool, p4 []insolar.Message) (r error)
 	RegisterValidationCounter    uint64
 	RegisterValidationPreCounter uint64
@@ -124,10 +144,14 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetCodeMock = mClientMockGetCode{mock: m}
 	m.GetDelegateMock = mClientMockGetDelegate{mock: m}
 	m.GetObjectMock = mClientMockGetObject{mock: m}
+	m.GetObjectEventsMock = mClientMockGetObjectEvents{mock: m}
+	m.GetObjectHistoryMock = mClientMockGetObjectHistory{mock: m}
+	m.GetObjectRequestsMock = mClientMockGetObjectRequests{mock: m}
 	m.GetPendingRequestMock = mClientMockGetPendingRequest{mock: m}
 	m.HasPendingRequestsMock = mClientMockHasPendingRequests{mock: m}
 	m.RegisterRequestMock = mClientMockRegisterRequest{mock: m}
 	m.RegisterResultMock = mClientMockRegisterResult{mock: m}
+	m.RegisterResultWithEventsMock = mClientMockRegisterResultWithEvents{mock: m}
 	m.RegisterValidationMock = mClientMockRegisterValidation{mock: m}
 	m.StateMock = mClientMockState{mock: m}
 	m.UpdateObjectMock = mClientMockUpdateObject{mock: m}
@@ -1513,6 +1537,475 @@ func (m *ClientMock) GetObjectFinished() bool {
 	return true
 }
 
+type mClientMockGetObjectEvents struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetObjectEventsExpectation
+	expectationSeries []*ClientMockGetObjectEventsExpectation
+}
+
+type ClientMockGetObjectEventsExpectation struct {
+	input  *ClientMockGetObjectEventsInput
+	result *ClientMockGetObjectEventsResult
+}
+
+type ClientMockGetObjectEventsInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 EventsFilter
+	p3 *insolar.ID
+	p4 int
+}
+
+type ClientMockGetObjectEventsResult struct {
+	r  []ObjectEvent
+	r1 *insolar.ID
+	r2 error
+}
+
+//Expect specifies that invocation of Client.GetObjectEvents is expected from 1 to Infinity times
+func (m *mClientMockGetObjectEvents) Expect(p context.Context, p1 insolar.Reference, p2 EventsFilter, p3 *insolar.ID, p4 int) *mClientMockGetObjectEvents {
+	m.mock.GetObjectEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectEventsExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetObjectEventsInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetObjectEvents
+func (m *mClientMockGetObjectEvents) Return(r []ObjectEvent, r1 *insolar.ID, r2 error) *ClientMock {
+	m.mock.GetObjectEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectEventsExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetObjectEventsResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetObjectEvents is expected once
+func (m *mClientMockGetObjectEvents) ExpectOnce(p context.Context, p1 insolar.Reference, p2 EventsFilter, p3 *insolar.ID, p4 int) *ClientMockGetObjectEventsExpectation {
+	m.mock.GetObjectEventsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetObjectEventsExpectation{}
+	expectation.input = &ClientMockGetObjectEventsInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetObjectEventsExpectation) Return(r []ObjectEvent, r1 *insolar.ID, r2 error) {
+	e.result = &ClientMockGetObjectEventsResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of Client.GetObjectEvents method
+func (m *mClientMockGetObjectEvents) Set(f func(p context.Context, p1 insolar.Reference, p2 EventsFilter, p3 *insolar.ID, p4 int) (r []ObjectEvent, r1 *insolar.ID, r2 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectEventsFunc = f
+	return m.mock
+}
+
+//GetObjectEvents implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetObjectEvents(p context.Context, p1 insolar.Reference, p2 EventsFilter, p3 *insolar.ID, p4 int) (r []ObjectEvent, r1 *insolar.ID, r2 error) {
+	counter := atomic.AddUint64(&m.GetObjectEventsPreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectEventsCounter, 1)
+
+	if len(m.GetObjectEventsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectEventsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetObjectEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.GetObjectEventsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetObjectEventsInput{p, p1, p2, p3, p4}, "Client.GetObjectEvents got unexpected parameters")
+
+		result := m.GetObjectEventsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectEvents")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetObjectEventsMock.mainExpectation != nil {
+
+		input := m.GetObjectEventsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetObjectEventsInput{p, p1, p2, p3, p4}, "Client.GetObjectEvents got unexpected parameters")
+		}
+
+		result := m.GetObjectEventsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectEvents")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetObjectEventsFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetObjectEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.GetObjectEventsFunc(p, p1, p2, p3, p4)
+}
+
+//GetObjectEventsMinimockCounter returns a count of ClientMock.GetObjectEventsFunc invocations
+func (m *ClientMock) GetObjectEventsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectEventsCounter)
+}
+
+//GetObjectEventsMinimockPreCounter returns the value of ClientMock.GetObjectEvents invocations
+func (m *ClientMock) GetObjectEventsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectEventsPreCounter)
+}
+
+//GetObjectEventsFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetObjectEventsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectEventsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectEventsCounter) == uint64(len(m.GetObjectEventsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectEventsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectEventsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectEventsFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectEventsCounter) > 0
+	}
+
+	return true
+}
+
+type mClientMockGetObjectHistory struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetObjectHistoryExpectation
+	expectationSeries []*ClientMockGetObjectHistoryExpectation
+}
+
+type ClientMockGetObjectHistoryExpectation struct {
+	input  *ClientMockGetObjectHistoryInput
+	result *ClientMockGetObjectHistoryResult
+}
+
+type ClientMockGetObjectHistoryInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 *insolar.ID
+	p3 int
+}
+
+type ClientMockGetObjectHistoryResult struct {
+	r  []ObjectState
+	r1 *insolar.ID
+	r2 error
+}
+
+//Expect specifies that invocation of Client.GetObjectHistory is expected from 1 to Infinity times
+func (m *mClientMockGetObjectHistory) Expect(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) *mClientMockGetObjectHistory {
+	m.mock.GetObjectHistoryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectHistoryExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetObjectHistoryInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetObjectHistory
+func (m *mClientMockGetObjectHistory) Return(r []ObjectState, r1 *insolar.ID, r2 error) *ClientMock {
+	m.mock.GetObjectHistoryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectHistoryExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetObjectHistoryResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetObjectHistory is expected once
+func (m *mClientMockGetObjectHistory) ExpectOnce(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) *ClientMockGetObjectHistoryExpectation {
+	m.mock.GetObjectHistoryFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetObjectHistoryExpectation{}
+	expectation.input = &ClientMockGetObjectHistoryInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetObjectHistoryExpectation) Return(r []ObjectState, r1 *insolar.ID, r2 error) {
+	e.result = &ClientMockGetObjectHistoryResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of Client.GetObjectHistory method
+func (m *mClientMockGetObjectHistory) Set(f func(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []ObjectState, r1 *insolar.ID, r2 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectHistoryFunc = f
+	return m.mock
+}
+
+//GetObjectHistory implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetObjectHistory(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []ObjectState, r1 *insolar.ID, r2 error) {
+	counter := atomic.AddUint64(&m.GetObjectHistoryPreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectHistoryCounter, 1)
+
+	if len(m.GetObjectHistoryMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectHistoryMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetObjectHistory. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetObjectHistoryMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetObjectHistoryInput{p, p1, p2, p3}, "Client.GetObjectHistory got unexpected parameters")
+
+		result := m.GetObjectHistoryMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectHistory")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetObjectHistoryMock.mainExpectation != nil {
+
+		input := m.GetObjectHistoryMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetObjectHistoryInput{p, p1, p2, p3}, "Client.GetObjectHistory got unexpected parameters")
+		}
+
+		result := m.GetObjectHistoryMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectHistory")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetObjectHistoryFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetObjectHistory. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetObjectHistoryFunc(p, p1, p2, p3)
+}
+
+//GetObjectHistoryMinimockCounter returns a count of ClientMock.GetObjectHistoryFunc invocations
+func (m *ClientMock) GetObjectHistoryMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectHistoryCounter)
+}
+
+//GetObjectHistoryMinimockPreCounter returns the value of ClientMock.GetObjectHistory invocations
+func (m *ClientMock) GetObjectHistoryMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectHistoryPreCounter)
+}
+
+//GetObjectHistoryFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetObjectHistoryFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectHistoryMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectHistoryCounter) == uint64(len(m.GetObjectHistoryMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectHistoryMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectHistoryCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectHistoryFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectHistoryCounter) > 0
+	}
+
+	return true
+}
+
+type mClientMockGetObjectRequests struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetObjectRequestsExpectation
+	expectationSeries []*ClientMockGetObjectRequestsExpectation
+}
+
+type ClientMockGetObjectRequestsExpectation struct {
+	input  *ClientMockGetObjectRequestsInput
+	result *ClientMockGetObjectRequestsResult
+}
+
+type ClientMockGetObjectRequestsInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 *insolar.ID
+	p3 int
+}
+
+type ClientMockGetObjectRequestsResult struct {
+	r  []RequestResult
+	r1 *insolar.ID
+	r2 error
+}
+
+//Expect specifies that invocation of Client.GetObjectRequests is expected from 1 to Infinity times
+func (m *mClientMockGetObjectRequests) Expect(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) *mClientMockGetObjectRequests {
+	m.mock.GetObjectRequestsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectRequestsExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetObjectRequestsInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetObjectRequests
+func (m *mClientMockGetObjectRequests) Return(r []RequestResult, r1 *insolar.ID, r2 error) *ClientMock {
+	m.mock.GetObjectRequestsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectRequestsExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetObjectRequestsResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetObjectRequests is expected once
+func (m *mClientMockGetObjectRequests) ExpectOnce(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) *ClientMockGetObjectRequestsExpectation {
+	m.mock.GetObjectRequestsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetObjectRequestsExpectation{}
+	expectation.input = &ClientMockGetObjectRequestsInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetObjectRequestsExpectation) Return(r []RequestResult, r1 *insolar.ID, r2 error) {
+	e.result = &ClientMockGetObjectRequestsResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of Client.GetObjectRequests method
+func (m *mClientMockGetObjectRequests) Set(f func(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []RequestResult, r1 *insolar.ID, r2 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectRequestsFunc = f
+	return m.mock
+}
+
+//GetObjectRequests implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetObjectRequests(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []RequestResult, r1 *insolar.ID, r2 error) {
+	counter := atomic.AddUint64(&m.GetObjectRequestsPreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectRequestsCounter, 1)
+
+	if len(m.GetObjectRequestsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectRequestsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetObjectRequests. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetObjectRequestsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetObjectRequestsInput{p, p1, p2, p3}, "Client.GetObjectRequests got unexpected parameters")
+
+		result := m.GetObjectRequestsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectRequests")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetObjectRequestsMock.mainExpectation != nil {
+
+		input := m.GetObjectRequestsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetObjectRequestsInput{p, p1, p2, p3}, "Client.GetObjectRequests got unexpected parameters")
+		}
+
+		result := m.GetObjectRequestsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectRequests")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetObjectRequestsFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetObjectRequests. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetObjectRequestsFunc(p, p1, p2, p3)
+}
+
+//GetObjectRequestsMinimockCounter returns a count of ClientMock.GetObjectRequestsFunc invocations
+func (m *ClientMock) GetObjectRequestsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectRequestsCounter)
+}
+
+//GetObjectRequestsMinimockPreCounter returns the value of ClientMock.GetObjectRequests invocations
+func (m *ClientMock) GetObjectRequestsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectRequestsPreCounter)
+}
+
+//GetObjectRequestsFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetObjectRequestsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectRequestsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectRequestsCounter) == uint64(len(m.GetObjectRequestsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectRequestsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectRequestsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectRequestsFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectRequestsCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockGetPendingRequest struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockGetPendingRequestExpectation
@@ -2119,6 +2612,160 @@ func (m *ClientMock) RegisterResultFinished() bool {
 	return true
 }
 
+type mClientMockRegisterResultWithEvents struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockRegisterResultWithEventsExpectation
+	expectationSeries []*ClientMockRegisterResultWithEventsExpectation
+}
+
+type ClientMockRegisterResultWithEventsExpectation struct {
+	input  *ClientMockRegisterResultWithEventsInput
+	result *ClientMockRegisterResultWithEventsResult
+}
+
+type ClientMockRegisterResultWithEventsInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.Reference
+	p3 []byte
+	p4 []insolar.ContractEvent
+}
+
+type ClientMockRegisterResultWithEventsResult struct {
+	r  *insolar.ID
+	r1 error
+}
+
+//Expect specifies that invocation of Client.RegisterResultWithEvents is expected from 1 to Infinity times
+func (m *mClientMockRegisterResultWithEvents) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) *mClientMockRegisterResultWithEvents {
+	m.mock.RegisterResultWithEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockRegisterResultWithEventsExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of Client.RegisterResultWithEvents
+func (m *mClientMockRegisterResultWithEvents) Return(r *insolar.ID, r1 error) *ClientMock {
+	m.mock.RegisterResultWithEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockRegisterResultWithEventsExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockRegisterResultWithEventsResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.RegisterResultWithEvents is expected once
+func (m *mClientMockRegisterResultWithEvents) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) *ClientMockRegisterResultWithEventsExpectation {
+	m.mock.RegisterResultWithEventsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockRegisterResultWithEventsExpectation{}
+	expectation.input = &ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockRegisterResultWithEventsExpectation) Return(r *insolar.ID, r1 error) {
+	e.result = &ClientMockRegisterResultWithEventsResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.RegisterResultWithEvents method
+func (m *mClientMockRegisterResultWithEvents) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) (r *insolar.ID, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RegisterResultWithEventsFunc = f
+	return m.mock
+}
+
+//RegisterResultWithEvents implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) RegisterResultWithEvents(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) (r *insolar.ID, r1 error) {
+	counter := atomic.AddUint64(&m.RegisterResultWithEventsPreCounter, 1)
+	defer atomic.AddUint64(&m.RegisterResultWithEventsCounter, 1)
+
+	if len(m.RegisterResultWithEventsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RegisterResultWithEventsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.RegisterResultWithEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.RegisterResultWithEventsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}, "Client.RegisterResultWithEvents got unexpected parameters")
+
+		result := m.RegisterResultWithEventsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.RegisterResultWithEvents")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterResultWithEventsMock.mainExpectation != nil {
+
+		input := m.RegisterResultWithEventsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockRegisterResultWithEventsInput{p, p1, p2, p3, p4}, "Client.RegisterResultWithEvents got unexpected parameters")
+		}
+
+		result := m.RegisterResultWithEventsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.RegisterResultWithEvents")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.RegisterResultWithEventsFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.RegisterResultWithEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.RegisterResultWithEventsFunc(p, p1, p2, p3, p4)
+}
+
+//RegisterResultWithEventsMinimockCounter returns a count of ClientMock.RegisterResultWithEventsFunc invocations
+func (m *ClientMock) RegisterResultWithEventsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterResultWithEventsCounter)
+}
+
+//RegisterResultWithEventsMinimockPreCounter returns the value of ClientMock.RegisterResultWithEvents invocations
+func (m *ClientMock) RegisterResultWithEventsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RegisterResultWithEventsPreCounter)
+}
+
+//RegisterResultWithEventsFinished returns true if mock invocations count is ok
+func (m *ClientMock) RegisterResultWithEventsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RegisterResultWithEventsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RegisterResultWithEventsCounter) == uint64(len(m.RegisterResultWithEventsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RegisterResultWithEventsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RegisterResultWithEventsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RegisterResultWithEventsFunc != nil {
+		return atomic.LoadUint64(&m.RegisterResultWithEventsCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockRegisterValidation struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockRegisterValidationExpectation
@@ -2756,6 +3403,18 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
 
+	if !m.GetObjectEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectEvents")
+	}
+
+	if !m.GetObjectHistoryFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectHistory")
+	}
+
+	if !m.GetObjectRequestsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectRequests")
+	}
+
 	if !m.GetPendingRequestFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
@@ -2772,6 +3431,10 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.RegisterResult")
 	}
 
+	if !m.RegisterResultWithEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.RegisterResultWithEvents")
+	}
+
 	if !m.RegisterValidationFinished() {
 		m.t.Fatal("Expected call to ClientMock.RegisterValidation")
 	}
@@ -2841,6 +3504,18 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
 
+	if !m.GetObjectEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectEvents")
+	}
+
+	if !m.GetObjectHistoryFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectHistory")
+	}
+
+	if !m.GetObjectRequestsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectRequests")
+	}
+
 	if !m.GetPendingRequestFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
@@ -2857,6 +3532,10 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.RegisterResult")
 	}
 
+	if !m.RegisterResultWithEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.RegisterResultWithEvents")
+	}
+
 	if !m.RegisterValidationFinished() {
 		m.t.Fatal("Expected call to ClientMock.RegisterValidation")
 	}
@@ -2896,10 +3575,14 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetCodeFinished()
 		ok = ok && m.GetDelegateFinished()
 		ok = ok && m.GetObjectFinished()
+		ok = ok && m.GetObjectEventsFinished()
+		ok = ok && m.GetObjectHistoryFinished()
+		ok = ok && m.GetObjectRequestsFinished()
 		ok = ok && m.GetPendingRequestFinished()
 		ok = ok && m.HasPendingRequestsFinished()
 		ok = ok && m.RegisterRequestFinished()
 		ok = ok && m.RegisterResultFinished()
+		ok = ok && m.RegisterResultWithEventsFinished()
 		ok = ok && m.RegisterValidationFinished()
 		ok = ok && m.StateFinished()
 		ok = ok && m.UpdateObjectFinished()
@@ -2948,6 +3631,18 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.GetObject")
 			}
 
+			if !m.GetObjectEventsFinished() {
+				m.t.Error("Expected call to ClientMock.GetObjectEvents")
+			}
+
+			if !m.GetObjectHistoryFinished() {
+				m.t.Error("Expected call to ClientMock.GetObjectHistory")
+			}
+
+			if !m.GetObjectRequestsFinished() {
+				m.t.Error("Expected call to ClientMock.GetObjectRequests")
+			}
+
 			if !m.GetPendingRequestFinished() {
 				m.t.Error("Expected call to ClientMock.GetPendingRequest")
 			}
@@ -2964,6 +3659,10 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.RegisterResult")
 			}
 
+			if !m.RegisterResultWithEventsFinished() {
+				m.t.Error("Expected call to ClientMock.RegisterResultWithEvents")
+			}
+
 			if !m.RegisterValidationFinished() {
 				m.t.Error("Expected call to ClientMock.RegisterValidation")
 			}
@@ -3028,6 +3727,18 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetObjectEventsFinished() {
+		return false
+	}
+
+	if !m.GetObjectHistoryFinished() {
+		return false
+	}
+
+	if !m.GetObjectRequestsFinished() {
+		return false
+	}
+
 	if !m.GetPendingRequestFinished() {
 		return false
 	}
@@ -3044,6 +3755,10 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.RegisterResultWithEventsFinished() {
+		return false
+	}
+
 	if !m.RegisterValidationFinished() {
 		return false
 	}
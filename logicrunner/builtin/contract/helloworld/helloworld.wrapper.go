@@ -28,6 +28,52 @@ func (e *ExtendableError) Error() string {
 	return e.S
 }
 
+const INSHELPER_CodeVersion uint = 0
+
+func INSHELPER_Migrate(object []byte, self *HelloWorld) (*HelloWorld, error) {
+	if self.CodeVersion > INSHELPER_CodeVersion {
+		return nil, &ExtendableError{S: "[ INSHELPER_Migrate ] ( Generated Method ) State is saved by newer version of code"}
+	}
+	if self.CodeVersion == INSHELPER_CodeVersion {
+		return self, nil
+	}
+
+	self.CodeVersion = INSHELPER_CodeVersion
+	return self, nil
+}
+
+func INSMETHOD_MigrateState(object []byte, data []byte) ([]byte, []byte, error) {
+	ph := proxyctx.Current
+	self := new(HelloWorld)
+
+	if len(object) == 0 {
+		return nil, nil, &ExtendableError{S: "[ Fake MigrateState ] ( Generated Method ) Object is nil"}
+	}
+
+	err := ph.Deserialize(object, self)
+	if err != nil {
+		e := &ExtendableError{S: "[ Fake MigrateState ] ( Generated Method ) Can't deserialize args.Data: " + err.Error()}
+		return nil, nil, e
+	}
+
+	self, err = INSHELPER_Migrate(object, self)
+	if err != nil {
+		e := &ExtendableError{S: "[ Fake MigrateState ] ( Generated Method ) Can't migrate state: " + err.Error()}
+		return nil, nil, e
+	}
+
+	state := []byte{}
+	err = ph.Serialize(self, &state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ret := []byte{}
+	err = ph.Serialize([]interface{}{nil}, &ret)
+
+	return state, ret, err
+}
+
 func INSMETHOD_GetCode(object []byte, data []byte) ([]byte, []byte, error) {
 	ph := proxyctx.Current
 	self := new(HelloWorld)
@@ -95,6 +141,12 @@ func INSMETHOD_Greet(object []byte, data []byte) ([]byte, []byte, error) {
 		return nil, nil, e
 	}
 
+	self, err = INSHELPER_Migrate(object, self)
+	if err != nil {
+		e := &ExtendableError{S: "[ FakeGreet ] ( INSMETHOD_* ) ( Generated Method ) Can't migrate state: " + err.Error()}
+		return nil, nil, e
+	}
+
 	args := [1]interface{}{}
 	var args0 string
 	args[0] = &args0
@@ -136,17 +188,18 @@ func INSCONSTRUCTOR_New(data []byte) ([]byte, error) {
 		return nil, ret1
 	}
 
+	if ret0 == nil {
+		e := &ExtendableError{S: "[ FakeNew ] ( INSCONSTRUCTOR_* ) ( Generated Method ) Constructor returns nil"}
+		return nil, e
+	}
+	ret0.CodeVersion = INSHELPER_CodeVersion
+
 	ret := []byte{}
 	err = ph.Serialize(ret0, &ret)
 	if err != nil {
 		return nil, err
 	}
 
-	if ret0 == nil {
-		e := &ExtendableError{S: "[ FakeNew ] ( INSCONSTRUCTOR_* ) ( Generated Method ) Constructor returns nil"}
-		return nil, e
-	}
-
 	return ret, err
 }
 
@@ -154,6 +207,7 @@ func Initialize() map[string]interface{} {
 	return map[string]interface{}{
 		"INSMETHOD_GetCode":      INSMETHOD_GetCode,
 		"INSMETHOD_GetPrototype": INSMETHOD_GetPrototype,
+		"INSMETHOD_MigrateState": INSMETHOD_MigrateState,
 		"INSMETHOD_Greet":        INSMETHOD_Greet,
 		"INSCONSTRUCTOR_New":     INSCONSTRUCTOR_New,
 	}
@@ -0,0 +1,46 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+)
+
+// HandleGetCallTraceMessage returns tree of calls the node executed for the request, the tree is empty if the node
+// doesn't keep the request anymore.
+func (lr *LogicRunner) HandleGetCallTraceMessage(ctx context.Context, inmsg insolar.Parcel) (insolar.Reply, error) {
+	msg, ok := inmsg.Message().(*message.GetCallTrace)
+	if !ok {
+		return nil, errors.New("HandleGetCallTraceMessage( ! message.GetCallTrace )")
+	}
+
+	tree, ok := lr.CallTracer.Tree(msg.Request)
+	if !ok || tree.Object != msg.Object {
+		return &reply.CallTrace{}, nil
+	}
+	buf, err := insolar.Serialize(tree)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ HandleGetCallTraceMessage ] failed to serialize tree")
+	}
+	return &reply.CallTrace{Tree: buf}, nil
+}
@@ -0,0 +1,173 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package calltrace keeps contract calls executed by the node linked into trees by requests they were made from.
+// Collector completes the trees with calls executed by other nodes.
+package calltrace
+
+import (
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// DefaultLimit is default number of recent calls tracer keeps
+const DefaultLimit = 10000
+
+// Outgoing is a call made by contract, it may be executed on other node
+type Outgoing struct {
+	Object insolar.Reference
+	Method string
+	Error  string
+}
+
+// Call is a contract call executed by the node
+type Call struct {
+	Request       insolar.Reference
+	ParentRequest *insolar.Reference // request made the call, nil for calls from outside
+	TraceID       string
+	Caller        insolar.Reference
+	Object        insolar.Reference
+	Method        string
+	Start         time.Time
+	Finish        time.Time
+	Error         string
+	Outgoing      []Outgoing
+}
+
+// Tree is a call with calls made from it and executed by the node
+type Tree struct {
+	Call
+	Children []*Tree
+}
+
+// Tracer keeps limited number of recent calls, the oldest calls are forgotten first
+type Tracer struct {
+	lock     sync.Mutex
+	limit    int
+	calls    map[insolar.Reference]*Call
+	children map[insolar.Reference][]insolar.Reference
+	order    []insolar.Reference
+}
+
+// New creates tracer keeping `limit` recent calls
+func New(limit int) *Tracer {
+	return &Tracer{
+		limit:    limit,
+		calls:    make(map[insolar.Reference]*Call),
+		children: make(map[insolar.Reference][]insolar.Reference),
+	}
+}
+
+// Start records call which starts execution
+func (t *Tracer) Start(call Call) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.calls[call.Request]; ok {
+		return
+	}
+
+	if t.limit > 0 && len(t.order) >= t.limit {
+		t.forget(t.order[0])
+		t.order = t.order[1:]
+	}
+
+	t.calls[call.Request] = &call
+	t.order = append(t.order, call.Request)
+	if call.ParentRequest != nil {
+		t.children[*call.ParentRequest] = append(t.children[*call.ParentRequest], call.Request)
+	}
+}
+
+func (t *Tracer) forget(request insolar.Reference) {
+	call, ok := t.calls[request]
+	if !ok {
+		return
+	}
+	delete(t.calls, request)
+
+	if call.ParentRequest == nil {
+		return
+	}
+	siblings := t.children[*call.ParentRequest]
+	for i, sibling := range siblings {
+		if sibling == request {
+			siblings = append(siblings[:i], siblings[i+1:]...)
+			break
+		}
+	}
+	if len(siblings) == 0 {
+		delete(t.children, *call.ParentRequest)
+	} else {
+		t.children[*call.ParentRequest] = siblings
+	}
+}
+
+// Finish records result of call and calls it made
+func (t *Tracer) Finish(request insolar.Reference, outgoing []Outgoing, err string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	call, ok := t.calls[request]
+	if !ok {
+		return
+	}
+	call.Finish = time.Now()
+	call.Outgoing = outgoing
+	call.Error = err
+}
+
+// Tree returns tree of calls started from request
+func (t *Tracer) Tree(request insolar.Reference) (*Tree, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.calls[request]; !ok {
+		return nil, false
+	}
+	return t.tree(request), true
+}
+
+func (t *Tracer) tree(request insolar.Reference) *Tree {
+	res := &Tree{Call: *t.calls[request]}
+	for _, child := range t.children[request] {
+		res.Children = append(res.Children, t.tree(child))
+	}
+	return res
+}
+
+// TraceTrees returns trees of calls made with trace ID, trees start from calls which parents are unknown to the node
+func (t *Tracer) TraceTrees(traceID string) []*Tree {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var res []*Tree
+	for _, request := range t.order {
+		call := t.calls[request]
+		if call.TraceID != traceID {
+			continue
+		}
+		if call.ParentRequest != nil {
+			if parent, ok := t.calls[*call.ParentRequest]; ok && parent.TraceID == traceID {
+				continue
+			}
+		}
+		res = append(res, t.tree(request))
+	}
+	return res
+}
@@ -0,0 +1,87 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package calltrace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestTracer_Tree(t *testing.T) {
+	tracer := New(DefaultLimit)
+
+	root := testutils.RandomRef()
+	child := testutils.RandomRef()
+	grandchild := testutils.RandomRef()
+	other := testutils.RandomRef()
+
+	tracer.Start(Call{Request: root, TraceID: "trace", Method: "Call"})
+	tracer.Start(Call{Request: child, ParentRequest: &root, TraceID: "trace", Method: "Transfer"})
+	tracer.Start(Call{Request: grandchild, ParentRequest: &child, TraceID: "trace", Method: "Accept"})
+	tracer.Start(Call{Request: other, TraceID: "other", Method: "Call"})
+
+	wallet := testutils.RandomRef()
+	tracer.Finish(child, []Outgoing{{Object: wallet, Method: "Accept"}}, "")
+	tracer.Finish(root, nil, "failed")
+
+	tree, ok := tracer.Tree(root)
+	require.True(t, ok)
+	require.Equal(t, "failed", tree.Error)
+	require.False(t, tree.Finish.IsZero())
+	require.Len(t, tree.Children, 1)
+	require.Equal(t, child, tree.Children[0].Request)
+	require.Equal(t, []Outgoing{{Object: wallet, Method: "Accept"}}, tree.Children[0].Outgoing)
+	require.Len(t, tree.Children[0].Children, 1)
+	require.Equal(t, grandchild, tree.Children[0].Children[0].Request)
+	require.True(t, tree.Children[0].Children[0].Finish.IsZero())
+
+	_, ok = tracer.Tree(testutils.RandomRef())
+	require.False(t, ok)
+
+	trees := tracer.TraceTrees("trace")
+	require.Len(t, trees, 1)
+	require.Equal(t, root, trees[0].Request)
+
+	trees = tracer.TraceTrees("other")
+	require.Len(t, trees, 1)
+	require.Equal(t, other, trees[0].Request)
+}
+
+func TestTracer_Limit(t *testing.T) {
+	tracer := New(2)
+
+	root := testutils.RandomRef()
+	child := testutils.RandomRef()
+	grandchild := testutils.RandomRef()
+
+	tracer.Start(Call{Request: root, TraceID: "trace"})
+	tracer.Start(Call{Request: child, ParentRequest: &root, TraceID: "trace"})
+	tracer.Start(Call{Request: grandchild, ParentRequest: &child, TraceID: "trace"})
+
+	_, ok := tracer.Tree(root)
+	require.False(t, ok)
+
+	// the oldest known call of trace becomes a root
+	trees := tracer.TraceTrees("trace")
+	require.Len(t, trees, 1)
+	require.Equal(t, child, trees[0].Request)
+	require.Len(t, trees[0].Children, 1)
+	require.Equal(t, grandchild, trees[0].Children[0].Request)
+}
@@ -0,0 +1,216 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package calltrace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+// requestsPageSize is number of object requests fetched from ledger at once when children of a call are searched
+const requestsPageSize = 100
+
+// Collector builds trees of calls executed by different nodes. Children of a call are found in ledger as requests
+// of objects it called made from its request, their subtrees are asked from the nodes which executed them. Calls
+// their executors don't keep anymore are built from request records, their children can't be found.
+type Collector struct {
+	Requests    artifacts.Client   `inject:""`
+	Coordinator jet.Coordinator    `inject:""`
+	Bus         insolar.MessageBus `inject:""`
+
+	tracer *Tracer
+}
+
+// NewCollector creates collector which starts trees from calls known to the tracer
+func NewCollector(tracer *Tracer) *Collector {
+	return &Collector{tracer: tracer}
+}
+
+// Tree returns tree of calls started from request. Object of the request is used to find the request in ledger if
+// the node didn't execute it, it may be nil.
+func (c *Collector) Tree(ctx context.Context, request insolar.Reference, object *insolar.Reference) (*Tree, bool, error) {
+	tree, ok := c.tracer.Tree(request)
+	if !ok {
+		if object == nil {
+			return nil, false, nil
+		}
+		var err error
+		tree, err = c.requestTree(ctx, *object, request)
+		if err != nil {
+			return nil, false, err
+		}
+		if tree == nil {
+			return nil, false, nil
+		}
+	}
+
+	if err := c.complete(ctx, tree); err != nil {
+		return nil, false, err
+	}
+	return tree, true, nil
+}
+
+// TraceTrees returns trees of calls made with trace ID, trees start from calls executed by the node which parents
+// are unknown to it
+func (c *Collector) TraceTrees(ctx context.Context, traceID string) ([]*Tree, error) {
+	trees := c.tracer.TraceTrees(traceID)
+	for _, tree := range trees {
+		if err := c.complete(ctx, tree); err != nil {
+			return nil, err
+		}
+	}
+	return trees, nil
+}
+
+func (c *Collector) requestTree(ctx context.Context, object, request insolar.Reference) (*Tree, error) {
+	requests, _, err := c.Requests.GetObjectRequests(ctx, object, request.Record(), 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch request")
+	}
+	if len(requests) == 0 || requests[0].RequestID != *request.Record() {
+		return nil, nil
+	}
+	return c.subtree(ctx, object, requests[0]), nil
+}
+
+// complete adds children executed by other nodes to the tree and its subtrees
+func (c *Collector) complete(ctx context.Context, tree *Tree) error {
+	known := make(map[insolar.Reference]bool, len(tree.Children))
+	for _, child := range tree.Children {
+		known[child.Request] = true
+	}
+
+	called := make(map[insolar.Reference]bool, len(tree.Outgoing))
+	for _, o := range tree.Outgoing {
+		if called[o.Object] {
+			continue
+		}
+		called[o.Object] = true
+
+		children, err := c.children(ctx, o.Object, tree.Request)
+		if err != nil {
+			return err
+		}
+		for _, rr := range children {
+			request := *insolar.NewReference(insolar.DomainID, rr.RequestID)
+			if known[request] {
+				continue
+			}
+			known[request] = true
+			tree.Children = append(tree.Children, c.subtree(ctx, o.Object, rr))
+		}
+	}
+
+	for _, child := range tree.Children {
+		if err := c.complete(ctx, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// children returns requests of the object made from the parent request, they can't be older than the parent one
+func (c *Collector) children(
+	ctx context.Context, object, parent insolar.Reference,
+) ([]artifacts.RequestResult, error) {
+	var (
+		res  []artifacts.RequestResult
+		from *insolar.ID
+	)
+	for {
+		requests, next, err := c.Requests.GetObjectRequests(ctx, object, from, requestsPageSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch requests of %s", object.String())
+		}
+		for _, rr := range requests {
+			if rr.RequestID.Pulse() < parent.Record().Pulse() {
+				return res, nil
+			}
+			if rr.Request.ParentRequest != nil && *rr.Request.ParentRequest == parent {
+				res = append(res, rr)
+			}
+		}
+		if next == nil {
+			return res, nil
+		}
+		from = next
+	}
+}
+
+// subtree returns tree of the request from its executor or builds it from the request record
+func (c *Collector) subtree(ctx context.Context, object insolar.Reference, rr artifacts.RequestResult) *Tree {
+	request := *insolar.NewReference(insolar.DomainID, rr.RequestID)
+	tree, err := c.executorTree(ctx, object, request)
+	if err != nil {
+		inslogger.FromContext(ctx).Warnf("failed to get calls of %s from executor: %s", request.String(), err)
+	}
+	if tree != nil {
+		return tree
+	}
+
+	return &Tree{Call: Call{
+		Request:       request,
+		ParentRequest: rr.Request.ParentRequest,
+		Caller:        rr.Request.Caller,
+		Object:        object,
+		Method:        rr.Request.Method,
+	}}
+}
+
+func (c *Collector) executorTree(ctx context.Context, object, request insolar.Reference) (*Tree, error) {
+	executor, err := c.Coordinator.VirtualExecutorForObject(ctx, *object.Record(), request.Record().Pulse())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate executor")
+	}
+	if *executor == c.Coordinator.Me() {
+		tree, _ := c.tracer.Tree(request)
+		return tree, nil
+	}
+
+	rep, err := c.Bus.Send(
+		ctx,
+		&message.GetCallTrace{Object: object, Request: request},
+		&insolar.MessageSendOptions{Receiver: executor},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send message")
+	}
+	switch r := rep.(type) {
+	case *reply.CallTrace:
+		if len(r.Tree) == 0 {
+			return nil, nil
+		}
+		tree := &Tree{}
+		if err := insolar.Deserialize(r.Tree, tree); err != nil {
+			return nil, errors.Wrap(err, "failed to deserialize tree")
+		}
+		return tree, nil
+	case *reply.Error:
+		return nil, r.Error()
+	default:
+		return nil, fmt.Errorf("unexpected reply: %#v", rep)
+	}
+}
@@ -0,0 +1,133 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package calltrace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestCollector_Tree(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	requestID := func(pn insolar.PulseNumber) insolar.ID {
+		id := gen.ID()
+		return *insolar.NewID(pn, id.Hash())
+	}
+	requestRef := func(id insolar.ID) insolar.Reference {
+		return *insolar.NewReference(insolar.DomainID, id)
+	}
+
+	wallet, deposit := testutils.RandomRef(), testutils.RandomRef()
+	rootID, childID, grandchildID := requestID(65537), requestID(65538), requestID(65539)
+	root, child, grandchild := requestRef(rootID), requestRef(childID), requestRef(grandchildID)
+
+	tracer := New(DefaultLimit)
+	tracer.Start(Call{Request: root, TraceID: "trace", Method: "Call"})
+	tracer.Finish(root, []Outgoing{{Object: wallet, Method: "Transfer"}}, "")
+
+	me, other := testutils.RandomRef(), testutils.RandomRef()
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.MeMock.Return(me)
+	coordinator.VirtualExecutorForObjectMock.Set(
+		func(_ context.Context, obj insolar.ID, _ insolar.PulseNumber) (*insolar.Reference, error) {
+			if obj == *wallet.Record() {
+				return &other, nil
+			}
+			return &me, nil
+		},
+	)
+
+	requests := artifacts.NewClientMock(mc)
+	requests.GetObjectRequestsMock.Set(
+		func(_ context.Context, obj insolar.Reference, from *insolar.ID, _ int) ([]artifacts.RequestResult, *insolar.ID, error) {
+			switch obj {
+			case wallet:
+				return []artifacts.RequestResult{
+					{RequestID: requestID(65540), Request: record.Request{Method: "Balance"}},
+					{RequestID: childID, Request: record.Request{Method: "Transfer", ParentRequest: &root}},
+					{RequestID: requestID(65536), Request: record.Request{Method: "Transfer", ParentRequest: &root}},
+				}, nil, nil
+			case deposit:
+				require.Nil(t, from)
+				return []artifacts.RequestResult{
+					{RequestID: grandchildID, Request: record.Request{Method: "Accept", Caller: wallet, ParentRequest: &child}},
+				}, nil, nil
+			}
+			t.Fatal("unexpected object")
+			return nil, nil, nil
+		},
+	)
+
+	bus := testutils.NewMessageBusMock(mc)
+	bus.SendMock.Set(
+		func(_ context.Context, msg insolar.Message, opts *insolar.MessageSendOptions) (insolar.Reply, error) {
+			require.Equal(t, &message.GetCallTrace{Object: wallet, Request: child}, msg)
+			require.Equal(t, &other, opts.Receiver)
+			buf, err := insolar.Serialize(&Tree{Call: Call{
+				Request:       child,
+				ParentRequest: &root,
+				TraceID:       "trace",
+				Object:        wallet,
+				Method:        "Transfer",
+				Outgoing:      []Outgoing{{Object: deposit, Method: "Accept"}},
+			}})
+			require.NoError(t, err)
+			return &reply.CallTrace{Tree: buf}, nil
+		},
+	)
+
+	collector := NewCollector(tracer)
+	collector.Requests = requests
+	collector.Coordinator = coordinator
+	collector.Bus = bus
+
+	tree, ok, err := collector.Tree(ctx, root, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, tree.Children, 1)
+	require.Equal(t, child, tree.Children[0].Request)
+	require.Equal(t, "trace", tree.Children[0].TraceID)
+	require.Len(t, tree.Children[0].Children, 1)
+
+	// grandchild is executed by the node but it's not in the tracer, so it's built from the request record
+	leaf := tree.Children[0].Children[0]
+	require.Equal(t, grandchild, leaf.Request)
+	require.Equal(t, deposit, leaf.Object)
+	require.Equal(t, wallet, leaf.Caller)
+	require.Equal(t, "Accept", leaf.Method)
+	require.Empty(t, leaf.TraceID)
+	require.True(t, leaf.Start.IsZero())
+
+	_, ok, err = collector.Tree(ctx, testutils.RandomRef(), nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
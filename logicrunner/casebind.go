@@ -17,6 +17,7 @@
 package logicrunner
 
 import (
+	"bytes"
 	"context"
 	"encoding/gob"
 
@@ -24,8 +25,10 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
 )
 
 type CaseRequest struct {
@@ -34,10 +37,34 @@ type CaseRequest struct {
 	MessageBus insolar.MessageBus
 	Reply      insolar.Reply
 	Error      string
+	// State is an object state request was executed on, empty for constructors.
+	State *insolar.ID
+	// Result is an object state produced by request.
+	Result   *insolar.ID
+	Outgoing []CaseOutgoing
+}
+
+// CaseOutgoing is a call made by contract during execution of a request. Validators don't
+// send calls, they replay results recorded by executor.
+type CaseOutgoing struct {
+	Object    insolar.Reference
+	Method    string
+	Arguments []byte
+	Result    []byte
+	Reference *insolar.Reference
+	Error     string
+}
+
+func (o *CaseOutgoing) err() error {
+	if o.Error == "" {
+		return nil
+	}
+	return errors.New(o.Error)
 }
 
 // CaseBinder is a whole result of executor efforts on every object it seen on this pulse
 type CaseBind struct {
+	Pulse    insolar.Pulse
 	Requests []CaseRequest
 }
 
@@ -45,52 +72,67 @@ func NewCaseBind() *CaseBind {
 	return &CaseBind{Requests: make([]CaseRequest, 0)}
 }
 
-func NewCaseBindFromValidateMessage(ctx context.Context, mb insolar.MessageBus, msg *message.ValidateCaseBind) *CaseBind {
+func newCaseBindFromRequests(pulse insolar.Pulse, requests []message.CaseBindRequest) (*CaseBind, error) {
 	res := &CaseBind{
-		Requests: make([]CaseRequest, len(msg.Requests)),
+		Pulse:    pulse,
+		Requests: make([]CaseRequest, len(requests)),
 	}
-	for i, req := range msg.Requests {
-		// TODO: here we used message bus player
+	for i, req := range requests {
+		var outgoing []CaseOutgoing
+		if len(req.MessageBusTape) > 0 {
+			err := gob.NewDecoder(bytes.NewReader(req.MessageBusTape)).Decode(&outgoing)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't read tape of request %d", i)
+			}
+		}
 		res.Requests[i] = CaseRequest{
-			Parcel:  req.Parcel,
-			Request: req.Request,
-			Reply:   req.Reply,
-			Error:   req.Error,
+			Parcel:   req.Parcel,
+			Request:  req.Request,
+			Reply:    req.Reply,
+			Error:    req.Error,
+			State:    req.State,
+			Result:   req.Result,
+			Outgoing: outgoing,
 		}
 	}
-	return res
+	return res, nil
+}
+
+func NewCaseBindFromValidateMessage(ctx context.Context, mb insolar.MessageBus, msg *message.ValidateCaseBind) (*CaseBind, error) {
+	return newCaseBindFromRequests(msg.Pulse, msg.Requests)
 }
 
-func NewCaseBindFromExecutorResultsMessage(msg *message.ExecutorResults) *CaseBind {
-	panic("not implemented")
+func NewCaseBindFromExecutorResultsMessage(msg *message.ExecutorResults) (*CaseBind, error) {
+	return newCaseBindFromRequests(insolar.Pulse{}, msg.Requests)
 }
 
 func (cb *CaseBind) getCaseBindForMessage(ctx context.Context) []message.CaseBindRequest {
-	return make([]message.CaseBindRequest, 0)
-	// TODO: we don't validate at the moment, just send empty case bind
-	//
-	//if cb == nil {
-	//	return make([]message.CaseBindRequest, 0)
-	//}
-	//
-	//requests := make([]message.CaseBindRequest, len(cb.Requests))
-	//
-	//for i, req := range cb.Requests {
-	//	var buf bytes.Buffer
-	//	err := req.MessageBus.(insolar.TapeWriter).WriteTape(ctx, &buf)
-	//	if err != nil {
-	//		panic("couldn't write tape: " + err.Error())
-	//	}
-	//	requests[i] = message.CaseBindRequest{
-	//		Parcel:         req.Parcel,
-	//		Request:        req.Request,
-	//		MessageBusTape: buf.Bytes(),
-	//		Reply:          req.Reply,
-	//		Error:          req.Error,
-	//	}
-	//}
-	//
-	//return requests
+	if cb == nil {
+		return nil
+	}
+
+	requests := make([]message.CaseBindRequest, len(cb.Requests))
+
+	for i, req := range cb.Requests {
+		var buf bytes.Buffer
+		if len(req.Outgoing) > 0 {
+			err := gob.NewEncoder(&buf).Encode(req.Outgoing)
+			if err != nil {
+				panic("couldn't write tape: " + err.Error())
+			}
+		}
+		requests[i] = message.CaseBindRequest{
+			Parcel:         req.Parcel,
+			Request:        req.Request,
+			MessageBusTape: buf.Bytes(),
+			Reply:          req.Reply,
+			Error:          req.Error,
+			State:          req.State,
+			Result:         req.Result,
+		}
+	}
+
+	return requests
 }
 
 func (cb *CaseBind) ToValidateMessage(ctx context.Context, ref Ref, pulse insolar.Pulse) *message.ValidateCaseBind {
@@ -123,6 +165,7 @@ type CaseBindReplay struct {
 
 func NewCaseBindReplay(cb CaseBind) *CaseBindReplay {
 	return &CaseBindReplay{
+		Pulse:    cb.Pulse,
 		CaseBind: cb,
 		Request:  -1,
 		Record:   -1,
@@ -134,53 +177,181 @@ func (r *CaseBindReplay) NextRequest() *CaseRequest {
 		return nil
 	}
 	r.Request++
+	r.Record = -1
 	return &r.CaseBind.Requests[r.Request]
 }
 
+// NextOutgoing returns next outgoing call recorded by executor for current request.
+func (r *CaseBindReplay) NextOutgoing() *CaseOutgoing {
+	if r.Request < 0 {
+		return nil
+	}
+	outgoing := r.CaseBind.Requests[r.Request].Outgoing
+	if r.Record+1 >= len(outgoing) {
+		return nil
+	}
+	r.Record++
+	return &outgoing[r.Record]
+}
+
+// replayOutgoing returns executor's result of outgoing call, call made by validator must be the same.
+func (r *CaseBindReplay) replayOutgoing(object Ref, method string, args []byte) (*CaseOutgoing, error) {
+	o := r.NextOutgoing()
+	if o == nil {
+		return nil, errors.New("executor made less outgoing calls")
+	}
+	if !o.Object.Equal(object) || o.Method != method || !bytes.Equal(o.Arguments, args) {
+		return nil, errors.Errorf("outgoing call %d differs from executor's one", r.Record)
+	}
+	return o, nil
+}
+
 func (lr *LogicRunner) Validate(ctx context.Context, ref Ref, p insolar.Pulse, cb CaseBind) (int, error) {
-	//os := lr.UpsertObjectState(ref)
-	//vs := os.StartValidation(ref)
-	//
-	//vs.Lock()
-	//defer vs.Unlock()
-	//
-	//checker := &ValidationChecker{
-	//	lr: lr,
-	//	cb: NewCaseBindReplay(cb),
-	//}
-	//vs.Behaviour = checker
-	//
-	//for {
-	//	request := checker.NextRequest()
-	//	if request == nil {
-	//		break
-	//	}
-	//
-	//	traceID := "TODO" // FIXME
-	//
-	//	ctx = inslogger.ContextWithTrace(ctx, traceID)
-	//
-	//	// TODO: here we were injecting message bus into context
-	//
-	//	sender := request.Parcel.GetSender()
-	//	vs.Current = &CurrentExecution{
-	//		Context:       ctx,
-	//		Request:       &request.Request,
-	//		RequesterNode: &sender,
-	//	}
-	//
-	//	rep, err := func() (insolar.Reply, error) {
-	//		vs.Unlock()
-	//		defer vs.Lock()
-	//		return lr.executeOrValidate(ctx, vs, request.Parcel)
-	//	}()
-	//
-	//	err = vs.Behaviour.Result(rep, err)
-	//	if err != nil {
-	//		return 0, errors.Wrap(err, "validation step failed")
-	//	}
-	//}
-	return 1, nil
+	os := lr.UpsertObjectState(ref)
+	vs, err := os.StartValidation(ref)
+	if err != nil {
+		return 0, err
+	}
+	defer os.FinishValidation()
+
+	replay := NewCaseBindReplay(cb)
+	replay.Pulse = p
+
+	vs.Lock()
+	vs.replay = replay
+	vs.Unlock()
+
+	for {
+		request := replay.NextRequest()
+		if request == nil {
+			break
+		}
+
+		err := lr.validateRequest(ctx, vs, request)
+		if err != nil {
+			replay.Fail++
+			return replay.Steps, errors.Wrapf(err, "validation step %d failed", replay.Request)
+		}
+		replay.Steps++
+	}
+	return replay.Steps, nil
+}
+
+// validateRequest executes request once again and compares results with ones executor reported.
+func (lr *LogicRunner) validateRequest(ctx context.Context, vs *ExecutionState, request *CaseRequest) error {
+	msg, ok := request.Parcel.Message().(*message.CallMethod)
+	if !ok {
+		return errors.New("request is not a call")
+	}
+
+	sender := request.Parcel.GetSender()
+
+	vs.Lock()
+	vs.Current = &CurrentExecution{
+		Context:       ctx,
+		Request:       &request.Request,
+		RequesterNode: &sender,
+		ReturnMode:    msg.ReturnMode,
+		Sequence:      msg.Sequence,
+	}
+	vs.objectbody = nil
+	vs.deactivate = false
+	vs.Unlock()
+
+	head := request.Request
+	if msg.CallType == record.CTMethod {
+		head = *msg.Object
+
+		// request must be replayed on the state executor had, not on the latest one
+		body, err := lr.getObjectBodyForState(ctx, head, request.State)
+		if err != nil {
+			return errors.Wrap(err, "couldn't get object state request was executed on")
+		}
+		vs.objectbody = body
+	}
+
+	re, err := lr.execute(ctx, vs, msg)
+	errstr := ""
+	if err != nil {
+		errstr = err.Error()
+	}
+	if errstr != request.Error {
+		return errors.Errorf("executor error %q differs from %q", request.Error, errstr)
+	}
+	if err != nil {
+		return nil
+	}
+
+	if request.Reply == nil || !bytes.Equal(reply.ToBytes(re), reply.ToBytes(request.Reply)) {
+		return errors.New("reply differs from executor's one")
+	}
+	if vs.replay.NextOutgoing() != nil {
+		return errors.New("executor made more outgoing calls")
+	}
+
+	if request.Result == nil {
+		return errors.New("executor didn't report result state")
+	}
+	if request.State != nil && *request.Result == *request.State {
+		if vs.deactivate || !bytes.Equal(vs.Current.Memory, vs.objectbody.Object) {
+			return errors.New("object state is changed, but executor didn't change it")
+		}
+		return nil
+	}
+
+	expected, err := lr.getObjectState(ctx, head, *request.Result)
+	if err != nil {
+		return errors.Wrap(err, "couldn't get object state produced by executor")
+	}
+	if vs.deactivate != (expected.State == record.StateDeactivation) {
+		return errors.New("object deactivation differs from executor's one")
+	}
+	if !vs.deactivate && !bytes.Equal(vs.Current.Memory, expected.Memory) {
+		return errors.New("object memory differs from executor's one")
+	}
+	return nil
+}
+
+// getObjectState returns state of an object with provided id.
+func (lr *LogicRunner) getObjectState(ctx context.Context, head Ref, state insolar.ID) (*artifacts.ObjectState, error) {
+	states, _, err := lr.ArtifactManager.GetObjectHistory(ctx, head, &state, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 || states[0].ID != state {
+		return nil, errors.New("object state not found")
+	}
+	return &states[0], nil
+}
+
+func (lr *LogicRunner) getObjectBodyForState(ctx context.Context, head Ref, state *insolar.ID) (*ObjectBody, error) {
+	if state == nil {
+		return nil, errors.New("executor didn't report object state")
+	}
+	st, err := lr.getObjectState(ctx, head, *state)
+	if err != nil {
+		return nil, err
+	}
+	if st.Prototype == nil {
+		return nil, errors.New("object state has no prototype")
+	}
+	objDesc, err := lr.ArtifactManager.GetObject(ctx, head)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get object")
+	}
+	protoDesc, codeDesc, err := lr.getDescriptorsByPrototypeRef(ctx, *st.Prototype)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't resolve prototype reference to descriptors")
+	}
+
+	return &ObjectBody{
+		objDescriptor:   objDesc,
+		Object:          st.Memory,
+		Prototype:       protoDesc.HeadRef(),
+		CodeMachineType: codeDesc.MachineType(),
+		CodeRef:         codeDesc.Ref(),
+		Parent:          objDesc.Parent(),
+	}, nil
 }
 
 func (lr *LogicRunner) HandleValidateCaseBindMessage(ctx context.Context, inmsg insolar.Parcel) (insolar.Reply, error) {
@@ -200,11 +371,14 @@ func (lr *LogicRunner) HandleValidateCaseBindMessage(ctx context.Context, inmsg
 		return nil, errors.Wrap(err, "[ HandleValidateCaseBindMessage ] can't play role")
 	}
 
-	passedStepsCount, validationError := lr.Validate(
-		ctx, msg.GetReference(), msg.GetPulse(), *NewCaseBindFromValidateMessage(ctx, lr.MessageBus, msg),
-	)
+	passedStepsCount := 0
+	cb, validationError := NewCaseBindFromValidateMessage(ctx, lr.MessageBus, msg)
+	if validationError == nil {
+		passedStepsCount, validationError = lr.Validate(ctx, msg.GetReference(), msg.GetPulse(), *cb)
+	}
 	errstr := ""
 	if validationError != nil {
+		inslogger.FromContext(ctx).Warn("validation failed: ", validationError)
 		errstr = validationError.Error()
 	}
 
@@ -239,4 +413,5 @@ func (lr *LogicRunner) HandleExecutorResultsMessage(ctx context.Context, inmsg i
 func init() {
 	gob.Register(&CaseRequest{})
 	gob.Register(&CaseBind{})
+	gob.Register(&CaseOutgoing{})
 }
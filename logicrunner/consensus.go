@@ -22,6 +22,7 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/pkg/errors"
 )
 
@@ -35,6 +36,7 @@ type ConsensusRecord struct {
 type Consensus struct {
 	sync.Mutex
 	lr       *LogicRunner
+	ref      Ref
 	ready    bool
 	Have     int
 	Need     int
@@ -44,9 +46,10 @@ type Consensus struct {
 	Message  insolar.Parcel
 }
 
-func newConsensus(lr *LogicRunner, refs []Ref) *Consensus {
+func newConsensus(lr *LogicRunner, ref Ref, refs []Ref) *Consensus {
 	c := &Consensus{
 		lr:      lr,
+		ref:     ref,
 		Results: make(map[Ref]ConsensusRecord),
 	}
 	for _, r := range refs {
@@ -62,68 +65,111 @@ func (c *Consensus) AddValidated(ctx context.Context, sm insolar.Parcel, msg *me
 	source := sm.GetSender()
 	c.Lock()
 	defer c.Unlock()
-	if _, ok := c.Results[source]; !ok {
+	r, ok := c.Results[source]
+	if !ok {
 		return errors.Errorf("Validation packet from non validation node for %#v", sm)
 	}
+	if r.Message != nil {
+		return errors.Errorf("Validation packet from %s is already received", source.String())
+	}
 
 	c.Results[source] = ConsensusRecord{
-		Steps: msg.PassedStepsCount,
-		Error: msg.Error,
+		Steps:   msg.PassedStepsCount,
+		Error:   msg.Error,
+		Message: sm,
 	}
 	c.Have++
 	c.CheckReady(ctx)
 	return nil
 }
 
-func (c *Consensus) AddExecutor(ctx context.Context, sm insolar.Parcel, msg *message.ExecutorResults) {
+// AddExecutor sets requests executed by previous executor, validated results are compared with them
+func (c *Consensus) AddExecutor(ctx context.Context, sm insolar.Parcel, msg *message.ExecutorResults) error {
+	cb, err := NewCaseBindFromExecutorResultsMessage(msg)
+	if err != nil {
+		return errors.Wrap(err, "couldn't read executor results")
+	}
+
 	c.Lock()
 	defer c.Unlock()
-	c.CaseBind = *NewCaseBindFromExecutorResultsMessage(msg)
+	c.setCaseBind(ctx, *cb, sm)
+	return nil
+}
+
+func (c *Consensus) setCaseBind(ctx context.Context, cb CaseBind, sm insolar.Parcel) {
+	c.CaseBind = cb
 	c.Message = sm
 	c.CheckReady(ctx)
 }
 
 func (c *Consensus) CheckReady(ctx context.Context) {
-	if c.Have < c.Need {
+	// without requests of executor there is nothing to compare with
+	if c.ready || c.Have < c.Need || len(c.CaseBind.Requests) == 0 {
 		return
 	}
 	steps := make(map[int]int)
 	maxSame := 0   // count of nodes with same result
 	stepsSame := 0 // steps agreed by maximum nodes
 	for _, r := range c.Results {
+		if r.Message == nil {
+			continue
+		}
 		steps[r.Steps]++
 		if maxSame < steps[r.Steps] {
 			maxSame = steps[r.Steps]
 			stepsSame = r.Steps
 		}
 	}
-	var err error
-	if maxSame < c.Need && c.Total == c.Have {
-		c.ready = true
-		err = c.lr.ArtifactManager.RegisterValidation(ctx, c.GetReference(), *c.FindRequestBefore(stepsSame), false, c.GetValidatorSignatures())
-	} else if maxSame >= c.Need && stepsSame == len(c.CaseBind.Requests) {
-		c.ready = true
-		err = c.lr.ArtifactManager.RegisterValidation(ctx, c.GetReference(), *c.FindRequestBefore(stepsSame), true, c.GetValidatorSignatures())
+
+	var valid bool
+	switch {
+	case maxSame >= c.Need:
+		valid = stepsSame == len(c.CaseBind.Requests)
+	case c.Total == c.Have:
+		valid = false
+	default:
+		return
 	}
+	c.ready = true
+
+	state := c.FindRequestBefore(stepsSame)
+	if state == nil {
+		inslogger.FromContext(ctx).Warn("no object state to register validation of ", c.GetReference().String())
+		return
+	}
+	err := c.lr.ArtifactManager.RegisterValidation(ctx, c.GetReference(), *state, valid, c.GetValidatorSignatures())
 	if err != nil {
-		panic(err)
+		inslogger.FromContext(ctx).Error("couldn't register validation: ", err)
 	}
 }
 
 func (c *Consensus) GetReference() Ref {
-	return c.Message.Message().(*message.ExecutorResults).RecordRef
+	return c.ref
 }
 
-//
+// GetValidatorSignatures returns results of validators to register them with validation
 func (c *Consensus) GetValidatorSignatures() (messages []insolar.Message) {
 	for _, x := range c.Results {
+		if x.Message == nil {
+			continue
+		}
 		messages = append(messages, x.Message)
 	}
 	return messages
 }
 
-// FindRequestBefore returns request placed before step (last valid request)
+// FindRequestBefore returns object state produced by the last valid request,
+// or state the first request was executed on if there are no valid requests
 func (c *Consensus) FindRequestBefore(steps int) *insolar.ID {
-	// TODO: resurrect this part
-	return nil
+	requests := c.CaseBind.Requests
+	if len(requests) == 0 {
+		return nil
+	}
+	if steps > len(requests) {
+		steps = len(requests)
+	}
+	if steps == 0 {
+		return requests[0].State
+	}
+	return requests[steps-1].Result
 }
@@ -0,0 +1,144 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// HandleDryRunMethodMessage executes method on the current state of object. Request isn't registered,
+// object state isn't saved, reply contains result of the call and changes of objects memory made by the
+// dry-run so far. Objects changed by previous calls of the dry-run are taken from the overlay of the message.
+func (lr *LogicRunner) HandleDryRunMethodMessage(ctx context.Context, inmsg insolar.Parcel) (insolar.Reply, error) {
+	ctx = loggerWithTargetID(ctx, inmsg)
+	inslogger.FromContext(ctx).Debug("LogicRunner.HandleDryRunMethodMessage starts ...")
+	msg, ok := inmsg.Message().(*message.DryRunMethod)
+	if !ok {
+		return nil, errors.New("Execute( ! message.DryRunMethod )")
+	}
+	if msg.CallType != record.CTMethod {
+		return nil, errors.New("only methods can be called in dry-run mode")
+	}
+
+	procCheckRole := CheckOurRole{
+		msg:  msg,
+		role: insolar.DynamicRoleVirtualExecutor,
+		lr:   lr,
+	}
+	if err := procCheckRole.Proceed(ctx); err != nil {
+		return nil, errors.Wrap(err, "[ HandleDryRunMethodMessage ] can't play role")
+	}
+
+	ref := msg.GetReference()
+	request := msg.RequestRef(lr.pulse(ctx).PulseNumber)
+	root := *request
+	if msg.Root != nil {
+		root = *msg.Root
+	}
+	overlay := newDryRunOverlay(root, msg.Overlay)
+
+	// every dry-run call has its own state, so nested calls of the same object don't wait for each other
+	os := lr.UpsertObjectState(ref)
+	ds := os.StartDryRun(ref, *request, overlay)
+	defer os.FinishDryRun(*request)
+
+	sender := inmsg.GetSender()
+	ds.Current = &CurrentExecution{
+		Context:       ctx,
+		Request:       request,
+		RequesterNode: &sender,
+		ReturnMode:    msg.ReturnMode,
+		Sequence:      msg.Sequence,
+	}
+
+	re, err := lr.execute(ctx, ds, &msg.CallMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	if ds.deactivate || !bytes.Equal(ds.objectbody.Object, ds.Current.Memory) {
+		diff := insolar.StateDiff{Object: ref, Before: ds.objectbody.Object}
+		if !ds.deactivate {
+			diff.After = ds.Current.Memory
+		}
+		overlay.merge([]insolar.StateDiff{diff})
+	}
+	return &reply.DryRun{
+		Result: re.(*reply.CallMethod).Result,
+		Diff:   overlay.diff(),
+	}, nil
+}
+
+// dryRunOverlay contains objects changed by a dry-run call and its nested calls, they are kept in memory only.
+// Overlay belongs to the root request of the dry-run and is passed to nested calls with their messages.
+type dryRunOverlay struct {
+	root  Ref
+	diffs []insolar.StateDiff
+}
+
+func newDryRunOverlay(root Ref, diffs []insolar.StateDiff) *dryRunOverlay {
+	o := &dryRunOverlay{root: root}
+	o.merge(diffs)
+	return o
+}
+
+// load replaces memory of the object from ledger with memory changed by the dry-run
+func (o *dryRunOverlay) load(object Ref, body *ObjectBody) error {
+	d := o.find(object)
+	if d == nil {
+		return nil
+	}
+	if d.After == nil {
+		return errors.New("object is deactivated")
+	}
+	body.Object = d.After
+	return nil
+}
+
+// merge adds changes of objects, memory of object before the dry-run is kept from its first change
+func (o *dryRunOverlay) merge(diffs []insolar.StateDiff) {
+	for _, d := range diffs {
+		if prev := o.find(d.Object); prev != nil {
+			prev.After = d.After
+			continue
+		}
+		o.diffs = append(o.diffs, d)
+	}
+}
+
+// diff returns all changes made by the dry-run in order of objects first change
+func (o *dryRunOverlay) diff() []insolar.StateDiff {
+	return append([]insolar.StateDiff(nil), o.diffs...)
+}
+
+func (o *dryRunOverlay) find(object Ref) *insolar.StateDiff {
+	for i := range o.diffs {
+		if o.diffs[i].Object.Equal(object) {
+			return &o.diffs[i]
+		}
+	}
+	return nil
+}
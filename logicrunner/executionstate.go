@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
 )
 
@@ -33,6 +34,15 @@ type ExecutionState struct {
 	deactivate bool
 	nonce      uint64
 
+	// CaseBind collects requests executed on this pulse, it's sent to validators on pulse change
+	CaseBind *CaseBind
+	// replay is set in validation mode only
+	replay *CaseBindReplay
+	// dryRun is set for state of dry-run calls, nothing is saved on ledger in this mode
+	dryRun bool
+	// overlay contains objects changed by the dry-run, set in dry-run mode only
+	overlay *dryRunOverlay
+
 	Current               *CurrentExecution
 	Queue                 []ExecutionQueueElement
 	QueueProcessorActive  bool
@@ -62,6 +72,68 @@ func (es *ExecutionState) WrapError(err error, message string) error {
 	return res
 }
 
+// addCaseRequest records executed request for validators, must be calling only with es.Lock
+func (es *ExecutionState) addCaseRequest(parcel insolar.Parcel, re insolar.Reply, errstr string) {
+	if es.CaseBind == nil {
+		es.CaseBind = NewCaseBind()
+		es.CaseBind.Pulse = es.Current.LogicContext.Pulse
+	}
+
+	req := es.CaseBind.NewRequest(parcel, *es.Current.Request, nil)
+	req.Reply = re
+	req.Error = errstr
+	req.State = es.Current.State
+	req.Result = es.Current.Result
+	req.Outgoing = es.Current.Outgoing
+}
+
+// outgoingCall makes a call on behalf of current execution and records its result. In validation
+// mode the call isn't made, result recorded by executor is returned instead.
+func (es *ExecutionState) outgoingCall(
+	object Ref, method string, args []byte, call func() ([]byte, *Ref, error),
+) (
+	[]byte, *Ref, error,
+) {
+	if es.replay != nil {
+		o, err := es.replay.replayOutgoing(object, method, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return o.Result, o.Reference, o.err()
+	}
+
+	result, ref, err := call()
+	o := CaseOutgoing{
+		Object:    object,
+		Method:    method,
+		Arguments: args,
+		Result:    result,
+		Reference: ref,
+	}
+	if err != nil {
+		o.Error = err.Error()
+	}
+	es.Current.Outgoing = append(es.Current.Outgoing, o)
+	return result, ref, err
+}
+
+// upcall checks that a call from contract code to the platform is made by request being executed and counts it within
+// budget of current execution. Upcalls of calls, that are finished or aborted due to timeout, are rejected.
+func (es *ExecutionState) upcall(request Ref, outgoing bool) error {
+	if es.Current == nil || es.Current.Request == nil || !es.Current.Request.Equal(request) {
+		return errors.Errorf("upcall of request %s, that is not executed now", request.String())
+	}
+	lc := es.Current.LogicContext
+	upcall := insolar.CallConsumption{Upcalls: 1}
+	if outgoing {
+		upcall.OutgoingCalls = 1
+	}
+	if lc.Budget.Exceeded(lc.Consume(upcall)) {
+		return insolar.ErrBudgetExceeded
+	}
+	return nil
+}
+
 // releaseQueue must be calling only with es.Lock
 func (es *ExecutionState) releaseQueue() ([]ExecutionQueueElement, bool) {
 	ledgerHasMoreRequest := false
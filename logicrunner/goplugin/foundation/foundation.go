@@ -26,6 +26,8 @@ import (
 
 // BaseContract is a base class for all contracts.
 type BaseContract struct {
+	// CodeVersion is version of contract code the state is saved by, it's maintained by generated wrapper
+	CodeVersion uint
 }
 
 // ProxyInterface interface any proxy of a contract implements
@@ -99,6 +101,47 @@ func (bc *BaseContract) SelfDestruct() error {
 	return proxyctx.Current.DeactivateObject(bc.GetReference())
 }
 
+// EmitEvent emits event with serialized payload, events are saved with result of the call
+//    foundation.EmitEvent("Transfer", map[string]string{"to": to, "amount": amount})
+func EmitEvent(name string, payload interface{}) error {
+	var data []byte
+	err := proxyctx.Current.Serialize(payload, &data)
+	if err != nil {
+		return err
+	}
+	return proxyctx.Current.EmitEvent(name, data)
+}
+
+// MigrateState rolls object forward to the latest code of its prototype, state saved by previous
+// versions of the code is converted by Migrate function of the contract
+func MigrateState(object, prototype insolar.Reference) error {
+	var args [0]interface{}
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(object, true, false, "MigrateState", argsSerialized, prototype)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
 // Error elementary string based error struct satisfying builtin error interface
 //    foundation.Error{"some err"}
 type Error struct {
@@ -467,6 +467,32 @@ func (gi *GoInsider) DeactivateObject(object insolar.Reference) error {
 	return nil
 }
 
+// EmitEvent ...
+func (gi *GoInsider) EmitEvent(name string, payload []byte) error {
+	client, err := gi.Upstream()
+	if err != nil {
+		return err
+	}
+
+	req := rpctypes.UpEmitEventReq{
+		UpBaseReq: MakeUpBaseReq(),
+		Name:      name,
+		Payload:   payload,
+	}
+
+	res := rpctypes.UpEmitEventResp{}
+	err = client.Call("RPC.EmitEvent", req, &res)
+	if err != nil {
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return errors.Wrap(err, "[ EmitEvent ] on calling main API")
+	}
+
+	return nil
+}
+
 // Serialize - CBOR serializer wrapper: `what` -> `to`
 func (gi *GoInsider) Serialize(what interface{}, to *[]byte) error {
 	ch := new(codec.CborHandle)
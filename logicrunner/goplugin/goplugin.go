@@ -54,6 +54,8 @@ type GoPlugin struct {
 	Cfg             *configuration.LogicRunner
 	MessageBus      insolar.MessageBus
 	ArtifactManager artifacts.Client
+	// Workers is a pool of supervised `insgorund` processes, calls go to single `insgorund` if it's nil
+	Workers *Pool
 
 	clientMutex sync.Mutex
 	client      *rpc.Client
@@ -137,6 +139,15 @@ func (gp *GoPlugin) callClientWithReconnect(ctx context.Context, method string,
 	return err
 }
 
+func (gp *GoPlugin) callClient(
+	ctx context.Context, callContext *insolar.LogicCallContext, method string, req interface{}, res interface{},
+) error {
+	if gp.Workers != nil {
+		return gp.Workers.Call(ctx, callContext, method, req, res)
+	}
+	return gp.callClientWithReconnect(ctx, method, req, res)
+}
+
 type CallMethodResult struct {
 	Response rpctypes.DownCallMethodResp
 	Error    error
@@ -145,7 +156,7 @@ type CallMethodResult struct {
 func (gp *GoPlugin) CallMethodRPC(ctx context.Context, req rpctypes.DownCallMethodReq, res rpctypes.DownCallMethodResp, resultChan chan CallMethodResult) {
 	inslogger.FromContext(ctx).Debug("GoPlugin.CallMethodRPC starts ...")
 	method := "RPC.CallMethod"
-	callClientError := gp.callClientWithReconnect(ctx, method, req, &res)
+	callClientError := gp.callClient(ctx, req.Context, method, req, &res)
 	resultChan <- CallMethodResult{Response: res, Error: callClientError}
 }
 
@@ -176,7 +187,7 @@ func (gp *GoPlugin) CallMethod(
 		Arguments: args,
 	}
 
-	resultChan := make(chan CallMethodResult)
+	resultChan := make(chan CallMethodResult, 1)
 	go gp.CallMethodRPC(ctx, req, res, resultChan)
 
 	select {
@@ -185,6 +196,8 @@ func (gp *GoPlugin) CallMethod(
 			return nil, nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
 		return callResult.Response.Data, callResult.Response.Ret, nil
+	case <-ctx.Done():
+		return nil, nil, errors.Wrap(ctx.Err(), "logicrunner execution is cancelled")
 	case <-time.After(timeout):
 		return nil, nil, errors.New("logicrunner execution timeout")
 	}
@@ -197,7 +210,7 @@ type CallConstructorResult struct {
 
 func (gp *GoPlugin) CallConstructorRPC(ctx context.Context, req rpctypes.DownCallConstructorReq, res rpctypes.DownCallConstructorResp, resultChan chan CallConstructorResult) {
 	method := "RPC.CallConstructor"
-	callClientError := gp.callClientWithReconnect(ctx, method, req, &res)
+	callClientError := gp.callClient(ctx, req.Context, method, req, &res)
 	resultChan <- CallConstructorResult{Response: res, Error: callClientError}
 }
 
@@ -217,7 +230,7 @@ func (gp *GoPlugin) CallConstructor(
 		Arguments: args,
 	}
 
-	resultChan := make(chan CallConstructorResult)
+	resultChan := make(chan CallConstructorResult, 1)
 	go gp.CallConstructorRPC(ctx, req, res, resultChan)
 
 	select {
@@ -226,6 +239,8 @@ func (gp *GoPlugin) CallConstructor(
 			return nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
 		return callResult.Response.Ret, nil
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "logicrunner execution is cancelled")
 	case <-time.After(timeout):
 		return nil, errors.New("logicrunner execution timeout")
 	}
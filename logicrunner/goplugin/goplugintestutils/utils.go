@@ -18,6 +18,7 @@ package goplugintestutils
 
 import (
 	"context"
+	"fmt"
 	"go/build"
 	"io/ioutil"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -167,6 +169,21 @@ func (t *TestArtifactManager) GetChildren(ctx context.Context, parent insolar.Re
 	panic("implement me")
 }
 
+// GetObjectHistory implementation for tests
+func (t *TestArtifactManager) GetObjectHistory(ctx context.Context, head insolar.Reference, from *insolar.ID, amount int) ([]artifacts.ObjectState, *insolar.ID, error) {
+	panic("implement me")
+}
+
+// GetObjectRequests implementation for tests
+func (t *TestArtifactManager) GetObjectRequests(ctx context.Context, obj insolar.Reference, from *insolar.ID, amount int) ([]artifacts.RequestResult, *insolar.ID, error) {
+	panic("implement me")
+}
+
+// GetObjectEvents implementation for tests
+func (t *TestArtifactManager) GetObjectEvents(ctx context.Context, obj insolar.Reference, filter artifacts.EventsFilter, from *insolar.ID, amount int) ([]artifacts.ObjectEvent, *insolar.ID, error) {
+	panic("implement me")
+}
+
 // NewTestArtifactManager implementation for tests
 func NewTestArtifactManager() *TestArtifactManager {
 	return &TestArtifactManager{
@@ -189,6 +206,13 @@ func (t *TestArtifactManager) RegisterResult(
 	panic("implement me")
 }
 
+// RegisterResultWithEvents saves VM method call result with events.
+func (t *TestArtifactManager) RegisterResultWithEvents(
+	ctx context.Context, object, request insolar.Reference, payload []byte, events []insolar.ContractEvent,
+) (*insolar.ID, error) {
+	panic("implement me")
+}
+
 // GetObject implementation for tests
 func (t *TestArtifactManager) GetObject(ctx context.Context, object insolar.Reference) (artifacts.ObjectDescriptor, error) {
 	res, ok := t.Objects[object]
@@ -536,6 +560,84 @@ func (cb *ContractsBuilder) Build(contracts map[string]string) error {
 	return nil
 }
 
+// Upgrade builds new code of already built contract and registers it for the contract's prototype,
+// objects of the prototype migrate their state with Migrate function of the new code
+func (cb *ContractsBuilder) Upgrade(name string, code string) error {
+	ctx := context.TODO()
+
+	protoRef, ok := cb.Prototypes[name]
+	if !ok {
+		return errors.Errorf("[ Upgrade ] Contract %q has no prototype", name)
+	}
+
+	code = regexp.MustCompile(`package\s+\S+`).ReplaceAllString(code, "package main")
+	err := WriteFile(filepath.Join(cb.root, "src/contract", name), "main.go", code)
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't WriteFile")
+	}
+	err = cb.proxy(name)
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't call proxy")
+	}
+	err = cb.wrapper(name)
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't call wrapper")
+	}
+
+	// plugin with the same path can't be loaded twice, so new code gets its own one
+	log.Debugf("Building upgraded plugin for contract %q in %q", name, cb.root)
+	err = cb.plugin(name, fmt.Sprintf("-ldflags=-pluginpath=contract/%s/%d", name, time.Now().UnixNano()))
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't call plugin")
+	}
+
+	pluginBinary, err := ioutil.ReadFile(filepath.Join(cb.root, "plugins", name+".so"))
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't ReadFile")
+	}
+	nonce := testutils.RandomRef()
+	codeReq, err := cb.ArtifactManager.RegisterRequest(
+		ctx,
+		record.Request{
+			CallType:  record.CTSaveAsChild,
+			Prototype: &nonce,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't RegisterRequest")
+	}
+	codeID, err := cb.ArtifactManager.DeployCode(
+		ctx,
+		insolar.Reference{}, *insolar.NewReference(insolar.ID{}, *codeReq),
+		pluginBinary, insolar.MachineTypeGoPlugin,
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't DeployCode")
+	}
+	codeRef := &insolar.Reference{}
+	codeRef.SetRecord(*codeID)
+
+	protoDesc, err := cb.ArtifactManager.GetObject(ctx, *protoRef)
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't GetObject")
+	}
+	_, err = cb.ArtifactManager.UpdatePrototype(
+		ctx,
+		insolar.Reference{},
+		*insolar.NewReference(insolar.ID{}, *codeReq),
+		protoDesc,
+		protoDesc.Memory(),
+		codeRef,
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ Upgrade ] Can't UpdatePrototype")
+	}
+	log.Debugf("Upgraded prototype %q of contract %q to code %q", protoRef.String(), name, codeRef.String())
+	cb.Codes[name] = codeRef
+
+	return nil
+}
+
 func (cb *ContractsBuilder) proxy(name string) error {
 	dstDir := filepath.Join(cb.root, "src/github.com/insolar/insolar/application/proxy", name)
 
@@ -570,7 +672,7 @@ func (cb *ContractsBuilder) wrapper(name string) error {
 }
 
 // Plugin ...
-func (cb *ContractsBuilder) plugin(name string) error {
+func (cb *ContractsBuilder) plugin(name string, flags ...string) error {
 	dstDir := filepath.Join(cb.root, "plugins")
 
 	err := os.MkdirAll(dstDir, 0777)
@@ -578,12 +680,9 @@ func (cb *ContractsBuilder) plugin(name string) error {
 		return errors.Wrap(err, "[ plugin ]")
 	}
 
-	cmd := exec.Command(
-		"go", "build",
-		"-buildmode=plugin",
-		"-o", filepath.Join(dstDir, name+".so"),
-		filepath.Join(cb.root, "src/contract", name),
-	)
+	args := append([]string{"build", "-buildmode=plugin"}, flags...)
+	args = append(args, "-o", filepath.Join(dstDir, name+".so"), filepath.Join(cb.root, "src/contract", name))
+	cmd := exec.Command("go", args...)
 	cmd.Env = append(os.Environ(), "GOPATH="+PrependGoPath(cb.root))
 	out, err := cmd.CombinedOutput()
 	if err != nil {
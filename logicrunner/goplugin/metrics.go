@@ -26,6 +26,7 @@ import (
 
 var (
 	tagMethodName = insmetrics.MustTagKey("methodName")
+	tagWorker     = insmetrics.MustTagKey("worker")
 )
 
 var (
@@ -34,6 +35,16 @@ var (
 		"time spent on execution contract, measured in goplugin",
 		stats.UnitMilliseconds,
 	)
+	statWorkerRestarts = stats.Int64(
+		"goplugin/worker/restarts",
+		"restarts of crashed insgorund workers",
+		stats.UnitDimensionless,
+	)
+	statWorkerAlive = stats.Int64(
+		"goplugin/worker/alive",
+		"insgorund worker is running",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -43,6 +54,16 @@ func init() {
 			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
 			TagKeys:     []tag.Key{tagMethodName},
 		},
+		&view.View{
+			Measure:     statWorkerRestarts,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagWorker},
+		},
+		&view.View{
+			Measure:     statWorkerAlive,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{tagWorker},
+		},
 	)
 	if err != nil {
 		panic(err)
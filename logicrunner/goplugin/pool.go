@@ -0,0 +1,397 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package goplugin
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+// Calls are routed to workers by one of these references.
+const (
+	RouteByObject    = "object"
+	RouteByPrototype = "prototype"
+)
+
+const (
+	dialAttempts = 50
+	dialInterval = 100 * time.Millisecond
+)
+
+// WorkerHealth is a state of one `insgorund` worker.
+type WorkerHealth struct {
+	ID       int
+	Address  string
+	PID      int
+	Alive    bool
+	Restarts int
+}
+
+// Pool supervises `insgorund` workers of the node. Every worker is a separate process, so contract
+// crashing or leaking memory in one of them doesn't affect calls routed to others.
+type Pool struct {
+	cfg              configuration.GoPluginWorkers
+	upstreamProtocol string
+	upstreamAddress  string
+
+	lock    sync.RWMutex
+	dir     string
+	workers []*worker
+}
+
+// NewPool creates pool of workers configured in logic runner configuration.
+func NewPool(cfg configuration.LogicRunner) (*Pool, error) {
+	if cfg.GoPlugin == nil || cfg.GoPlugin.Workers == nil {
+		return nil, errors.New("workers are not configured")
+	}
+	workers := *cfg.GoPlugin.Workers
+	if workers.Command == "" {
+		return nil, errors.New("command of workers is required")
+	}
+	if workers.Count <= 0 {
+		return nil, errors.New("count of workers should be positive")
+	}
+	switch workers.RouteBy {
+	case RouteByObject, RouteByPrototype:
+	default:
+		return nil, errors.Errorf("unknown reference to route calls by %q", workers.RouteBy)
+	}
+
+	return &Pool{
+		cfg:              workers,
+		upstreamProtocol: cfg.RPCProtocol,
+		upstreamAddress:  cfg.RPCListen,
+	}, nil
+}
+
+// Start starts workers.
+func (p *Pool) Start(ctx context.Context) error {
+	dir, err := ioutil.TempDir("", "insgorund-")
+	if err != nil {
+		return errors.Wrap(err, "couldn't create directory of workers")
+	}
+
+	p.lock.Lock()
+	p.dir = dir
+	for i := 0; i < p.cfg.Count; i++ {
+		p.workers = append(p.workers, &worker{
+			pool:    p,
+			id:      i,
+			address: filepath.Join(dir, fmt.Sprintf("worker-%d.sock", i)),
+			codeDir: filepath.Join(dir, fmt.Sprintf("code-%d", i)),
+		})
+	}
+	workers := p.workers
+	p.lock.Unlock()
+
+	for i, w := range workers {
+		err := w.start(ctx)
+		if err != nil {
+			stopErr := p.Stop(ctx)
+			if stopErr != nil {
+				inslogger.FromContext(ctx).Error("couldn't stop workers: ", stopErr)
+			}
+			return errors.Wrapf(err, "couldn't start worker %d", i)
+		}
+	}
+	return nil
+}
+
+// Stop kills workers.
+func (p *Pool) Stop(ctx context.Context) error {
+	p.lock.Lock()
+	workers, dir := p.workers, p.dir
+	p.workers = nil
+	p.lock.Unlock()
+
+	for _, w := range workers {
+		w.stop(ctx)
+	}
+	return os.RemoveAll(dir)
+}
+
+// Health returns state of workers.
+func (p *Pool) Health() []WorkerHealth {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	res := make([]WorkerHealth, len(p.workers))
+	for i, w := range p.workers {
+		res[i] = w.health()
+	}
+	return res
+}
+
+// Call makes RPC call on worker responsible for the contract. Call running in worker can't be interrupted, so
+// when context is done before the call returns, the worker is killed and supervisor starts it again.
+func (p *Pool) Call(
+	ctx context.Context, callContext *insolar.LogicCallContext, method string, req interface{}, res interface{},
+) error {
+	p.lock.RLock()
+	if len(p.workers) == 0 {
+		p.lock.RUnlock()
+		return errors.New("workers are not started")
+	}
+	w := p.workers[p.route(callContext)]
+	p.lock.RUnlock()
+
+	client, cmd, err := w.dial()
+	if err != nil {
+		return err
+	}
+
+	var call *rpc.Call
+	select {
+	case call = <-client.Go(method, req, res, nil).Done:
+	case <-ctx.Done():
+		w.kill(ctx, client, cmd)
+		return errors.Wrapf(ctx.Err(), "worker %d is killed as call is running too long", w.id)
+	}
+	if call.Error == rpc.ErrShutdown || call.Error == io.ErrUnexpectedEOF {
+		// process is likely crashed, supervisor restarts it
+		w.closeClient(client)
+		return errors.Wrapf(call.Error, "worker %d failed", w.id)
+	}
+	return call.Error
+}
+
+// route must be called with p.lock held
+func (p *Pool) route(callContext *insolar.LogicCallContext) int {
+	ref := callContext.Prototype
+	if p.cfg.RouteBy == RouteByObject {
+		ref = callContext.Callee
+	}
+	if ref == nil {
+		return 0
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write(ref.Bytes())
+	return int(h.Sum32() % uint32(len(p.workers)))
+}
+
+type worker struct {
+	pool    *Pool
+	id      int
+	address string
+	codeDir string
+
+	lock     sync.Mutex
+	cmd      *exec.Cmd
+	client   *rpc.Client
+	alive    bool
+	stopped  bool
+	restarts int
+}
+
+func (w *worker) start(ctx context.Context) error {
+	err := os.MkdirAll(w.codeDir, 0700)
+	if err != nil {
+		return errors.Wrap(err, "couldn't create code directory")
+	}
+
+	args := []string{
+		"-l", w.address,
+		"--proto", "unix",
+		"-d", w.codeDir,
+		"--rpc", w.pool.upstreamAddress,
+		"--rpc-proto", w.pool.upstreamProtocol,
+	}
+	if w.pool.cfg.MemoryLimit > 0 {
+		args = append(args, "--memory-limit", strconv.FormatUint(w.pool.cfg.MemoryLimit, 10))
+	}
+
+	cmd := exec.Command(w.pool.cfg.Command, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.stopped {
+		return nil
+	}
+	err = cmd.Start()
+	if err != nil {
+		return errors.Wrap(err, "couldn't start process")
+	}
+	w.cmd = cmd
+	w.alive = true
+	w.recordAlive(ctx)
+
+	go w.supervise(ctx, cmd)
+	return nil
+}
+
+// supervise waits for process of the worker and starts it again if it wasn't stopped.
+func (w *worker) supervise(ctx context.Context, cmd *exec.Cmd) {
+	err := cmd.Wait()
+
+	w.lock.Lock()
+	w.alive = false
+	w.recordAlive(ctx)
+	if w.client != nil {
+		w.client.Close() // nolint: errcheck
+		w.client = nil
+	}
+	stopped := w.stopped
+	w.lock.Unlock()
+
+	if stopped {
+		return
+	}
+
+	logger := inslogger.FromContext(ctx)
+	logger.Errorf("worker %d exited: %v", w.id, err)
+	for {
+		time.Sleep(w.pool.cfg.RestartDelay)
+
+		w.lock.Lock()
+		stopped := w.stopped
+		w.restarts++
+		w.lock.Unlock()
+		if stopped {
+			return
+		}
+
+		stats.Record(w.tagged(ctx), statWorkerRestarts.M(1))
+		err := w.start(ctx)
+		if err == nil {
+			return
+		}
+		logger.Error("couldn't restart worker: ", err)
+	}
+}
+
+func (w *worker) stop(ctx context.Context) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.stopped = true
+	if w.client != nil {
+		w.client.Close() // nolint: errcheck
+		w.client = nil
+	}
+	if w.alive && w.cmd != nil {
+		err := w.cmd.Process.Kill()
+		if err != nil {
+			inslogger.FromContext(ctx).Errorf("couldn't kill worker %d: %v", w.id, err)
+		}
+	}
+}
+
+// dial returns client of the worker and its process, it waits for worker to be started.
+func (w *worker) dial() (*rpc.Client, *exec.Cmd, error) {
+	for attempt := 0; ; attempt++ {
+		w.lock.Lock()
+		if w.client != nil {
+			client, cmd := w.client, w.cmd
+			w.lock.Unlock()
+			return client, cmd, nil
+		}
+		if w.alive {
+			client, err := rpc.Dial("unix", w.address)
+			if err == nil {
+				w.client = client
+				cmd := w.cmd
+				w.lock.Unlock()
+				return client, cmd, nil
+			}
+		}
+		w.lock.Unlock()
+
+		if attempt >= dialAttempts {
+			return nil, nil, errors.Errorf("worker %d is not available", w.id)
+		}
+		time.Sleep(dialInterval)
+	}
+}
+
+// kill kills process of the worker running a call through client, supervisor starts it again.
+func (w *worker) kill(ctx context.Context, client *rpc.Client, cmd *exec.Cmd) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	client.Close() // nolint: errcheck
+	if w.client == client {
+		w.client = nil
+	}
+	// process could be already restarted, then the new one is left running
+	if w.stopped || !w.alive || w.cmd != cmd {
+		return
+	}
+	err := cmd.Process.Kill()
+	if err != nil {
+		inslogger.FromContext(ctx).Errorf("couldn't kill worker %d: %v", w.id, err)
+	}
+}
+
+func (w *worker) closeClient(client *rpc.Client) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	client.Close() // nolint: errcheck
+	if w.client == client {
+		w.client = nil
+	}
+}
+
+func (w *worker) health() WorkerHealth {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	res := WorkerHealth{
+		ID:       w.id,
+		Address:  w.address,
+		Alive:    w.alive,
+		Restarts: w.restarts,
+	}
+	if w.alive && w.cmd != nil {
+		res.PID = w.cmd.Process.Pid
+	}
+	return res
+}
+
+// recordAlive must be called with w.lock held
+func (w *worker) recordAlive(ctx context.Context) {
+	var alive int64
+	if w.alive {
+		alive = 1
+	}
+	stats.Record(w.tagged(ctx), statWorkerAlive.M(alive))
+}
+
+func (w *worker) tagged(ctx context.Context) context.Context {
+	return insmetrics.InsertTag(ctx, tagWorker, strconv.Itoa(w.id))
+}
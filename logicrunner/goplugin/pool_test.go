@@ -0,0 +1,177 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package goplugin
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/testutils"
+)
+
+func poolConfig(workers *configuration.GoPluginWorkers) configuration.LogicRunner {
+	cfg := configuration.NewLogicRunner()
+	cfg.GoPlugin.Workers = workers
+	return cfg
+}
+
+func TestNewPool(t *testing.T) {
+	_, err := NewPool(poolConfig(nil))
+	require.Error(t, err)
+
+	_, err = NewPool(poolConfig(&configuration.GoPluginWorkers{Count: 1, RouteBy: RouteByObject}))
+	require.Error(t, err)
+
+	_, err = NewPool(poolConfig(&configuration.GoPluginWorkers{Command: "insgorund", RouteBy: RouteByObject}))
+	require.Error(t, err)
+
+	_, err = NewPool(poolConfig(&configuration.GoPluginWorkers{Command: "insgorund", Count: 1, RouteBy: "node"}))
+	require.Error(t, err)
+
+	_, err = NewPool(poolConfig(&configuration.GoPluginWorkers{Command: "insgorund", Count: 1, RouteBy: RouteByPrototype}))
+	require.NoError(t, err)
+}
+
+func TestPool_Route(t *testing.T) {
+	pool, err := NewPool(poolConfig(&configuration.GoPluginWorkers{Command: "insgorund", Count: 4, RouteBy: RouteByObject}))
+	require.NoError(t, err)
+	pool.workers = make([]*worker, 4)
+
+	callee := testutils.RandomRef()
+	callContext := &insolar.LogicCallContext{Callee: &callee}
+	idx := pool.route(callContext)
+	assert.True(t, idx >= 0 && idx < 4)
+	for i := 0; i < 10; i++ {
+		prototype := testutils.RandomRef()
+		callContext.Prototype = &prototype
+		assert.Equal(t, idx, pool.route(callContext))
+	}
+
+	assert.Equal(t, 0, pool.route(&insolar.LogicCallContext{}))
+}
+
+func TestPool_RestartsExitedWorker(t *testing.T) {
+	command, err := exec.LookPath("true")
+	if err != nil {
+		t.Skip("no `true` command in PATH")
+	}
+
+	pool, err := NewPool(poolConfig(&configuration.GoPluginWorkers{
+		Command:      command,
+		Count:        2,
+		RouteBy:      RouteByObject,
+		RestartDelay: 10 * time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, pool.Start(ctx))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		restarted := true
+		for _, h := range pool.Health() {
+			restarted = restarted && h.Restarts > 0
+		}
+		if restarted {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "workers were not restarted")
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.NoError(t, pool.Stop(ctx))
+	assert.Empty(t, pool.Health())
+}
+
+type hangingService struct {
+	release chan struct{}
+}
+
+func (s *hangingService) Call(req int, res *int) error {
+	<-s.release
+	return nil
+}
+
+func TestPool_KillsWorkerOnDeadline(t *testing.T) {
+	command, err := exec.LookPath("sleep")
+	if err != nil {
+		t.Skip("no `sleep` command in PATH")
+	}
+	restartCommand, err := exec.LookPath("true")
+	if err != nil {
+		t.Skip("no `true` command in PATH")
+	}
+
+	pool, err := NewPool(poolConfig(&configuration.GoPluginWorkers{
+		Command:      restartCommand,
+		Count:        1,
+		RouteBy:      RouteByObject,
+		RestartDelay: 10 * time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "pool-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	// worker process doesn't serve calls itself, calls hang in the listener of the test instead
+	service := &hangingService{release: make(chan struct{})}
+	defer close(service.release)
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("Hanging", service))
+	address := filepath.Join(dir, "worker.sock")
+	listener, err := net.Listen("unix", address)
+	require.NoError(t, err)
+	defer listener.Close() // nolint: errcheck
+	go server.Accept(listener)
+
+	ctx := context.Background()
+	cmd := exec.Command(command, "60")
+	require.NoError(t, cmd.Start())
+	w := &worker{pool: pool, address: address, codeDir: dir, cmd: cmd, alive: true}
+	pool.workers = []*worker{w}
+	pool.dir = dir
+	go w.supervise(ctx, cmd)
+
+	callCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	var res int
+	err = pool.Call(callCtx, &insolar.LogicCallContext{}, "Hanging.Call", 1, &res)
+	require.Error(t, err)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Health()[0].Restarts == 0 {
+		require.True(t, time.Now().Before(deadline), "worker was not killed")
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NotNil(t, cmd.ProcessState, "hanging process is not finished")
+
+	require.NoError(t, pool.Stop(ctx))
+}
@@ -28,6 +28,7 @@ type ProxyHelper interface {
 	SaveAsDelegate(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error)
 	GetDelegate(object, ofType insolar.Reference) (insolar.Reference, error)
 	DeactivateObject(object insolar.Reference) error
+	EmitEvent(name string, payload []byte) error
 	Serialize(what interface{}, to *[]byte) error
 	Deserialize(from []byte, into interface{}) error
 	MakeErrorSerializable(error) error
@@ -161,3 +161,14 @@ type UpDeactivateObjectReq struct {
 // UpDeactivateObjectResp is response from DeactivateObject RPC in goplugin
 type UpDeactivateObjectResp struct {
 }
+
+// UpEmitEventReq is a set of arguments for EmitEvent RPC in goplugin
+type UpEmitEventReq struct {
+	UpBaseReq
+	Name    string
+	Payload []byte
+}
+
+// UpEmitEventResp is response from EmitEvent RPC in goplugin
+type UpEmitEventResp struct {
+}
@@ -120,6 +120,14 @@ func (h *HandleExecutorResults) realHandleExecutorState(ctx context.Context, f f
 	// another one is about prepare state on new executor after pulse
 	// TODO make it in different goroutines
 
+	// requests executed by previous executor, results of validators are compared with them
+	if len(msg.Requests) > 0 {
+		err := h.dep.lr.GetConsensus(ctx, msg.GetReference()).AddExecutor(ctx, parcel, msg)
+		if err != nil {
+			return errors.Wrap(err, "[ HandleExecutorResults ] Failed to add executor results")
+		}
+	}
+
 	// prepare state after previous executor
 	procInitializeExecutionState := initializeExecutionState{
 		LR:  h.dep.lr,
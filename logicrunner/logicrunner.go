@@ -54,7 +54,10 @@ import (
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/builtin"
+	"github.com/insolar/insolar/logicrunner/calltrace"
 	"github.com/insolar/insolar/logicrunner/goplugin"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/wasm"
 )
 
 const maxQueueLength = 10
@@ -67,6 +70,7 @@ type ObjectState struct {
 
 	ExecutionState *ExecutionState
 	Validation     *ExecutionState
+	DryRun         map[Ref]*ExecutionState
 	Consensus      *Consensus
 }
 
@@ -78,6 +82,12 @@ type CurrentExecution struct {
 	RequesterNode *Ref
 	ReturnMode    record.Request_RM
 	SentResult    bool
+
+	State    *insolar.ID
+	Result   *insolar.ID
+	Memory   []byte
+	Outgoing []CaseOutgoing
+	Events   []insolar.ContractEvent
 }
 
 type ExecutionQueueElement struct {
@@ -111,12 +121,17 @@ func (lre Error) Error() string {
 	return buffer.String()
 }
 
-func (st *ObjectState) MustModeState(mode string) (res *ExecutionState) {
+// MustModeState returns state executing the request in provided mode
+func (st *ObjectState) MustModeState(mode string, request Ref) (res *ExecutionState) {
 	switch mode {
 	case "execution":
 		res = st.ExecutionState
 	case "validation":
 		res = st.Validation
+	case "dryrun":
+		st.Lock()
+		res = st.DryRun[request]
+		st.Unlock()
 	default:
 		panic("'" + mode + "' is unknown object processing mode")
 	}
@@ -156,6 +171,11 @@ type LogicRunner struct {
 	ArtifactManager            artifacts.Client                   `inject:""`
 	JetCoordinator             jet.Coordinator                    `inject:""`
 
+	// Workers is an optional pool of `insgorund` processes used by goplugin executor
+	Workers *goplugin.Pool
+	// CallTracer keeps recent calls executed by the node linked by requests they were made from
+	CallTracer *calltrace.Tracer
+
 	Executors    [insolar.MachineTypesLastID]insolar.MachineLogicExecutor
 	machinePrefs []insolar.MachineType
 	Cfg          *configuration.LogicRunner
@@ -183,8 +203,9 @@ func NewLogicRunner(cfg *configuration.LogicRunner) (*LogicRunner, error) {
 		return nil, errors.New("LogicRunner have nil configuration")
 	}
 	res := LogicRunner{
-		Cfg:   cfg,
-		state: make(map[Ref]*ObjectState),
+		Cfg:        cfg,
+		state:      make(map[Ref]*ObjectState),
+		CallTracer: calltrace.New(calltrace.DefaultLimit),
 	}
 
 	err := initHandlers(&res)
@@ -259,7 +280,7 @@ func initHandlers(lr *LogicRunner) error {
 func (lr *LogicRunner) Start(ctx context.Context) error {
 	if lr.Cfg.BuiltIn != nil {
 		bi := builtin.NewBuiltIn(lr.MessageBus, lr.ArtifactManager)
-		if err := lr.RegisterExecutor(insolar.MachineTypeBuiltin, bi); err != nil {
+		if err := lr.RegisterExecutor(insolar.MachineTypeBuiltin, NewMeteredExecutor("builtin", bi)); err != nil {
 			return err
 		}
 		lr.machinePrefs = append(lr.machinePrefs, insolar.MachineTypeBuiltin)
@@ -274,12 +295,21 @@ func (lr *LogicRunner) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		if err := lr.RegisterExecutor(insolar.MachineTypeGoPlugin, gp); err != nil {
+		gp.Workers = lr.Workers
+		if err := lr.RegisterExecutor(insolar.MachineTypeGoPlugin, NewMeteredExecutor("goplugin", gp)); err != nil {
 			return err
 		}
 		lr.machinePrefs = append(lr.machinePrefs, insolar.MachineTypeGoPlugin)
 	}
 
+	if lr.Cfg.WASM != nil {
+		w := wasm.NewWASM(*lr.Cfg.WASM, lr.ArtifactManager, &RPC{lr: lr})
+		if err := lr.RegisterExecutor(insolar.MachineTypeWASM, NewMeteredExecutor("wasm", w)); err != nil {
+			return err
+		}
+		lr.machinePrefs = append(lr.machinePrefs, insolar.MachineTypeWASM)
+	}
+
 	lr.RegisterHandlers()
 
 	return nil
@@ -290,6 +320,8 @@ func (lr *LogicRunner) RegisterHandlers() {
 	lr.MessageBus.MustRegister(insolar.TypeExecutorResults, lr.FlowDispatcher.WrapBusHandle)
 	lr.MessageBus.MustRegister(insolar.TypeValidateCaseBind, lr.HandleValidateCaseBindMessage)
 	lr.MessageBus.MustRegister(insolar.TypeValidationResults, lr.HandleValidationResultsMessage)
+	lr.MessageBus.MustRegister(insolar.TypeDryRunMethod, lr.HandleDryRunMethodMessage)
+	lr.MessageBus.MustRegister(insolar.TypeGetCallTrace, lr.HandleGetCallTraceMessage)
 	lr.MessageBus.MustRegister(insolar.TypePendingFinished, lr.FlowDispatcher.WrapBusHandle)
 	lr.MessageBus.MustRegister(insolar.TypeStillExecuting, lr.FlowDispatcher.WrapBusHandle)
 	lr.MessageBus.MustRegister(insolar.TypeAbandonedRequestsNotification, lr.FlowDispatcher.WrapBusHandle)
@@ -430,36 +462,31 @@ func (lr *LogicRunner) finishPendingIfNeeded(ctx context.Context, es *ExecutionS
 func (lr *LogicRunner) executeOrValidate(
 	ctx context.Context, es *ExecutionState, parcel insolar.Parcel,
 ) {
-	ctx, span := instracer.StartSpan(ctx, "LogicRunner.ExecuteOrValidate")
-	defer span.End()
-
 	msg := parcel.Message().(*message.CallMethod)
-	ref := msg.GetReference()
+	request := *es.Current.Request
 
-	es.Current.LogicContext = &insolar.LogicCallContext{
-		Mode:            "execution",
-		Caller:          msg.GetCaller(),
-		Callee:          &ref,
-		Request:         es.Current.Request,
-		Time:            time.Now(), // TODO: probably we should take it earlier
-		Pulse:           *lr.pulse(ctx),
-		TraceID:         inslogger.TraceID(ctx),
-		CallerPrototype: &msg.CallerPrototype,
+	ctx, span := instracer.StartSpan(ctx, "LogicRunner.ExecuteOrValidate")
+	span.AddAttributes(
+		trace.StringAttribute("request", request.String()),
+		trace.StringAttribute("object", msg.GetReference().String()),
+		trace.StringAttribute("method", msg.Method),
+	)
+	if msg.ParentRequest != nil {
+		span.AddAttributes(trace.StringAttribute("parentRequest", msg.ParentRequest.String()))
 	}
+	defer span.End()
 
-	var re insolar.Reply
-	var err error
-	switch msg.CallType {
-	case record.CTMethod:
-		es.Current.LogicContext.Immutable = msg.Immutable
-		re, err = lr.executeMethodCall(ctx, es, msg)
-
-	case record.CTSaveAsChild, record.CTSaveAsDelegate:
-		re, err = lr.executeConstructorCall(ctx, es, msg)
+	lr.CallTracer.Start(calltrace.Call{
+		Request:       request,
+		ParentRequest: msg.ParentRequest,
+		TraceID:       inslogger.TraceID(ctx),
+		Caller:        msg.Caller,
+		Object:        msg.GetReference(),
+		Method:        msg.Method,
+		Start:         time.Now(),
+	})
 
-	default:
-		panic("Unknown e type")
-	}
+	re, err := lr.execute(ctx, es, msg)
 	errstr := ""
 	if err != nil {
 		inslogger.FromContext(ctx).Warn("contract execution error: ", err)
@@ -469,13 +496,20 @@ func (lr *LogicRunner) executeOrValidate(
 	es.Lock()
 	defer es.Unlock()
 
+	outgoing := make([]calltrace.Outgoing, 0, len(es.Current.Outgoing))
+	for _, o := range es.Current.Outgoing {
+		outgoing = append(outgoing, calltrace.Outgoing{Object: o.Object, Method: o.Method, Error: o.Error})
+	}
+	lr.CallTracer.Finish(request, outgoing, errstr)
+
+	es.addCaseRequest(parcel, re, errstr)
+
 	es.Current.SentResult = true
 	if es.Current.ReturnMode != record.ReturnResult {
 		return
 	}
 
 	target := *es.Current.RequesterNode
-	request := *es.Current.Request
 	seq := es.Current.Sequence
 
 	go func() {
@@ -500,6 +534,59 @@ func (lr *LogicRunner) executeOrValidate(
 	}()
 }
 
+// execute runs request in mode of provided state, ledger isn't changed in validation and dry-run modes
+func (lr *LogicRunner) execute(
+	ctx context.Context, es *ExecutionState, msg *message.CallMethod,
+) (
+	insolar.Reply, error,
+) {
+	ref := msg.GetReference()
+
+	var mode string
+	var pulse insolar.Pulse
+	switch {
+	case es.replay != nil:
+		mode = "validation"
+		pulse = es.replay.Pulse
+	case es.dryRun:
+		mode = "dryrun"
+		pulse = *lr.pulse(ctx)
+	default:
+		mode = "execution"
+		pulse = *lr.pulse(ctx)
+	}
+
+	es.Current.LogicContext = &insolar.LogicCallContext{
+		Mode:            mode,
+		Caller:          msg.GetCaller(),
+		Callee:          &ref,
+		Request:         es.Current.Request,
+		ParentRequest:   msg.ParentRequest,
+		Time:            time.Now(), // TODO: probably we should take it earlier
+		Pulse:           pulse,
+		TraceID:         inslogger.TraceID(ctx),
+		CallerPrototype: &msg.CallerPrototype,
+		Budget: insolar.CallBudget{
+			Timeout:       lr.Cfg.Budget.Timeout,
+			Steps:         lr.Cfg.Budget.Steps,
+			Upcalls:       lr.Cfg.Budget.Upcalls,
+			OutgoingCalls: lr.Cfg.Budget.OutgoingCalls,
+		},
+	}
+
+	switch msg.CallType {
+	case record.CTMethod:
+		es.Current.LogicContext.Immutable = msg.Immutable
+		return lr.executeMethodCall(ctx, es, msg)
+
+	case record.CTSaveAsChild, record.CTSaveAsDelegate:
+		return lr.executeConstructorCall(ctx, es, msg)
+
+	default:
+		panic("Unknown e type")
+	}
+}
+
 func (lr *LogicRunner) getExecStateFromRef(ctx context.Context, rawRef []byte) *ExecutionState {
 	ref := Ref{}.FromSlice(rawRef)
 
@@ -607,6 +694,11 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 			CodeRef:         codeDesc.Ref(),
 			Parent:          objDesc.Parent(),
 		}
+		if es.overlay != nil {
+			if err := es.overlay.load(*m.Object, es.objectbody); err != nil {
+				return nil, es.WrapError(err, "couldn't get object state in dry-run")
+			}
+		}
 		inslogger.FromContext(ctx).Info("LogicRunner.executeMethodCall starts")
 	}
 
@@ -624,21 +716,35 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 		return nil, es.WrapError(err, "no executor registered")
 	}
 
+	if es.replay == nil {
+		es.Current.State = es.objectbody.objDescriptor.StateID()
+	}
+
 	newData, result, err := executor.CallMethod(
 		ctx, current.LogicContext, *es.objectbody.CodeRef, es.objectbody.Object, m.Method, m.Arguments,
 	)
 	if err != nil {
+		if errors.Cause(err) == insolar.ErrBudgetExceeded {
+			lr.registerBudgetExceeded(ctx, es, *m.Object, err)
+		}
 		return nil, es.WrapError(err, "executor error")
 	}
 
+	if es.replay != nil || es.dryRun {
+		es.Current.Memory = newData
+		return &reply.CallMethod{Result: result}, nil
+	}
+
 	am := lr.ArtifactManager
+	es.Current.Result = es.Current.State
 	if es.deactivate {
-		_, err := am.DeactivateObject(
+		id, err := am.DeactivateObject(
 			ctx, Ref{}, *current.Request, es.objectbody.objDescriptor,
 		)
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't deactivate object")
 		}
+		es.Current.Result = id
 	} else if !bytes.Equal(es.objectbody.Object, newData) {
 		od, err := am.UpdateObject(ctx, Ref{}, *current.Request, es.objectbody.objDescriptor, newData)
 		if err != nil {
@@ -648,8 +754,9 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 			return nil, es.WrapError(err, "couldn't update object")
 		}
 		es.objectbody.objDescriptor = od
+		es.Current.Result = od.StateID()
 	}
-	_, err = am.RegisterResult(ctx, *m.Object, *current.Request, result)
+	err = lr.registerResult(ctx, es, *m.Object, result)
 	if err != nil {
 		return nil, es.WrapError(err, "couldn't save results")
 	}
@@ -735,19 +842,28 @@ func (lr *LogicRunner) executeConstructorCall(
 
 	newData, err := executor.CallConstructor(ctx, current.LogicContext, *codeDesc.Ref(), m.Method, m.Arguments)
 	if err != nil {
+		if errors.Cause(err) == insolar.ErrBudgetExceeded {
+			lr.registerBudgetExceeded(ctx, es, *current.Request, err)
+		}
 		return nil, es.WrapError(err, "executer error")
 	}
 
 	switch m.CallType {
 	case record.CTSaveAsChild, record.CTSaveAsDelegate:
-		_, err = lr.ArtifactManager.ActivateObject(
+		if es.replay != nil {
+			es.Current.Memory = newData
+			return &reply.CallConstructor{Object: current.Request}, nil
+		}
+
+		od, err := lr.ArtifactManager.ActivateObject(
 			ctx,
 			Ref{}, *current.Request, *m.Base, *m.Prototype, m.CallType == record.CTSaveAsDelegate, newData,
 		)
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't activate object")
 		}
-		_, err = lr.ArtifactManager.RegisterResult(ctx, *current.Request, *current.Request, nil)
+		es.Current.Result = od.StateID()
+		err = lr.registerResult(ctx, es, *current.Request, nil)
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't save results")
 		}
@@ -758,6 +874,33 @@ func (lr *LogicRunner) executeConstructorCall(
 	}
 }
 
+// registerResult saves result of the current request with events emitted during the call
+func (lr *LogicRunner) registerResult(ctx context.Context, es *ExecutionState, object Ref, payload []byte) error {
+	if len(es.Current.Events) == 0 {
+		_, err := lr.ArtifactManager.RegisterResult(ctx, object, *es.Current.Request, payload)
+		return err
+	}
+	_, err := lr.ArtifactManager.RegisterResultWithEvents(ctx, object, *es.Current.Request, payload, es.Current.Events)
+	return err
+}
+
+// registerBudgetExceeded saves error of the call aborted due to exceeded budget as result of the request
+func (lr *LogicRunner) registerBudgetExceeded(ctx context.Context, es *ExecutionState, object Ref, cause error) {
+	if es.replay != nil || es.dryRun {
+		return
+	}
+
+	payload, err := insolar.Serialize([]interface{}{nil, &foundation.Error{S: cause.Error()}})
+	if err != nil {
+		inslogger.FromContext(ctx).Error("couldn't serialize error of exceeded budget: ", err)
+		return
+	}
+	_, err = lr.ArtifactManager.RegisterResult(ctx, object, *es.Current.Request, payload)
+	if err != nil {
+		inslogger.FromContext(ctx).Error("couldn't save result of exceeded budget: ", err)
+	}
+}
+
 func (lr *LogicRunner) startGetLedgerPendingRequest(ctx context.Context, es *ExecutionState) {
 	err := lr.publisher.Publish(InnerMsgTopic, makeWMMessage(ctx, es.Ref.Bytes(), getLedgerPendingRequestMsg))
 	if err != nil {
@@ -788,6 +931,13 @@ func (lr *LogicRunner) OnPulse(ctx context.Context, pulse insolar.Pulse) error {
 		if es := state.ExecutionState; es != nil {
 			es.Lock()
 
+			// requests executed on previous pulse are validated by validators of new pulse
+			caseBind := es.CaseBind
+			es.CaseBind = nil
+			if caseBind != nil {
+				messages = append(messages, caseBind.ToValidateMessage(ctx, ref, caseBind.Pulse))
+			}
+
 			// if we are executor again we just continue working
 			// without sending data on next executor (because we are next executor)
 			if !meNext {
@@ -818,20 +968,14 @@ func (lr *LogicRunner) OnPulse(ctx context.Context, pulse insolar.Pulse) error {
 				}
 
 				queue, ledgerHasMoreRequest := es.releaseQueue()
-				if len(queue) > 0 || sendExecResults {
-					// TODO: we also should send when executed something for validation
-					// TODO: now validation is disabled
+				if len(queue) > 0 || sendExecResults || caseBind != nil {
 					messagesQueue := convertQueueToMessageQueue(queue)
 
 					messages = append(
 						messages,
-						//&message.ValidateCaseBind{
-						//	Reference: ref,
-						//	Requests:  requests,
-						//	Pulse:     pulse,
-						//},
 						&message.ExecutorResults{
 							RecordRef:             ref,
+							Requests:              caseBind.getCaseBindForMessage(ctx),
 							Pending:               es.pending,
 							Queue:                 messagesQueue,
 							LedgerHasMoreRequests: es.LedgerHasMoreRequests || ledgerHasMoreRequest,
@@ -839,6 +983,10 @@ func (lr *LogicRunner) OnPulse(ctx context.Context, pulse insolar.Pulse) error {
 					)
 				}
 			} else {
+				if caseBind != nil {
+					lr.startConsensus(ctx, state, ref, pulse, *caseBind)
+				}
+
 				if es.Current != nil {
 					// no pending should be as we are executing
 					if es.pending == message.InPending {
@@ -881,6 +1029,23 @@ func (lr *LogicRunner) OnPulse(ctx context.Context, pulse insolar.Pulse) error {
 	return nil
 }
 
+// startConsensus waits results of validators for requests we executed on previous pulse,
+// must be calling only with state.Lock
+func (lr *LogicRunner) startConsensus(
+	ctx context.Context, state *ObjectState, ref Ref, pulse insolar.Pulse, cb CaseBind,
+) {
+	validators, err := lr.JetCoordinator.QueryRole(
+		ctx, insolar.DynamicRoleVirtualValidator, *ref.Record(), pulse.PulseNumber,
+	)
+	if err != nil {
+		inslogger.FromContext(ctx).Error("couldn't get validators: ", err)
+		return
+	}
+
+	state.Consensus = newConsensus(lr, ref, validators)
+	state.Consensus.setCaseBind(ctx, cb, nil)
+}
+
 func (lr *LogicRunner) stopIfNeeded(ctx context.Context) {
 	if len(lr.state) == 0 {
 		lr.stopLock.Lock()
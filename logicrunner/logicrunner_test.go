@@ -1219,11 +1219,11 @@ func (s *LogicRunnerFuncSuite) TestRootDomainContractError() {
 
 	// Verify Member1 balance
 	res3 := root.SignedCall(ctx, pm, *rootDomainRef, "GetBalance", *cb.Prototypes["member"], []interface{}{member1Ref})
-	s.Equal(999999999, int(res3.(uint64)))
+	s.Equal("999999999", res3)
 
 	// Verify Member2 balance
 	res4 := root.SignedCall(ctx, pm, *rootDomainRef, "GetBalance", *cb.Prototypes["member"], []interface{}{member2Ref})
-	s.Equal(1000000001, int(res4.(uint64)))
+	s.Equal("1000000001", res4)
 }
 
 func (s *LogicRunnerFuncSuite) TestFullValidationCycleError() {
@@ -1490,7 +1490,7 @@ func (r *One) CreateAllowance(member string) (error) {
 	}
 	w, _ := wallet.GetImplementationFrom(*memberRef)
 	walletRef := w.GetReference()
-	ah := allowance.New(&walletRef, 111, r.GetContext().Time.Unix()+10)
+	ah := allowance.New(&walletRef, "111", r.GetContext().Time.Unix()+10)
 	_, err := ah.AsChild(walletRef)
 	if err != nil {
 		return fmt.Errorf("Error:", err.Error())
@@ -1613,7 +1613,7 @@ func (r *One) CreateAllowance(member string) (error) {
 
 	// Verify Member balance
 	res3 := root.SignedCall(ctx, pm, *rootDomainRef, "GetBalance", *cb.Prototypes["member"], []interface{}{memberRef})
-	s.Equal(1000000000, int(res3.(uint64)))
+	s.Equal("1000000000", res3)
 }
 
 func (s *LogicRunnerFuncSuite) TestGetParentError() {
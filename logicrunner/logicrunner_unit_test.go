@@ -43,6 +43,7 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
 	"github.com/insolar/insolar/pulsar"
 	"github.com/insolar/insolar/pulsar/entropygenerator"
 
@@ -614,13 +615,17 @@ func (suite *LogicRunnerTestSuite) TestReleaseQueue() {
 }
 
 func (suite *LogicRunnerTestSuite) TestNoExcessiveAmends() {
-	suite.am.UpdateObjectMock.Return(nil, nil)
+	stateID := testutils.RandomID()
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.StateIDMock.Return(&stateID)
+	suite.am.UpdateObjectMock.Return(od, nil)
 
 	randRef := testutils.RandomRef()
 
 	es := &ExecutionState{Queue: make([]ExecutionQueueElement, 0)}
 	es.Queue = append(es.Queue, ExecutionQueueElement{})
 	es.objectbody = &ObjectBody{}
+	es.objectbody.objDescriptor = od
 	es.objectbody.CodeMachineType = insolar.MachineTypeBuiltin
 	es.Current = &CurrentExecution{}
 	es.Current.LogicContext = &insolar.LogicCallContext{}
@@ -657,6 +662,64 @@ func (suite *LogicRunnerTestSuite) TestNoExcessiveAmends() {
 	suite.Require().Equal(uint64(1), suite.am.UpdateObjectCounter)
 }
 
+func (suite *LogicRunnerTestSuite) TestEmitEvent() {
+	objRef := testutils.RandomRef()
+	reqRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.StateIDMock.Return(&stateID)
+
+	data := []byte(testutils.RandomString())
+	es := &ExecutionState{Queue: make([]ExecutionQueueElement, 0)}
+	es.objectbody = &ObjectBody{
+		objDescriptor:   od,
+		Object:          data,
+		CodeMachineType: insolar.MachineTypeBuiltin,
+		CodeRef:         &objRef,
+	}
+	es.Current = &CurrentExecution{
+		LogicContext: &insolar.LogicCallContext{Mode: "execution", Callee: &objRef},
+		Request:      &reqRef,
+	}
+	suite.lr.state[objRef] = &ObjectState{ExecutionState: es}
+
+	rpc := &RPC{lr: suite.lr}
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodMock.Set(func(
+		ctx context.Context, callContext *insolar.LogicCallContext, code insolar.Reference, data []byte,
+		method string, args insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		err := rpc.EmitEvent(rpctypes.UpEmitEventReq{
+			UpBaseReq: rpctypes.UpBaseReq{Mode: "execution", Callee: objRef, Request: reqRef},
+			Name:      "Transfer",
+			Payload:   []byte("payload"),
+		}, &rpctypes.UpEmitEventResp{})
+		suite.Require().NoError(err)
+		return data, nil, nil
+	})
+
+	suite.am.RegisterResultWithEventsMock.Set(func(
+		ctx context.Context, object, request insolar.Reference, payload []byte, events []insolar.ContractEvent,
+	) (*insolar.ID, error) {
+		suite.Equal(objRef, object)
+		suite.Equal(reqRef, request)
+		suite.Equal([]insolar.ContractEvent{{Name: "Transfer", Payload: []byte("payload")}}, events)
+		return nil, nil
+	})
+
+	msg := &message.CallMethod{
+		Request: record.Request{
+			Object: &objRef,
+			Method: "Transfer",
+		},
+	}
+	_, err := suite.lr.executeMethodCall(suite.ctx, es, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.am.RegisterResultWithEventsCounter)
+	suite.Require().Equal(uint64(1), es.Current.LogicContext.Consumption().Upcalls)
+}
+
 func (suite *LogicRunnerTestSuite) TestHandleAbandonedRequestsNotificationMessage() {
 	objectId := testutils.RandomID()
 	msg := &message.AbandonedRequestsNotification{Object: objectId}
@@ -805,6 +868,7 @@ func (suite *LogicRunnerTestSuite) TestConcurrency() {
 	parentRef := testutils.RandomRef()
 	protoRef := testutils.RandomRef()
 	codeRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
 
 	meRef := testutils.RandomRef()
 	notMeRef := testutils.RandomRef()
@@ -836,6 +900,7 @@ func (suite *LogicRunnerTestSuite) TestConcurrency() {
 	od.MemoryMock.Return([]byte{1, 2, 3})
 	od.ParentMock.Return(&parentRef)
 	od.HeadRefMock.Return(&objectRef)
+	od.StateIDMock.Return(&stateID)
 
 	pd := artifacts.NewObjectDescriptorMock(suite.T())
 	pd.CodeMock.Return(&codeRef, nil)
@@ -917,6 +982,7 @@ func (suite *LogicRunnerTestSuite) TestCallMethodWithOnPulse() {
 	parentRef := testutils.RandomRef()
 	protoRef := testutils.RandomRef()
 	codeRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
 
 	meRef := testutils.RandomRef()
 	notMeRef := testutils.RandomRef()
@@ -1064,6 +1130,7 @@ func (suite *LogicRunnerTestSuite) TestCallMethodWithOnPulse() {
 				od.MemoryMock.Return([]byte{1, 2, 3})
 				od.ParentMock.Return(&parentRef)
 				od.HeadRefMock.Return(&objectRef)
+				od.StateIDMock.Return(&stateID)
 
 				pd := artifacts.NewObjectDescriptorMock(suite.T())
 				pd.CodeMock.Return(&codeRef, nil)
@@ -1154,6 +1221,328 @@ func (suite *LogicRunnerTestSuite) TestCallMethodWithOnPulse() {
 	}
 }
 
+func (suite *LogicRunnerTestSuite) TestValidate() {
+	objectRef := testutils.RandomRef()
+	parentRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	requestRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
+	resultID := testutils.RandomID()
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	err := suite.lr.RegisterExecutor(insolar.MachineTypeBuiltin, mle)
+	suite.Require().NoError(err)
+
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.ParentMock.Return(&parentRef)
+
+	pd := artifacts.NewObjectDescriptorMock(suite.T())
+	pd.CodeMock.Return(&codeRef, nil)
+	pd.HeadRefMock.Return(&protoRef)
+
+	cd := artifacts.NewCodeDescriptorMock(suite.T())
+	cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+	cd.RefMock.Return(&codeRef)
+	suite.am.GetCodeMock.Return(cd, nil)
+
+	suite.am.GetObjectFunc = func(
+		ctx context.Context, obj insolar.Reference,
+	) (artifacts.ObjectDescriptor, error) {
+		switch obj {
+		case objectRef:
+			return od, nil
+		case protoRef:
+			return pd, nil
+		}
+		return nil, errors.New("unexpected call")
+	}
+
+	suite.am.GetObjectHistoryFunc = func(
+		ctx context.Context, head insolar.Reference, from *insolar.ID, amount int,
+	) ([]artifacts.ObjectState, *insolar.ID, error) {
+		suite.Require().Equal(objectRef, head)
+		switch *from {
+		case stateID:
+			return []artifacts.ObjectState{
+				{ID: stateID, State: record.StateActivation, Prototype: &protoRef, Memory: []byte{1}},
+			}, nil, nil
+		case resultID:
+			return []artifacts.ObjectState{
+				{ID: resultID, State: record.StateAmend, Prototype: &protoRef, Memory: []byte{2}},
+			}, nil, nil
+		}
+		return nil, nil, errors.New("unexpected call")
+	}
+
+	// ledger isn't changed on validation, so there are no expectations on it
+	mle.CallMethodFunc = func(
+		ctx context.Context, lctx *insolar.LogicCallContext, r insolar.Reference,
+		mem []byte, method string, args insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.Require().Equal("validation", lctx.Mode)
+		suite.Require().Equal([]byte{1}, mem)
+		return []byte{2}, []byte{3}, nil
+	}
+
+	cb := CaseBind{
+		Requests: []CaseRequest{
+			{
+				Parcel: &message.Parcel{
+					Msg: &message.CallMethod{
+						Request: record.Request{
+							Object:    &objectRef,
+							Prototype: &protoRef,
+							Method:    "some",
+						},
+					},
+				},
+				Request: requestRef,
+				Reply:   &reply.CallMethod{Result: []byte{3}},
+				State:   &stateID,
+				Result:  &resultID,
+			},
+		},
+	}
+
+	steps, err := suite.lr.Validate(suite.ctx, objectRef, insolar.Pulse{}, cb)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, steps)
+	suite.Require().Nil(suite.lr.state[objectRef].Validation)
+
+	// executor reported memory that validator doesn't produce
+	mle.CallMethodFunc = func(
+		ctx context.Context, lctx *insolar.LogicCallContext, r insolar.Reference,
+		mem []byte, method string, args insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		return []byte{4}, []byte{3}, nil
+	}
+
+	steps, err = suite.lr.Validate(suite.ctx, objectRef, insolar.Pulse{}, cb)
+	suite.Require().Error(err)
+	suite.Require().Equal(0, steps)
+	suite.Require().Nil(suite.lr.state[objectRef].Validation)
+}
+
+func (suite *LogicRunnerTestSuite) TestHandleDryRunMethodMessage() {
+	objectRef := testutils.RandomRef()
+	parentRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	calleeRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
+
+	suite.jc.MeMock.Return(testutils.RandomRef())
+	suite.jc.IsAuthorizedMock.Return(true, nil)
+	suite.ps.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}, nil)
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	err := suite.lr.RegisterExecutor(insolar.MachineTypeBuiltin, mle)
+	suite.Require().NoError(err)
+
+	od := artifacts.NewObjectDescriptorMock(suite.T())
+	od.MemoryMock.Return([]byte{1})
+	od.StateIDMock.Return(&stateID)
+	od.PrototypeMock.Return(&protoRef, nil)
+	od.ParentMock.Return(&parentRef)
+
+	pd := artifacts.NewObjectDescriptorMock(suite.T())
+	pd.CodeMock.Return(&codeRef, nil)
+	pd.HeadRefMock.Return(&protoRef)
+
+	cd := artifacts.NewCodeDescriptorMock(suite.T())
+	cd.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+	cd.RefMock.Return(&codeRef)
+	suite.am.GetCodeMock.Return(cd, nil)
+
+	suite.am.GetObjectFunc = func(
+		ctx context.Context, obj insolar.Reference,
+	) (artifacts.ObjectDescriptor, error) {
+		switch obj {
+		case objectRef:
+			return od, nil
+		case protoRef:
+			return pd, nil
+		}
+		return nil, errors.New("unexpected call")
+	}
+
+	// nested call is made in dry-run mode too, it gets changes made so far and its changes are added to diff
+	var root insolar.Reference
+	var overlay []insolar.StateDiff
+	calleeDiff := insolar.StateDiff{Object: calleeRef, Before: []byte{4}, After: []byte{5}}
+	cr := testutils.NewContractRequesterMock(suite.mc)
+	cr.DryRunMethodFunc = func(ctx context.Context, msg insolar.Message) (insolar.Reply, error) {
+		dr, ok := msg.(*message.DryRunMethod)
+		suite.Require().True(ok)
+		suite.Require().Equal(calleeRef, *dr.Object)
+		suite.Require().Equal(root, *dr.Root)
+		suite.Require().Equal(overlay, dr.Overlay)
+		return &reply.DryRun{Result: []byte{6}, Diff: append(dr.Overlay, calleeDiff)}, nil
+	}
+	suite.lr.ContractRequester = cr
+
+	rpc := &RPC{lr: suite.lr}
+
+	// ledger isn't changed in dry-run mode, so there are no expectations on it
+	memory := []byte{1}
+	mle.CallMethodFunc = func(
+		ctx context.Context, lctx *insolar.LogicCallContext, r insolar.Reference,
+		mem []byte, method string, args insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.Require().Equal("dryrun", lctx.Mode)
+		suite.Require().Equal(memory, mem)
+		if root.IsEmpty() {
+			root = *lctx.Request
+		}
+
+		rep := &rpctypes.UpRouteResp{}
+		err := rpc.RouteCall(rpctypes.UpRouteReq{
+			UpBaseReq: rpctypes.UpBaseReq{Mode: "dryrun", Callee: objectRef, Request: *lctx.Request},
+			Wait:      true,
+			Object:    calleeRef,
+			Method:    "some",
+		}, rep)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]byte{6}, rep.Result)
+
+		err = rpc.SaveAsChild(rpctypes.UpSaveAsChildReq{
+			UpBaseReq: rpctypes.UpBaseReq{Mode: "dryrun", Callee: objectRef, Request: *lctx.Request},
+		}, &rpctypes.UpSaveAsChildResp{})
+		suite.Require().Error(err)
+
+		return []byte{2}, []byte{3}, nil
+	}
+
+	parcel := &message.Parcel{
+		Msg: &message.DryRunMethod{
+			CallMethod: message.CallMethod{
+				Request: record.Request{
+					Object:    &objectRef,
+					Prototype: &protoRef,
+					Method:    "some",
+				},
+			},
+		},
+	}
+
+	re, err := suite.lr.HandleDryRunMethodMessage(suite.ctx, parcel)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&reply.DryRun{
+		Result: []byte{3},
+		Diff: []insolar.StateDiff{
+			calleeDiff,
+			{Object: objectRef, Before: []byte{1}, After: []byte{2}},
+		},
+	}, re)
+	suite.Require().Nil(suite.lr.state[objectRef].ExecutionState)
+	suite.Require().Empty(suite.lr.state[objectRef].DryRun)
+
+	// object changed earlier in the same dry-run is taken from the overlay, its first state is kept in diff
+	root = testutils.RandomRef()
+	overlay = []insolar.StateDiff{{Object: objectRef, Before: []byte{1}, After: []byte{7}}}
+	memory = []byte{7}
+	nested := *parcel.Msg.(*message.DryRunMethod)
+	nested.Root = &root
+	nested.Overlay = overlay
+	re, err = suite.lr.HandleDryRunMethodMessage(suite.ctx, &message.Parcel{Msg: &nested})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&reply.DryRun{
+		Result: []byte{3},
+		Diff: []insolar.StateDiff{
+			{Object: objectRef, Before: []byte{1}, After: []byte{2}},
+			calleeDiff,
+		},
+	}, re)
+
+	// deactivated object can't be called in the same dry-run
+	nested.Overlay = []insolar.StateDiff{{Object: objectRef, Before: []byte{1}}}
+	_, err = suite.lr.HandleDryRunMethodMessage(suite.ctx, &message.Parcel{Msg: &nested})
+	suite.Require().Error(err)
+
+	// only methods can be called in dry-run mode
+	parcel.Msg.(*message.DryRunMethod).CallType = record.CTSaveAsChild
+	_, err = suite.lr.HandleDryRunMethodMessage(suite.ctx, parcel)
+	suite.Require().Error(err)
+}
+
+func (suite *LogicRunnerTestSuite) TestConsensus() {
+	objectRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
+	resultID := testutils.RandomID()
+	validators := []insolar.Reference{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+
+	c := newConsensus(suite.lr, objectRef, validators)
+	c.setCaseBind(suite.ctx, CaseBind{Requests: []CaseRequest{{State: &stateID, Result: &resultID}}}, nil)
+
+	suite.am.RegisterValidationFunc = func(
+		ctx context.Context, object insolar.Reference, state insolar.ID, isValid bool, msgs []insolar.Message,
+	) error {
+		suite.Require().Equal(objectRef, object)
+		suite.Require().Equal(resultID, state)
+		suite.Require().True(isValid)
+		suite.Require().Len(msgs, 2)
+		return nil
+	}
+
+	addValidated := func(sender insolar.Reference) error {
+		msg := &message.ValidationResults{RecordRef: objectRef, PassedStepsCount: 1}
+		return c.AddValidated(suite.ctx, &message.Parcel{Sender: sender, Msg: msg}, msg)
+	}
+
+	suite.Require().NoError(addValidated(validators[0]))
+	suite.Require().Error(addValidated(validators[0]))
+	suite.Require().Equal(uint64(0), suite.am.RegisterValidationCounter)
+
+	// majority of validators agreed
+	suite.Require().NoError(addValidated(validators[1]))
+	suite.Require().Equal(uint64(1), suite.am.RegisterValidationCounter)
+
+	suite.Require().NoError(addValidated(validators[2]))
+	suite.Require().Equal(uint64(1), suite.am.RegisterValidationCounter)
+
+	suite.Require().Error(addValidated(testutils.RandomRef()))
+}
+
+func (suite *LogicRunnerTestSuite) TestCaseBindMessage() {
+	objectRef := testutils.RandomRef()
+	calleeRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
+
+	cb := NewCaseBind()
+	req := cb.NewRequest(&message.Parcel{Msg: &message.CallMethod{}}, testutils.RandomRef(), nil)
+	req.State = &stateID
+	req.Outgoing = []CaseOutgoing{
+		{Object: calleeRef, Method: "some", Arguments: []byte{1}, Result: []byte{2}},
+		{Object: calleeRef, Method: "other", Error: "some error"},
+	}
+
+	msg := cb.ToValidateMessage(suite.ctx, objectRef, insolar.Pulse{PulseNumber: 100})
+	suite.Require().Equal(objectRef, msg.RecordRef)
+
+	res, err := NewCaseBindFromValidateMessage(suite.ctx, suite.mb, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(insolar.PulseNumber(100), res.Pulse.PulseNumber)
+	suite.Require().Len(res.Requests, 1)
+	suite.Require().Equal(&stateID, res.Requests[0].State)
+	suite.Require().Equal(req.Outgoing, res.Requests[0].Outgoing)
+
+	replay := NewCaseBindReplay(*res)
+	suite.Require().NotNil(replay.NextRequest())
+
+	o, err := replay.replayOutgoing(calleeRef, "some", []byte{1})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte{2}, o.Result)
+
+	o, err = replay.replayOutgoing(calleeRef, "other", nil)
+	suite.Require().NoError(err)
+	suite.Require().EqualError(o.err(), "some error")
+
+	// executor didn't make more calls
+	_, err = replay.replayOutgoing(calleeRef, "some", []byte{1})
+	suite.Require().Error(err)
+}
+
 /*
 func (suite *LogicRunnerTestSuite) TestGracefulStop() {
 	suite.lr.isStopping = false
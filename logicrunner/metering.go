@@ -0,0 +1,142 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+// MeteredExecutor enforces budget of contract calls executed by underlying machine executor.
+// Upcalls and outgoing calls are counted by RPC service, so they are the same on executor and validators.
+// Steps are counted by executors able to meter executed code. Call running longer than its timeout is
+// cancelled through context, its further upcalls are rejected.
+type MeteredExecutor struct {
+	machine  string
+	executor insolar.MachineLogicExecutor
+}
+
+// NewMeteredExecutor wraps executor of machine type with provided name.
+func NewMeteredExecutor(machine string, executor insolar.MachineLogicExecutor) *MeteredExecutor {
+	return &MeteredExecutor{
+		machine:  machine,
+		executor: executor,
+	}
+}
+
+// CallMethod runs a method within budget of call context.
+func (me *MeteredExecutor) CallMethod(
+	ctx context.Context, callContext *insolar.LogicCallContext,
+	code insolar.Reference, data []byte,
+	method string, args insolar.Arguments,
+) (
+	[]byte, insolar.Arguments, error,
+) {
+	var newData []byte
+	var result insolar.Arguments
+	err := me.run(ctx, callContext, func(ctx context.Context) (err error) {
+		newData, result, err = me.executor.CallMethod(ctx, callContext, code, data, method, args)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return newData, result, nil
+}
+
+// CallConstructor runs a constructor within budget of call context.
+func (me *MeteredExecutor) CallConstructor(
+	ctx context.Context, callContext *insolar.LogicCallContext,
+	code insolar.Reference, name string, args insolar.Arguments,
+) (
+	[]byte, error,
+) {
+	var newData []byte
+	err := me.run(ctx, callContext, func(ctx context.Context) (err error) {
+		newData, err = me.executor.CallConstructor(ctx, callContext, code, name, args)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newData, nil
+}
+
+// Stop stops underlying executor.
+func (me *MeteredExecutor) Stop() error {
+	return me.executor.Stop()
+}
+
+func (me *MeteredExecutor) run(
+	ctx context.Context, callContext *insolar.LogicCallContext, call func(ctx context.Context) error,
+) error {
+	ctx = insmetrics.InsertTag(ctx, tagMachineType, me.machine)
+	budget := callContext.Budget
+
+	var callCtx context.Context
+	var cancel context.CancelFunc
+	if budget.Timeout > 0 {
+		callCtx, cancel = context.WithTimeout(ctx, budget.Timeout)
+	} else {
+		callCtx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- call(callCtx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-callCtx.Done():
+		// executor is notified through context and is expected to stop the call
+		err = errors.Wrap(callCtx.Err(), "call is cancelled")
+		if callCtx.Err() == context.DeadlineExceeded {
+			err = errors.Wrapf(insolar.ErrBudgetExceeded, "call is running longer than %s", budget.Timeout)
+		}
+	}
+	// upcalls of the call check consumed time, so they are rejected if it's over
+	consumed := callContext.Consume(insolar.CallConsumption{Time: time.Since(start)})
+	stats.Record(
+		ctx,
+		statCallTime.M(float64(consumed.Time.Nanoseconds())/1e6),
+		statCallSteps.M(int64(consumed.Steps)),
+		statCallUpcalls.M(int64(consumed.Upcalls)),
+		statCallOutgoing.M(int64(consumed.OutgoingCalls)),
+	)
+
+	// contract may ignore errors of exceeded budget, so consumption is checked regardless of result
+	if errors.Cause(err) != insolar.ErrBudgetExceeded && budget.Exceeded(consumed) {
+		err = errors.Wrapf(
+			insolar.ErrBudgetExceeded, "call consumed %s, %d steps, %d upcalls, %d outgoing calls",
+			consumed.Time, consumed.Steps, consumed.Upcalls, consumed.OutgoingCalls,
+		)
+	}
+	if errors.Cause(err) == insolar.ErrBudgetExceeded {
+		stats.Record(ctx, statCallBudgetExceeded.M(1))
+	}
+	return err
+}
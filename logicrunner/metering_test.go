@@ -0,0 +1,109 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestMeteredExecutor_CallMethod(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	mle := testutils.NewMachineLogicExecutorMock(mc)
+	me := NewMeteredExecutor("test", mle)
+
+	t.Run("within budget", func(t *testing.T) {
+		mle.CallMethodMock.Return([]byte{1}, []byte{2}, nil)
+		callCtx := &insolar.LogicCallContext{Budget: insolar.CallBudget{Timeout: time.Minute, Steps: 10}}
+
+		data, res, err := me.CallMethod(ctx, callCtx, testutils.RandomRef(), nil, "some", nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1}, data)
+		require.Equal(t, insolar.Arguments{2}, res)
+		require.True(t, callCtx.Consumption().Time > 0)
+	})
+
+	t.Run("steps exceeded", func(t *testing.T) {
+		callCtx := &insolar.LogicCallContext{Budget: insolar.CallBudget{Steps: 1}}
+		// contract swallows error of exceeded budget
+		mle.CallMethodMock.Set(func(
+			ctx context.Context, lctx *insolar.LogicCallContext, r insolar.Reference,
+			mem []byte, method string, args insolar.Arguments,
+		) ([]byte, insolar.Arguments, error) {
+			lctx.Consume(insolar.CallConsumption{Steps: 2})
+			return []byte{1}, []byte{2}, nil
+		})
+
+		_, _, err := me.CallMethod(ctx, callCtx, testutils.RandomRef(), nil, "some", nil)
+		require.Error(t, err)
+		require.Equal(t, insolar.ErrBudgetExceeded, errors.Cause(err))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		callCtx := &insolar.LogicCallContext{Budget: insolar.CallBudget{Timeout: time.Millisecond}}
+		cancelled := make(chan error, 1)
+		mle.CallMethodMock.Set(func(
+			ctx context.Context, lctx *insolar.LogicCallContext, r insolar.Reference,
+			mem []byte, method string, args insolar.Arguments,
+		) ([]byte, insolar.Arguments, error) {
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return nil, nil, ctx.Err()
+		})
+
+		_, _, err := me.CallMethod(ctx, callCtx, testutils.RandomRef(), nil, "some", nil)
+		require.Error(t, err)
+		require.Equal(t, insolar.ErrBudgetExceeded, errors.Cause(err))
+		require.Equal(t, context.DeadlineExceeded, <-cancelled)
+	})
+}
+
+func TestExecutionState_Upcall(t *testing.T) {
+	request := testutils.RandomRef()
+	es := &ExecutionState{
+		Current: &CurrentExecution{
+			Request: &request,
+			LogicContext: &insolar.LogicCallContext{
+				Budget: insolar.CallBudget{Upcalls: 3, OutgoingCalls: 1},
+			},
+		},
+	}
+
+	require.NoError(t, es.upcall(request, false))
+	require.NoError(t, es.upcall(request, true))
+	require.Equal(t, insolar.ErrBudgetExceeded, es.upcall(request, true))
+	require.Equal(t, insolar.CallConsumption{Upcalls: 3, OutgoingCalls: 2}, es.Current.LogicContext.Consumption())
+
+	// upcalls of other requests, e.g. aborted ones, are rejected and not counted
+	require.Error(t, es.upcall(testutils.RandomRef(), false))
+	require.Equal(t, uint64(3), es.Current.LogicContext.Consumption().Upcalls)
+
+	es.Current = nil
+	require.Error(t, es.upcall(request, false))
+}
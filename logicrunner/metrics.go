@@ -0,0 +1,90 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagMachineType = insmetrics.MustTagKey("machineType")
+)
+
+var (
+	statCallTime = stats.Float64(
+		"logicrunner/call/time",
+		"wall-clock time consumed by contract call",
+		stats.UnitMilliseconds,
+	)
+	statCallSteps = stats.Int64(
+		"logicrunner/call/steps",
+		"instructions executed by contract call",
+		stats.UnitDimensionless,
+	)
+	statCallUpcalls = stats.Int64(
+		"logicrunner/call/upcalls",
+		"calls to the platform made by contract call",
+		stats.UnitDimensionless,
+	)
+	statCallOutgoing = stats.Int64(
+		"logicrunner/call/outgoing",
+		"calls of other contracts made by contract call",
+		stats.UnitDimensionless,
+	)
+	statCallBudgetExceeded = stats.Int64(
+		"logicrunner/call/budget_exceeded",
+		"contract calls aborted due to exceeded budget",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Measure:     statCallTime,
+			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
+			TagKeys:     []tag.Key{tagMachineType},
+		},
+		&view.View{
+			Measure:     statCallSteps,
+			Aggregation: view.Distribution(1000, 10000, 100000, 1000000, 10000000, 100000000),
+			TagKeys:     []tag.Key{tagMachineType},
+		},
+		&view.View{
+			Measure:     statCallUpcalls,
+			Aggregation: view.Distribution(1, 10, 100, 1000, 10000),
+			TagKeys:     []tag.Key{tagMachineType},
+		},
+		&view.View{
+			Measure:     statCallOutgoing,
+			Aggregation: view.Distribution(1, 10, 100, 1000),
+			TagKeys:     []tag.Key{tagMachineType},
+		},
+		&view.View{
+			Measure:     statCallBudgetExceeded,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagMachineType},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
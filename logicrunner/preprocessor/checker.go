@@ -0,0 +1,314 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package preprocessor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Severity is severity of a problem found in contract source
+type Severity int
+
+const (
+	// SeverityWarning marks constructs that may break determinism of contract execution
+	SeverityWarning Severity = iota
+	// SeverityError marks constructs that break determinism or can't be executed by logicrunner
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Diagnostic is a problem found in contract source
+type Diagnostic struct {
+	Pos      token.Position
+	Severity Severity
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s: %s", d.Pos, d.Severity, d.Message)
+}
+
+// disallowedImports are packages giving access to environment of the node, contract can't use them
+var disallowedImports = []string{
+	"crypto/rand",
+	"database/...",
+	"io/ioutil",
+	"math/rand",
+	"net",
+	"net/...",
+	"os",
+	"os/...",
+	"plugin",
+	"runtime",
+	"runtime/...",
+	"syscall",
+	"unsafe",
+}
+
+// disallowedCalls are functions with results depending on wall clock or scheduler,
+// contract should use time from its context instead
+var disallowedCalls = map[string][]string{
+	"time": {"After", "AfterFunc", "NewTicker", "NewTimer", "Now", "Since", "Sleep", "Tick", "Until"},
+}
+
+func isDisallowedImport(importPath string) bool {
+	for _, pattern := range disallowedImports {
+		if pattern == importPath {
+			return true
+		}
+		if strings.HasSuffix(pattern, "/...") && strings.HasPrefix(importPath, strings.TrimSuffix(pattern, "...")) {
+			return true
+		}
+	}
+	return false
+}
+
+type checker struct {
+	pf          *ParsedFile
+	info        *types.Info
+	imports     map[string]string
+	diagnostics []Diagnostic
+}
+
+func (c *checker) report(node ast.Node, severity Severity, format string, args ...interface{}) {
+	c.diagnostics = append(c.diagnostics, Diagnostic{
+		Pos:      c.pf.fileSet.Position(node.Pos()),
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Check type-checks the contract and looks for constructs breaking determinism of contract execution:
+// goroutines, select statements, calls depending on wall clock, iteration over maps,
+// imports giving access to environment of the node and types of exported methods' arguments
+// and results which can't be serialized
+func (pf *ParsedFile) Check() []Diagnostic {
+	c := &checker{
+		pf: pf,
+		info: &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Uses:  make(map[*ast.Ident]types.Object),
+		},
+		imports: make(map[string]string),
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(pf.fileSet, "source", nil),
+		Error: func(err error) {
+			if terr, ok := err.(types.Error); ok {
+				// imports may be unresolved outside of GOPATH, it doesn't mean that contract is broken
+				severity := SeverityError
+				if c.isImport(terr.Pos) {
+					severity = SeverityWarning
+				}
+				c.diagnostics = append(c.diagnostics, Diagnostic{
+					Pos:      terr.Fset.Position(terr.Pos),
+					Severity: severity,
+					Message:  terr.Msg,
+				})
+				return
+			}
+			c.diagnostics = append(c.diagnostics, Diagnostic{
+				Pos:      token.Position{Filename: pf.name},
+				Severity: SeverityError,
+				Message:  err.Error(),
+			})
+		},
+	}
+	// errors are collected by conf.Error, checks below work with partial type information
+	_, _ = conf.Check(pf.node.Name.Name, pf.fileSet, []*ast.File{pf.node}, c.info)
+
+	c.checkImports()
+	c.checkSignatures()
+	ast.Inspect(pf.node, c.inspect)
+
+	sort.SliceStable(c.diagnostics, func(i, j int) bool {
+		a, b := c.diagnostics[i].Pos, c.diagnostics[j].Pos
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return c.diagnostics
+}
+
+// isImport checks if position belongs to import of the contract
+func (c *checker) isImport(pos token.Pos) bool {
+	for _, imp := range c.pf.node.Imports {
+		if imp.Pos() <= pos && pos < imp.End() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) checkImports() {
+	for _, imp := range c.pf.node.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		c.imports[name] = importPath
+
+		if isDisallowedImport(importPath) {
+			c.report(imp, SeverityError, "import of %q is not allowed in contracts", importPath)
+		}
+	}
+}
+
+func (c *checker) checkSignatures() {
+	var funcs []*ast.FuncDecl
+	funcs = append(funcs, c.pf.methods[c.pf.contract]...)
+	funcs = append(funcs, c.pf.constructors[c.pf.contract]...)
+
+	for _, fd := range funcs {
+		for _, list := range []*ast.FieldList{fd.Type.Params, fd.Type.Results} {
+			if list == nil {
+				continue
+			}
+			for _, field := range list.List {
+				if kind := c.unsupportedKind(field.Type); kind != "" {
+					c.report(field, SeverityError, "%q uses %s type %s, it can't be serialized",
+						fd.Name.Name, kind, c.pf.codeOfNode(field.Type))
+				}
+			}
+		}
+	}
+}
+
+// unsupportedKind returns kind of type which can't be passed to or returned from contract,
+// uses type information if it's available and syntax of the type otherwise
+func (c *checker) unsupportedKind(expr ast.Expr) string {
+	if t := c.info.TypeOf(expr); t != nil {
+		return unsupportedKindOfType(t, make(map[types.Type]bool))
+	}
+
+	kind := ""
+	ast.Inspect(expr, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.ChanType:
+			kind = "channel"
+		case *ast.FuncType:
+			kind = "function"
+		}
+		return kind == ""
+	})
+	return kind
+}
+
+func unsupportedKindOfType(t types.Type, seen map[types.Type]bool) string {
+	if seen[t] {
+		return ""
+	}
+	seen[t] = true
+
+	switch t := t.(type) {
+	case *types.Named:
+		return unsupportedKindOfType(t.Underlying(), seen)
+	case *types.Chan:
+		return "channel"
+	case *types.Signature:
+		return "function"
+	case *types.Basic:
+		if t.Kind() == types.UnsafePointer {
+			return "unsafe pointer"
+		}
+	case *types.Pointer:
+		return unsupportedKindOfType(t.Elem(), seen)
+	case *types.Slice:
+		return unsupportedKindOfType(t.Elem(), seen)
+	case *types.Array:
+		return unsupportedKindOfType(t.Elem(), seen)
+	case *types.Map:
+		if kind := unsupportedKindOfType(t.Key(), seen); kind != "" {
+			return kind
+		}
+		return unsupportedKindOfType(t.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if !t.Field(i).Exported() {
+				continue
+			}
+			if kind := unsupportedKindOfType(t.Field(i).Type(), seen); kind != "" {
+				return kind
+			}
+		}
+	}
+	return ""
+}
+
+func (c *checker) inspect(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.GoStmt:
+		c.report(n, SeverityError, "goroutines are not allowed in contracts")
+	case *ast.SelectStmt:
+		c.report(n, SeverityError, "select statements are not allowed in contracts")
+	case *ast.RangeStmt:
+		if t := c.info.TypeOf(n.X); t != nil {
+			if _, ok := t.Underlying().(*types.Map); ok {
+				c.report(n, SeverityWarning, "iteration order over map is random, sort keys to get deterministic result")
+			}
+		}
+	case *ast.CallExpr:
+		c.checkCall(n)
+	}
+	return true
+}
+
+func (c *checker) checkCall(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	if obj := c.info.Uses[pkg]; obj != nil {
+		if _, ok := obj.(*types.PkgName); !ok {
+			return // shadowed by local identifier
+		}
+	}
+	importPath, ok := c.imports[pkg.Name]
+	if !ok {
+		return
+	}
+	for _, name := range disallowedCalls[importPath] {
+		if sel.Sel.Name == name {
+			c.report(call, SeverityError, "call of %s.%s is not allowed in contracts, use time from context", importPath, name)
+			return
+		}
+	}
+}
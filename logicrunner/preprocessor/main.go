@@ -48,6 +48,10 @@ var proxyctxPath = "github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 var corePath = "github.com/insolar/insolar/insolar"
 
 var immutableFlag = "//ins:immutable"
+var versionFlag = "//ins:version "
+
+// migrateFunction is name of the function converting state saved by previous versions of contract code
+const migrateFunction = "Migrate"
 
 const (
 	TemplateDirectory = "templates"
@@ -64,7 +68,9 @@ type ParsedFile struct {
 	types        map[string]*ast.TypeSpec
 	methods      map[string][]*ast.FuncDecl
 	constructors map[string][]*ast.FuncDecl
+	migrate      *ast.FuncDecl
 	contract     string
+	codeVersion  uint
 }
 
 // ParseFile parses a file as Go source code of a smart contract
@@ -100,6 +106,11 @@ func ParseFile(fileName string, machineType insolar.MachineType) (*ParsedFile, e
 		return nil, errors.New("Only one smart contract must exist")
 	}
 
+	err = res.checkMigrate()
+	if err != nil {
+		return nil, errors.Wrap(err, "")
+	}
+
 	return res, nil
 }
 
@@ -114,7 +125,7 @@ func (pf *ParsedFile) parseTypes() error {
 		for _, e := range tDecl.Specs {
 			typeNode := e.(*ast.TypeSpec)
 
-			err := pf.parseTypeSpec(typeNode)
+			err := pf.parseTypeSpec(typeNode, tDecl)
 			if err != nil {
 				return err
 			}
@@ -124,12 +135,18 @@ func (pf *ParsedFile) parseTypes() error {
 	return nil
 }
 
-func (pf *ParsedFile) parseTypeSpec(typeSpec *ast.TypeSpec) error {
+func (pf *ParsedFile) parseTypeSpec(typeSpec *ast.TypeSpec, decl *ast.GenDecl) error {
 	if isContractTypeSpec(typeSpec) {
 		if pf.contract != "" {
 			return errors.New("more than one contract in a file")
 		}
 		pf.contract = typeSpec.Name.Name
+
+		version, err := codeVersion(decl.Doc, typeSpec.Doc)
+		if err != nil {
+			return errors.Wrapf(err, "Contract %q has bad version", pf.contract)
+		}
+		pf.codeVersion = version
 	} else {
 		pf.types[typeSpec.Name.Name] = typeSpec
 	}
@@ -148,6 +165,10 @@ func (pf *ParsedFile) parseFunctionsAndMethods() error {
 
 		var err error
 		if fd.Recv == nil || fd.Recv.NumFields() == 0 {
+			if fd.Name.Name == migrateFunction {
+				pf.migrate = fd
+				continue
+			}
 			err = pf.parseConstructor(fd)
 		} else {
 			err = pf.parseMethod(fd)
@@ -204,6 +225,30 @@ func (pf *ParsedFile) parseMethod(fd *ast.FuncDecl) error {
 	return nil
 }
 
+// checkMigrate checks signature of migration function, it must be
+//    func Migrate(version uint, state []byte) (*Contract, error)
+func (pf *ParsedFile) checkMigrate() error {
+	if pf.migrate == nil {
+		return nil
+	}
+
+	params := pf.migrate.Type.Params
+	if params.NumFields() != 2 ||
+		pf.codeOfNode(params.List[0].Type) != "uint" ||
+		pf.codeOfNode(params.List[len(params.List)-1].Type) != "[]byte" {
+		return errors.Errorf("Function %q should accept version (uint) and state ([]byte)", migrateFunction)
+	}
+
+	res := pf.migrate.Type.Results
+	if res.NumFields() != 2 ||
+		pf.codeOfNode(res.List[0].Type) != "*"+pf.contract ||
+		pf.typeName(res.List[1].Type) != "error" {
+		return errors.Errorf("Function %q should return '*%s' and 'error'", migrateFunction, pf.contract)
+	}
+
+	return nil
+}
+
 // ProxyPackageName guesses user friendly contract "name" from file name
 // and/or package in the file
 func (pf *ParsedFile) ProxyPackageName() (string, error) {
@@ -224,6 +269,11 @@ func (pf *ParsedFile) ProxyPackageName() (string, error) {
 	return proxyPackageName, nil
 }
 
+// CodeVersion returns version of contract code, state saved by older versions is migrated on call
+func (pf *ParsedFile) CodeVersion() uint {
+	return pf.codeVersion
+}
+
 // ContractName returns name of the contract
 func (pf *ParsedFile) ContractName() string {
 	return pf.node.Name.Name
@@ -281,6 +331,8 @@ func (pf *ParsedFile) WriteWrapper(out io.Writer, packageName string) error {
 		"ContractType":       pf.contract,
 		"Methods":            pf.functionInfoForWrapper(pf.methods[pf.contract]),
 		"Functions":          pf.functionInfoForWrapper(pf.constructors[pf.contract]),
+		"CodeVersion":        pf.codeVersion,
+		"Migrate":            pf.migrate != nil,
 		"ParsedCode":         pf.code,
 		"FoundationPath":     foundationPath,
 		"Imports":            pf.generateImports(true),
@@ -655,6 +707,26 @@ func isImmutable(decl *ast.FuncDecl) bool {
 	return isImmutable
 }
 
+// codeVersion returns version of contract code set by flag in doc comment of contract type, zero by default
+func codeVersion(docs ...*ast.CommentGroup) (uint, error) {
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, comment := range doc.List {
+			if !strings.HasPrefix(comment.Text, versionFlag) {
+				continue
+			}
+			version, err := strconv.ParseUint(strings.TrimSpace(comment.Text[len(versionFlag):]), 10, 32)
+			if err != nil {
+				return 0, err
+			}
+			return uint(version), nil
+		}
+	}
+	return 0, nil
+}
+
 type ContractListEntry struct {
 	Name       string
 	Path       string
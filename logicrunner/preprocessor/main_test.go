@@ -394,6 +394,173 @@ type A struct{
 	s.EqualError(err, "Only one smart contract must exist")
 }
 
+func (s *PreprocessorSuite) TestCodeVersionAndMigrate() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package main
+
+//ins:version 2
+type A struct{
+	foundation.BaseContract
+}
+
+func Migrate(version uint, state []byte) (*A, error) {
+	return &A{}, nil
+}
+`)
+	s.NoError(err)
+
+	parsed, err := ParseFile(tmpDir+testContract, insolar.MachineTypeGoPlugin)
+	s.NoError(err)
+	s.Equal(uint(2), parsed.codeVersion)
+	s.NotNil(parsed.migrate)
+	s.Empty(parsed.constructors["A"])
+
+	var bufWrapper bytes.Buffer
+	err = parsed.WriteWrapper(&bufWrapper, parsed.ContractName())
+	s.NoError(err)
+	s.Contains(bufWrapper.String(), "const INSHELPER_CodeVersion uint = 2")
+	s.Contains(bufWrapper.String(), "Migrate(self.CodeVersion, object)")
+	s.Contains(bufWrapper.String(), "func INSMETHOD_MigrateState(")
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package main
+
+type A struct{
+	foundation.BaseContract
+}
+
+func Migrate(state []byte) (*A, error) {
+	return &A{}, nil
+}
+`)
+	s.NoError(err)
+
+	_, err = ParseFile(tmpDir+testContract, insolar.MachineTypeGoPlugin)
+	s.EqualError(err, `: Function "Migrate" should accept version (uint) and state ([]byte)`)
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package main
+
+//ins:version two
+type A struct{
+	foundation.BaseContract
+}
+`)
+	s.NoError(err)
+
+	_, err = ParseFile(tmpDir+testContract, insolar.MachineTypeGoPlugin)
+	s.Error(err)
+}
+
+func (s *PreprocessorSuite) TestCheck() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package main
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+type A struct{
+	foundation.BaseContract
+	Values map[string]int
+}
+
+func (a *A) Get(c chan int) (int, error) {
+	go func() {}()
+	for k := range a.Values {
+		_ = k
+	}
+	_ = time.Now()
+	_ = time.Unix(0, 0)
+	return rand.Int(), nil
+}
+`)
+	s.NoError(err)
+
+	parsed, err := ParseFile(tmpDir+testContract, insolar.MachineTypeGoPlugin)
+	s.NoError(err)
+
+	diagnostics := map[string]Diagnostic{}
+	for _, d := range parsed.Check() {
+		diagnostics[d.Message] = d
+	}
+
+	d, ok := diagnostics[`import of "math/rand" is not allowed in contracts`]
+	s.True(ok)
+	s.Equal(SeverityError, d.Severity)
+	s.Equal(5, d.Pos.Line)
+
+	d, ok = diagnostics[`"Get" uses channel type chan int, it can't be serialized`]
+	s.True(ok)
+	s.Equal(16, d.Pos.Line)
+
+	d, ok = diagnostics["goroutines are not allowed in contracts"]
+	s.True(ok)
+	s.Equal(17, d.Pos.Line)
+
+	d, ok = diagnostics["iteration order over map is random, sort keys to get deterministic result"]
+	s.True(ok)
+	s.Equal(SeverityWarning, d.Severity)
+
+	d, ok = diagnostics["call of time.Now is not allowed in contracts, use time from context"]
+	s.True(ok)
+	s.Equal(21, d.Pos.Line)
+	s.Contains(d.String(), "test.go:21:6: error: ")
+}
+
+func (s *PreprocessorSuite) TestCheck_UnresolvedImport() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package main
+
+import (
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/no/such/pkg"
+)
+
+type A struct{
+	foundation.BaseContract
+}
+
+func (a *A) Get() (int, error) {
+	return pkg.Value(), nil
+}
+`)
+	s.NoError(err)
+
+	parsed, err := ParseFile(tmpDir+testContract, insolar.MachineTypeGoPlugin)
+	s.NoError(err)
+
+	var unresolved bool
+	for _, d := range parsed.Check() {
+		s.Equal(SeverityWarning, d.Severity, d.String())
+		if d.Pos.Line == 6 {
+			unresolved = true
+		}
+	}
+	s.True(unresolved)
+}
+
 func (s *PreprocessorSuite) TestOnlyOneSmartContractMustExist() {
 	tmpDir, err := ioutil.TempDir("", "test-")
 	s.NoError(err)
@@ -30,6 +30,61 @@ func ( e *ExtendableError ) Error() string{
 	return e.S
 }
 
+const INSHELPER_CodeVersion uint = {{ $.CodeVersion }}
+
+func INSHELPER_Migrate(object []byte, self *{{ $.ContractType }}) (*{{ $.ContractType }}, error) {
+	if self.CodeVersion > INSHELPER_CodeVersion {
+		return nil, &ExtendableError{ S: "[ INSHELPER_Migrate ] ( Generated Method ) State is saved by newer version of code" }
+	}
+	if self.CodeVersion == INSHELPER_CodeVersion {
+		return self, nil
+	}
+{{ if $.Migrate }}
+	migrated, err := Migrate(self.CodeVersion, object)
+	if err != nil {
+		return nil, err
+	}
+	if migrated == nil {
+		return nil, &ExtendableError{ S: "[ INSHELPER_Migrate ] ( Generated Method ) Migrate returns nil" }
+	}
+	self = migrated
+{{ end }}
+	self.CodeVersion = INSHELPER_CodeVersion
+	return self, nil
+}
+
+func INSMETHOD_MigrateState(object []byte, data []byte) ([]byte, []byte, error) {
+	ph := proxyctx.Current
+	self := new({{ $.ContractType }})
+
+	if len(object) == 0 {
+		return nil, nil, &ExtendableError{ S: "[ Fake MigrateState ] ( Generated Method ) Object is nil"}
+	}
+
+	err := ph.Deserialize(object, self)
+	if err != nil {
+		e := &ExtendableError{ S: "[ Fake MigrateState ] ( Generated Method ) Can't deserialize args.Data: " + err.Error() }
+		return nil, nil, e
+	}
+
+	self, err = INSHELPER_Migrate(object, self)
+	if err != nil {
+		e := &ExtendableError{ S: "[ Fake MigrateState ] ( Generated Method ) Can't migrate state: " + err.Error() }
+		return nil, nil, e
+	}
+
+	state := []byte{}
+	err = ph.Serialize(self, &state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ret := []byte{}
+	err = ph.Serialize([]interface{} { nil }, &ret)
+
+	return state, ret, err
+}
+
 func INSMETHOD_GetCode(object []byte, data []byte) ([]byte, []byte, error) {
 	ph := proxyctx.Current
 	self := new({{ $.ContractType }})
@@ -98,6 +153,12 @@ func INSMETHOD_{{ $method.Name }}(object []byte, data []byte) ([]byte, []byte, e
 		return nil, nil, e
 	}
 
+	self, err = INSHELPER_Migrate(object, self)
+	if err != nil {
+		e := &ExtendableError{ S: "[ Fake{{ $method.Name }} ] ( INSMETHOD_* ) ( Generated Method ) Can't migrate state: " + err.Error() }
+		return nil, nil, e
+	}
+
 	{{ $method.ArgumentsZeroList }}
 	err = ph.Deserialize(data, &args)
 	if err != nil {
@@ -144,17 +205,18 @@ func INSCONSTRUCTOR_{{ $f.Name }}(data []byte) ([]byte, error) {
 		return nil, ret1
 	}
 
+	if ret0 == nil {
+		e := &ExtendableError{ S: "[ Fake{{ $f.Name }} ] ( INSCONSTRUCTOR_* ) ( Generated Method ) Constructor returns nil" }
+		return nil, e
+	}
+	ret0.CodeVersion = INSHELPER_CodeVersion
+
 	ret := []byte{}
 	err = ph.Serialize(ret0, &ret)
 	if err != nil {
 		return nil, err
 	}
 
-	if ret0 == nil {
-		e := &ExtendableError{ S: "[ Fake{{ $f.Name }} ] ( INSCONSTRUCTOR_* ) ( Generated Method ) Constructor returns nil" }
-		return nil, e
-	}
-
 	return ret, err
 }
 {{ end }}
@@ -164,6 +226,7 @@ func Initialize() map[string]interface{} {
     return map[string]interface{}{
         "INSMETHOD_GetCode": INSMETHOD_GetCode,
         "INSMETHOD_GetPrototype": INSMETHOD_GetPrototype,
+        "INSMETHOD_MigrateState": INSMETHOD_MigrateState,
 {{ range $method := .Methods -}}
         "INSMETHOD_{{ $method.Name }}": INSMETHOD_{{ $method.Name }},
 {{- end }}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"go.opencensus.io/trace"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/message"
@@ -87,7 +88,7 @@ func recoverRPC(err *error) {
 func (gpr *RPC) GetCode(req rpctypes.UpGetCodeReq, reply *rpctypes.UpGetCodeResp) (err error) {
 	defer recoverRPC(&err)
 	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := os.MustModeState(req.Mode, req.Request)
 	ctx := es.Current.Context
 	inslogger.FromContext(ctx).Debug("In RPC.GetCode ....")
 
@@ -112,14 +113,22 @@ func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (e
 	defer recoverRPC(&err)
 
 	os := gpr.lr.MustObjectState(req.Callee)
+	es := os.MustModeState(req.Mode, req.Request)
+	if err := es.upcall(req.Request, true); err != nil {
+		return err
+	}
+
+	ctx, span := instracer.StartSpan(es.Current.Context, "RPC.RouteCall")
+	span.AddAttributes(
+		trace.StringAttribute("object", req.Object.String()),
+		trace.StringAttribute("method", req.Method),
+	)
+	defer span.End()
 
-	if os.ExecutionState.Current.LogicContext.Immutable {
+	if es.Current.LogicContext.Immutable {
 		return errors.New("Try to call route from immutable method")
 	}
 
-	es := os.MustModeState(req.Mode)
-	ctx := es.Current.Context
-
 	// TODO: delegation token
 
 	es.nonce++
@@ -136,32 +145,87 @@ func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (e
 			Prototype: &req.Prototype,
 			Method:    req.Method,
 			Arguments: req.Arguments,
+
+			ParentRequest: es.Current.Request,
 		},
 	}
 
+	if es.dryRun {
+		rep.Result, err = gpr.dryRunCall(ctx, es, req, msg)
+		return err
+	}
+
 	if !req.Wait {
 		msg.ReturnMode = record.ReturnNoWait
 	}
 
-	res, err := gpr.lr.ContractRequester.CallMethod(ctx, msg)
+	result, _, err := es.outgoingCall(req.Object, req.Method, req.Arguments, func() ([]byte, *Ref, error) {
+		res, err := gpr.lr.ContractRequester.CallMethod(ctx, msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if req.Wait {
+			return res.(*reply.CallMethod).Result, nil, nil
+		}
+		return nil, nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
 	if req.Wait {
-		rep.Result = res.(*reply.CallMethod).Result
+		rep.Result = result
 	}
 
 	return nil
 }
 
+// dryRunCall makes nested call in dry-run mode, it gets objects changed by the dry-run so far and changes it made
+// are added to the overlay
+func (gpr *RPC) dryRunCall(
+	ctx context.Context, es *ExecutionState, req rpctypes.UpRouteReq, msg *message.CallMethod,
+) (
+	[]byte, error,
+) {
+	if !req.Wait {
+		return nil, errors.New("Try to call without waiting for result in dry-run mode")
+	}
+
+	root := es.overlay.root
+	res, err := gpr.lr.ContractRequester.DryRunMethod(ctx, &message.DryRunMethod{
+		CallMethod: *msg,
+		Root:       &root,
+		Overlay:    es.overlay.diff(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dr := res.(*reply.DryRun)
+	es.overlay.merge(dr.Diff)
+	return dr.Result, nil
+}
+
 // SaveAsChild is an RPC saving data as memory of a contract as child a parent
 func (gpr *RPC) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveAsChildResp) (err error) {
 	defer recoverRPC(&err)
 
 	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
-	ctx := es.Current.Context
+	es := os.MustModeState(req.Mode, req.Request)
+	if err := es.upcall(req.Request, true); err != nil {
+		return err
+	}
+
+	ctx, span := instracer.StartSpan(es.Current.Context, "RPC.SaveAsChild")
+	span.AddAttributes(
+		trace.StringAttribute("prototype", req.Prototype.String()),
+		trace.StringAttribute("method", req.ConstructorName),
+	)
+	defer span.End()
+
+	if es.dryRun {
+		return errors.New("Try to create object in dry-run mode")
+	}
 
 	es.nonce++
 
@@ -176,10 +240,15 @@ func (gpr *RPC) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveA
 			Prototype: &req.Prototype,
 			Method:    req.ConstructorName,
 			Arguments: req.ArgsSerialized,
+
+			ParentRequest: es.Current.Request,
 		},
 	}
 
-	ref, err := gpr.lr.ContractRequester.CallConstructor(ctx, msg)
+	_, ref, err := es.outgoingCall(req.Parent, req.ConstructorName, req.ArgsSerialized, func() ([]byte, *Ref, error) {
+		ref, err := gpr.lr.ContractRequester.CallConstructor(ctx, msg)
+		return nil, ref, err
+	})
 
 	rep.Reference = ref
 
@@ -191,8 +260,21 @@ func (gpr *RPC) SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.U
 	defer recoverRPC(&err)
 
 	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
-	ctx := es.Current.Context
+	es := os.MustModeState(req.Mode, req.Request)
+	if err := es.upcall(req.Request, true); err != nil {
+		return err
+	}
+
+	ctx, span := instracer.StartSpan(es.Current.Context, "RPC.SaveAsDelegate")
+	span.AddAttributes(
+		trace.StringAttribute("prototype", req.Prototype.String()),
+		trace.StringAttribute("method", req.ConstructorName),
+	)
+	defer span.End()
+
+	if es.dryRun {
+		return errors.New("Try to create object in dry-run mode")
+	}
 
 	es.nonce++
 
@@ -207,10 +289,15 @@ func (gpr *RPC) SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.U
 			Prototype: &req.Prototype,
 			Method:    req.ConstructorName,
 			Arguments: req.ArgsSerialized,
+
+			ParentRequest: es.Current.Request,
 		},
 	}
 
-	ref, err := gpr.lr.ContractRequester.CallConstructor(ctx, msg)
+	_, ref, err := es.outgoingCall(req.Into, req.ConstructorName, req.ArgsSerialized, func() ([]byte, *Ref, error) {
+		ref, err := gpr.lr.ContractRequester.CallConstructor(ctx, msg)
+		return nil, ref, err
+	})
 
 	rep.Reference = ref
 	return err
@@ -230,7 +317,10 @@ func (gpr *RPC) GetObjChildrenIterator(
 	defer recoverRPC(&err)
 
 	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := os.MustModeState(req.Mode, req.Request)
+	if err := es.upcall(req.Request, false); err != nil {
+		return err
+	}
 	ctx := es.Current.Context
 
 	am := gpr.lr.ArtifactManager
@@ -305,7 +395,10 @@ func (gpr *RPC) GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDe
 	defer recoverRPC(&err)
 
 	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := os.MustModeState(req.Mode, req.Request)
+	if err := es.upcall(req.Request, false); err != nil {
+		return err
+	}
 	ctx := es.Current.Context
 
 	am := gpr.lr.ArtifactManager
@@ -322,7 +415,23 @@ func (gpr *RPC) DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctyp
 	defer recoverRPC(&err)
 
 	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := os.MustModeState(req.Mode, req.Request)
+	if err := es.upcall(req.Request, false); err != nil {
+		return err
+	}
 	es.deactivate = true
 	return nil
 }
+
+// EmitEvent is an RPC saving event emitted by a contract, events are saved with result of the call
+func (gpr *RPC) EmitEvent(req rpctypes.UpEmitEventReq, rep *rpctypes.UpEmitEventResp) (err error) {
+	defer recoverRPC(&err)
+
+	os := gpr.lr.MustObjectState(req.Callee)
+	es := os.MustModeState(req.Mode, req.Request)
+	if err := es.upcall(req.Request, false); err != nil {
+		return err
+	}
+	es.Current.Events = append(es.Current.Events, insolar.ContractEvent{Name: req.Name, Payload: req.Payload})
+	return nil
+}
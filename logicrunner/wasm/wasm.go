@@ -0,0 +1,426 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package wasm is implementation of executor running contracts compiled to WebAssembly.
+//
+// Code of contracts is run by pure Go interpreter with floating point instructions disabled,
+// so results of calls don't depend on toolchain and platform of the node.
+//
+// Module of a contract exports:
+//
+//	memory - linear memory of the module;
+//	alloc(size i32) i32 - allocates buffer the executor writes data passed into the module to;
+//	methods by their names accepting (state, stateLen, args, argsLen i32);
+//	constructors by their names accepting (args, argsLen i32).
+//
+// Module of a contract can import following functions of `insolar` module:
+//
+//	set_state(ptr, len i32) - saves new state of the object;
+//	set_result(ptr, len i32) - saves serialized results of the call;
+//	abort(ptr, len i32) - stops execution with the error message;
+//	upcall(name, nameLen, req, reqLen i32) i32 - calls the platform with CBOR serialized
+//	  request of `rpctypes` package, name is one of RouteCall, SaveAsChild, GetObjChildrenIterator,
+//	  SaveAsDelegate, GetDelegate, DeactivateObject or EmitEvent, returns length of serialized response;
+//	upcall_result(ptr i32) - copies serialized response of the last upcall into memory.
+package wasm
+
+import (
+	"context"
+	"sync"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+// Upcalls is a set of platform calls available to contracts, the same as goplugin's RPC provides.
+type Upcalls interface {
+	RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) error
+	SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveAsChildResp) error
+	GetObjChildrenIterator(req rpctypes.UpGetObjChildrenIteratorReq, rep *rpctypes.UpGetObjChildrenIteratorResp) error
+	SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.UpSaveAsDelegateResp) error
+	GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDelegateResp) error
+	DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctypes.UpDeactivateObjectResp) error
+	EmitEvent(req rpctypes.UpEmitEventReq, rep *rpctypes.UpEmitEventResp) error
+}
+
+// WASM is an executor of WebAssembly contracts
+type WASM struct {
+	Cfg     configuration.WASM
+	AM      artifacts.Client
+	Upcalls Upcalls
+
+	codeLock sync.RWMutex
+	codes    map[insolar.Reference][]byte
+}
+
+// NewWASM is an constructor
+func NewWASM(cfg configuration.WASM, am artifacts.Client, upcalls Upcalls) *WASM {
+	return &WASM{
+		Cfg:     cfg,
+		AM:      am,
+		Upcalls: upcalls,
+		codes:   make(map[insolar.Reference][]byte),
+	}
+}
+
+// Stop stops executor, there is nothing to stop
+func (w *WASM) Stop() error {
+	return nil
+}
+
+// CallMethod runs a method on contract
+func (w *WASM) CallMethod(
+	ctx context.Context, callContext *insolar.LogicCallContext,
+	codeRef insolar.Reference, data []byte,
+	method string, args insolar.Arguments,
+) (
+	[]byte, insolar.Arguments, error,
+) {
+	ctx, span := instracer.StartSpan(ctx, "wasm.CallMethod")
+	defer span.End()
+
+	code, err := w.code(ctx, codeRef)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &call{ctx: ctx, callContext: callContext, upcalls: w.Upcalls}
+	err = c.run(code, w.Cfg, method, data, args)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "couldn't call method %q", method)
+	}
+	return c.state, c.result, nil
+}
+
+// CallConstructor runs a constructor of contract
+func (w *WASM) CallConstructor(
+	ctx context.Context, callContext *insolar.LogicCallContext,
+	codeRef insolar.Reference, name string, args insolar.Arguments,
+) (
+	[]byte, error,
+) {
+	ctx, span := instracer.StartSpan(ctx, "wasm.CallConstructor")
+	defer span.End()
+
+	code, err := w.code(ctx, codeRef)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &call{ctx: ctx, callContext: callContext, upcalls: w.Upcalls}
+	err = c.run(code, w.Cfg, name, nil, args)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't call constructor %q", name)
+	}
+	if c.state == nil {
+		return nil, errors.Errorf("constructor %q didn't set state of the object", name)
+	}
+	return c.state, nil
+}
+
+// code returns code of the contract, it's cached as code records are immutable
+func (w *WASM) code(ctx context.Context, ref insolar.Reference) ([]byte, error) {
+	w.codeLock.RLock()
+	code, ok := w.codes[ref]
+	w.codeLock.RUnlock()
+	if ok {
+		return code, nil
+	}
+
+	desc, err := w.AM.GetCode(ctx, ref)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get code")
+	}
+	if desc.MachineType() != insolar.MachineTypeWASM {
+		return nil, errors.Errorf("code %s isn't WebAssembly module", ref.String())
+	}
+	code, err = desc.Code()
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get code")
+	}
+
+	w.codeLock.Lock()
+	w.codes[ref] = code
+	w.codeLock.Unlock()
+	return code, nil
+}
+
+// call is a state of one call of contract, module is instantiated for every call
+type call struct {
+	ctx         context.Context
+	callContext *insolar.LogicCallContext
+	upcalls     Upcalls
+
+	state      []byte
+	result     []byte
+	response   []byte
+	abort      error
+	resolveErr error
+}
+
+func (c *call) run(code []byte, cfg configuration.WASM, entry string, data []byte, args []byte) error {
+	// executed instructions are steps of the call budget
+	gasLimit := cfg.GasLimit
+	if steps := c.callContext.Budget.Steps; steps > 0 && (gasLimit == 0 || steps < gasLimit) {
+		gasLimit = steps
+	}
+
+	vm, err := exec.NewVirtualMachine(
+		code,
+		exec.VMConfig{
+			MaxMemoryPages:       cfg.MaxMemoryPages,
+			MaxCallStackDepth:    cfg.MaxCallStackDepth,
+			GasLimit:             gasLimit,
+			DisableFloatingPoint: true,
+		},
+		c,
+		&compiler.SimpleGasPolicy{GasPerInstruction: 1},
+	)
+	if c.resolveErr != nil {
+		return errors.Wrap(c.resolveErr, "couldn't resolve imports of module")
+	}
+	if err != nil {
+		return errors.Wrap(err, "couldn't instantiate module")
+	}
+	defer func() {
+		c.callContext.Consume(insolar.CallConsumption{Steps: vm.Gas})
+	}()
+
+	entryID, ok := vm.GetFunctionExport(entry)
+	if !ok {
+		return errors.Errorf("no function %q exported by the module", entry)
+	}
+
+	var params []int64
+	if data != nil {
+		ptr, err := c.write(vm, data)
+		if err != nil {
+			return errors.Wrap(budgetError(vm, gasLimit, err), "couldn't pass state of the object")
+		}
+		params = append(params, ptr, int64(len(data)))
+	}
+	ptr, err := c.write(vm, args)
+	if err != nil {
+		return errors.Wrap(budgetError(vm, gasLimit, err), "couldn't pass arguments")
+	}
+	params = append(params, ptr, int64(len(args)))
+
+	_, err = vm.Run(entryID, params...)
+	if c.abort != nil {
+		return c.abort
+	}
+	if err != nil {
+		return errors.Wrap(budgetError(vm, gasLimit, err), "execution failed")
+	}
+	return nil
+}
+
+// budgetError returns ErrBudgetExceeded if execution failed as the module has run out of gas
+func budgetError(vm *exec.VirtualMachine, gasLimit uint64, err error) error {
+	if gasLimit > 0 && vm.Gas >= gasLimit {
+		return errors.Wrapf(insolar.ErrBudgetExceeded, "module executed more than %d instructions", gasLimit)
+	}
+	return err
+}
+
+// write allocates buffer in the module and copies data into it
+func (c *call) write(vm *exec.VirtualMachine, data []byte) (int64, error) {
+	allocID, ok := vm.GetFunctionExport("alloc")
+	if !ok {
+		return 0, errors.New("no function \"alloc\" exported by the module")
+	}
+	ptr, err := vm.Run(allocID, int64(len(data)))
+	if err != nil {
+		return 0, errors.Wrap(err, "allocation failed")
+	}
+	ptr = int64(uint32(ptr))
+	if ptr+int64(len(data)) > int64(len(vm.Memory)) {
+		return 0, errors.New("allocated buffer is out of memory bounds")
+	}
+	copy(vm.Memory[ptr:], data)
+	return ptr, nil
+}
+
+// read copies buffer of the module, panic stops execution of the module
+func (c *call) read(vm *exec.VirtualMachine, ptr int64, size int64) []byte {
+	ptr, size = int64(uint32(ptr)), int64(uint32(size))
+	if ptr+size > int64(len(vm.Memory)) {
+		panic(errors.New("memory access out of bounds"))
+	}
+	res := make([]byte, size)
+	copy(res, vm.Memory[ptr:ptr+size])
+	return res
+}
+
+// ResolveFunc implements exec.ImportResolver, it resolves functions of `insolar` module. Errors are saved to
+// the call and returned before the module is run.
+func (c *call) ResolveFunc(module, field string) exec.FunctionImport {
+	if module != "insolar" {
+		return c.unresolved(errors.Errorf("unknown module %q", module))
+	}
+
+	switch field {
+	case "set_state":
+		return func(vm *exec.VirtualMachine) int64 {
+			locals := vm.GetCurrentFrame().Locals
+			c.state = c.read(vm, locals[0], locals[1])
+			return 0
+		}
+	case "set_result":
+		return func(vm *exec.VirtualMachine) int64 {
+			locals := vm.GetCurrentFrame().Locals
+			c.result = c.read(vm, locals[0], locals[1])
+			return 0
+		}
+	case "abort":
+		return func(vm *exec.VirtualMachine) int64 {
+			locals := vm.GetCurrentFrame().Locals
+			c.abort = errors.New(string(c.read(vm, locals[0], locals[1])))
+			panic(c.abort)
+		}
+	case "upcall":
+		return func(vm *exec.VirtualMachine) int64 {
+			locals := vm.GetCurrentFrame().Locals
+			name := string(c.read(vm, locals[0], locals[1]))
+			req := c.read(vm, locals[2], locals[3])
+			if err := c.ctx.Err(); err != nil {
+				c.abort = errors.Wrap(err, "call is cancelled")
+				panic(c.abort)
+			}
+			res, err := c.upcall(name, req)
+			if err != nil {
+				c.abort = errors.Wrapf(err, "upcall %s failed", name)
+				panic(c.abort)
+			}
+			c.response = res
+			return int64(len(res))
+		}
+	case "upcall_result":
+		return func(vm *exec.VirtualMachine) int64 {
+			ptr := int64(uint32(vm.GetCurrentFrame().Locals[0]))
+			if ptr+int64(len(c.response)) > int64(len(vm.Memory)) {
+				panic(errors.New("memory access out of bounds"))
+			}
+			copy(vm.Memory[ptr:], c.response)
+			return 0
+		}
+	default:
+		return c.unresolved(errors.Errorf("unknown function %q of module %q", field, module))
+	}
+}
+
+// ResolveGlobal implements exec.ImportResolver, no globals are provided
+func (c *call) ResolveGlobal(module, field string) int64 {
+	if c.resolveErr == nil {
+		c.resolveErr = errors.Errorf("unknown global %q of module %q", field, module)
+	}
+	return 0
+}
+
+// unresolved saves the first error of import resolution and returns function, that is never called
+func (c *call) unresolved(err error) exec.FunctionImport {
+	if c.resolveErr == nil {
+		c.resolveErr = err
+	}
+	return func(vm *exec.VirtualMachine) int64 {
+		panic(err)
+	}
+}
+
+// upcall decodes request, calls the platform and encodes response
+func (c *call) upcall(name string, data []byte) ([]byte, error) {
+	base := c.baseReq()
+	switch name {
+	case "RouteCall":
+		req, res := rpctypes.UpRouteReq{}, rpctypes.UpRouteResp{}
+		return roundTrip(data, &req, &res, func() error {
+			req.UpBaseReq = base
+			return c.upcalls.RouteCall(req, &res)
+		})
+	case "SaveAsChild":
+		req, res := rpctypes.UpSaveAsChildReq{}, rpctypes.UpSaveAsChildResp{}
+		return roundTrip(data, &req, &res, func() error {
+			req.UpBaseReq = base
+			return c.upcalls.SaveAsChild(req, &res)
+		})
+	case "GetObjChildrenIterator":
+		req, res := rpctypes.UpGetObjChildrenIteratorReq{}, rpctypes.UpGetObjChildrenIteratorResp{}
+		return roundTrip(data, &req, &res, func() error {
+			req.UpBaseReq = base
+			return c.upcalls.GetObjChildrenIterator(req, &res)
+		})
+	case "SaveAsDelegate":
+		req, res := rpctypes.UpSaveAsDelegateReq{}, rpctypes.UpSaveAsDelegateResp{}
+		return roundTrip(data, &req, &res, func() error {
+			req.UpBaseReq = base
+			return c.upcalls.SaveAsDelegate(req, &res)
+		})
+	case "GetDelegate":
+		req, res := rpctypes.UpGetDelegateReq{}, rpctypes.UpGetDelegateResp{}
+		return roundTrip(data, &req, &res, func() error {
+			req.UpBaseReq = base
+			return c.upcalls.GetDelegate(req, &res)
+		})
+	case "DeactivateObject":
+		req, res := rpctypes.UpDeactivateObjectReq{}, rpctypes.UpDeactivateObjectResp{}
+		return roundTrip(data, &req, &res, func() error {
+			req.UpBaseReq = base
+			return c.upcalls.DeactivateObject(req, &res)
+		})
+	case "EmitEvent":
+		req, res := rpctypes.UpEmitEventReq{}, rpctypes.UpEmitEventResp{}
+		return roundTrip(data, &req, &res, func() error {
+			req.UpBaseReq = base
+			return c.upcalls.EmitEvent(req, &res)
+		})
+	default:
+		return nil, errors.Errorf("unknown upcall %q", name)
+	}
+}
+
+// baseReq makes base of upcall request from context of the call, it's never taken from the module
+func (c *call) baseReq() rpctypes.UpBaseReq {
+	res := rpctypes.UpBaseReq{Mode: c.callContext.Mode}
+	if c.callContext.Callee != nil {
+		res.Callee = *c.callContext.Callee
+	}
+	if c.callContext.Prototype != nil {
+		res.CalleePrototype = *c.callContext.Prototype
+	}
+	if c.callContext.Request != nil {
+		res.Request = *c.callContext.Request
+	}
+	return res
+}
+
+func roundTrip(data []byte, req interface{}, res interface{}, f func() error) ([]byte, error) {
+	err := insolar.Deserialize(data, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't decode request")
+	}
+	err = f()
+	if err != nil {
+		return nil, err
+	}
+	return insolar.Serialize(res)
+}
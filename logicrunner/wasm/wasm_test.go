@@ -0,0 +1,167 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/testutils"
+)
+
+// echoModule exports method "Echo" saving state and arguments as they are passed to it
+var echoModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+	// types: (i32, i32) -> (), (i32) -> i32, (i32, i32, i32, i32) -> ()
+	0x01, 0x12, 0x03,
+	0x60, 0x02, 0x7f, 0x7f, 0x00,
+	0x60, 0x01, 0x7f, 0x01, 0x7f,
+	0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x00,
+	// imports: insolar.set_state, insolar.set_result
+	0x02, 0x2a, 0x02,
+	0x07, 'i', 'n', 's', 'o', 'l', 'a', 'r', 0x09, 's', 'e', 't', '_', 's', 't', 'a', 't', 'e', 0x00, 0x00,
+	0x07, 'i', 'n', 's', 'o', 'l', 'a', 'r', 0x0a, 's', 'e', 't', '_', 'r', 'e', 's', 'u', 'l', 't', 0x00, 0x00,
+	// functions: alloc, Echo
+	0x03, 0x03, 0x02, 0x01, 0x02,
+	// memory of one page
+	0x05, 0x03, 0x01, 0x00, 0x01,
+	// global pointer of allocator starting from 1024
+	0x06, 0x07, 0x01, 0x7f, 0x01, 0x41, 0x80, 0x08, 0x0b,
+	// exports: memory, alloc, Echo
+	0x07, 0x19, 0x03,
+	0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00,
+	0x05, 'a', 'l', 'l', 'o', 'c', 0x00, 0x02,
+	0x04, 'E', 'c', 'h', 'o', 0x00, 0x03,
+	// code
+	0x0a, 0x1c, 0x02,
+	// alloc: res = ptr; ptr += size; return res
+	0x0b, 0x00, 0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00, 0x0b,
+	// Echo: set_state(state, stateLen); set_result(args, argsLen)
+	0x0e, 0x00, 0x20, 0x00, 0x20, 0x01, 0x10, 0x00, 0x20, 0x02, 0x20, 0x03, 0x10, 0x01, 0x0b,
+}
+
+// loopModule exports method "Loop" that never returns
+var loopModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+	// types: (i32, i32) -> (), (i32) -> i32, (i32, i32, i32, i32) -> ()
+	0x01, 0x12, 0x03,
+	0x60, 0x02, 0x7f, 0x7f, 0x00,
+	0x60, 0x01, 0x7f, 0x01, 0x7f,
+	0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x00,
+	// functions: alloc, Loop
+	0x03, 0x03, 0x02, 0x01, 0x02,
+	// memory of one page
+	0x05, 0x03, 0x01, 0x00, 0x01,
+	// global pointer of allocator starting from 1024
+	0x06, 0x07, 0x01, 0x7f, 0x01, 0x41, 0x80, 0x08, 0x0b,
+	// exports: memory, alloc, Loop
+	0x07, 0x19, 0x03,
+	0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00,
+	0x05, 'a', 'l', 'l', 'o', 'c', 0x00, 0x00,
+	0x04, 'L', 'o', 'o', 'p', 0x00, 0x01,
+	// code
+	0x0a, 0x15, 0x02,
+	// alloc: res = ptr; ptr += size; return res
+	0x0b, 0x00, 0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00, 0x0b,
+	// Loop: loop br 0 end
+	0x07, 0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x0b,
+}
+
+func newTestWASM(mc *minimock.Controller, desc artifacts.CodeDescriptor) (*WASM, insolar.Reference) {
+	codeRef := testutils.RandomRef()
+	am := artifacts.NewClientMock(mc)
+	am.GetCodeMock.Expect(context.Background(), codeRef).Return(desc, nil)
+
+	cfg := configuration.WASM{MaxMemoryPages: 16, MaxCallStackDepth: 64, GasLimit: 1000}
+	return NewWASM(cfg, am, nil), codeRef
+}
+
+func TestWASM_CallMethod(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.MachineTypeMock.Return(insolar.MachineTypeWASM)
+	desc.CodeMock.Return(echoModule, nil)
+	w, codeRef := newTestWASM(mc, desc)
+
+	ctx := context.Background()
+	callContext := &insolar.LogicCallContext{Mode: "execution"}
+	for i := 0; i < 2; i++ {
+		state, result, err := w.CallMethod(ctx, callContext, codeRef, []byte("state"), "Echo", []byte("arguments"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("state"), state)
+		require.Equal(t, insolar.Arguments("arguments"), result)
+	}
+	require.Equal(t, uint64(1), w.AM.(*artifacts.ClientMock).GetCodeCounter)
+
+	_, _, err := w.CallMethod(ctx, callContext, codeRef, []byte("state"), "Unknown", []byte("arguments"))
+	require.Error(t, err)
+}
+
+func TestWASM_CallMethod_NotWASM(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.MachineTypeMock.Return(insolar.MachineTypeGoPlugin)
+	w, codeRef := newTestWASM(mc, desc)
+
+	_, _, err := w.CallMethod(context.Background(), &insolar.LogicCallContext{}, codeRef, nil, "Echo", nil)
+	require.Error(t, err)
+}
+
+func TestWASM_CallMethod_UnknownImport(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	code := bytes.Replace(echoModule, []byte("set_state"), []byte("get_state"), 1)
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.MachineTypeMock.Return(insolar.MachineTypeWASM)
+	desc.CodeMock.Return(code, nil)
+	w, codeRef := newTestWASM(mc, desc)
+
+	require.NotPanics(t, func() {
+		_, _, err := w.CallMethod(context.Background(), &insolar.LogicCallContext{}, codeRef, nil, "Echo", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get_state")
+	})
+}
+
+func TestWASM_CallMethod_GasExhausted(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	desc := artifacts.NewCodeDescriptorMock(mc)
+	desc.MachineTypeMock.Return(insolar.MachineTypeWASM)
+	desc.CodeMock.Return(loopModule, nil)
+	w, codeRef := newTestWASM(mc, desc)
+
+	callContext := &insolar.LogicCallContext{Budget: insolar.CallBudget{Steps: 500}}
+	_, _, err := w.CallMethod(context.Background(), callContext, codeRef, nil, "Loop", nil)
+	require.Error(t, err)
+	require.Equal(t, insolar.ErrBudgetExceeded, errors.Cause(err))
+	require.True(t, callContext.Consumption().Steps >= 500)
+}
@@ -47,9 +47,11 @@ import (
 	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/ledger/blob"
-	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/backup"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/insolar/insolar/ledger/heavy/handler"
 	"github.com/insolar/insolar/ledger/heavy/pulsemanager"
+	"github.com/insolar/insolar/ledger/heavy/retention"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 	"github.com/insolar/insolar/messagebus"
@@ -140,7 +142,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 	var (
 		Requester insolar.ContractRequester
 		Genesis   insolar.GenesisDataProvider
-		API       insolar.APIRunner
+		API       *api.Runner
 	)
 	{
 		var err error
@@ -170,7 +172,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 	)
 	{
 		var err error
-		DB, err = store.NewBadgerDB(cfg.Ledger.Storage.DataDirectory)
+		DB, err = store.NewBadgerDB(cfg.Ledger.Storage)
 		if err != nil {
 			panic(errors.Wrap(err, "failed to initialize DB"))
 		}
@@ -227,7 +229,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		records := object.NewRecordDB(DB)
 		indexes := object.NewIndexDB(DB)
 		blobs := blob.NewDB(DB)
-		drops := drop.NewDB(DB)
+		catalog := exporter.NewCatalogDB(DB)
 
 		pm := pulsemanager.NewPulseManager()
 		pm.Bus = Bus
@@ -235,19 +237,33 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		pm.NodeSetter = Nodes
 		pm.Nodes = Nodes
 		pm.PulseAppender = pulses
+		if cfg.Ledger.Retention.Depth > 0 {
+			pm.Pruner = retention.NewPruner(cfg.Ledger.Retention, DB, pulses)
+		}
 
 		h := handler.New()
 		h.RecordAccessor = records
-		h.RecordModifier = records
 		h.JetCoordinator = Coordinator
 		h.IndexLifelineAccessor = indexes
-		h.IndexBucketModifier = indexes
+		h.RequestIndexAccessor = object.NewRequestIndexDB(DB)
+		h.RetentionHorizon = retention.NewHorizonDB(DB)
 		h.Bus = Bus
 		h.BlobAccessor = blobs
-		h.BlobModifier = blobs
-		h.DropModifier = drops
+		h.DB = DB
 		h.PCS = CryptoScheme
 
+		API.Exporter = exporter.NewPulseExporter(
+			cfg.Ledger.Exporter,
+			pulses,
+			pulses,
+			catalog,
+			records,
+			blobs,
+			indexes,
+		)
+		API.Backup = backup.NewSnapshotMaker(cfg.Ledger.Backup, DB)
+		API.TypeIndex = object.NewTypeIndexDB(DB)
+
 		PulseManager = pm
 		Handler = h
 
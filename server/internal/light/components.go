@@ -39,6 +39,7 @@ import (
 	"github.com/insolar/insolar/insolar/node"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
@@ -163,11 +164,17 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		Pulses      *pulse.StorageMem
 		Jets        jet.Storage
 		Nodes       *node.Storage
+		DB          *store.BadgerDB
 	)
 	{
+		var err error
+		DB, err = store.NewBadgerDB(cfg.Ledger.Storage)
+		if err != nil {
+			panic(errors.Wrap(err, "failed to initialize DB"))
+		}
 		Nodes = node.NewStorage()
 		Pulses = pulse.NewStorageMem()
-		Jets = jet.NewStore()
+		Jets = jet.NewDBStore(DB)
 
 		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
 		c.PulseCalculator = Pulses
@@ -242,6 +249,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		handler.IDLocker = idLocker
 		handler.RecordModifier = records
 		handler.RecordAccessor = records
+		handler.RequestIndex = records
 		handler.Nodes = Nodes
 		handler.HotDataWaiter = waiter
 		handler.JetReleaser = waiter
@@ -258,7 +266,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			Pulses,
 			conf.LightChainLimit,
 		)
-		dataGatherer := replication.NewDataGatherer(drops, blobs, records, indexes)
+		dataGatherer := replication.NewDataGatherer(drops, blobs, records, indexes, records)
 		lthSyncer := replication.NewReplicatorDefault(
 			jetCalculator,
 			dataGatherer,
@@ -301,7 +309,9 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		Handler = handler
 	}
 
+	// DB goes first to be stopped after all components, that use it.
 	c.cmp.Inject(
+		DB,
 		WmBus,
 		Handler,
 		Jets,
@@ -42,6 +42,8 @@ import (
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/logicrunner"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/calltrace"
+	"github.com/insolar/insolar/logicrunner/goplugin"
 	"github.com/insolar/insolar/logicrunner/pulsemanager"
 	"github.com/insolar/insolar/messagebus"
 	"github.com/insolar/insolar/metrics"
@@ -148,6 +150,16 @@ func initComponents(
 
 	apiRunner, err := api.NewRunner(&cfg.APIRunner)
 	checkError(ctx, err, "failed to start ApiRunner")
+	callTrees := calltrace.NewCollector(logicRunner.CallTracer)
+	apiRunner.CallTrees = callTrees
+
+	if cfg.LogicRunner.GoPlugin != nil && cfg.LogicRunner.GoPlugin.Workers != nil {
+		workers, err := goplugin.NewPool(cfg.LogicRunner)
+		checkError(ctx, err, "failed to start contract workers pool")
+		logicRunner.Workers = workers
+		apiRunner.ContractWorkers = workers
+		cm.Register(workers)
+	}
 
 	metricsHandler, err := metrics.NewMetrics(ctx, cfg.Metrics, metrics.GetInsolarRegistry("virtual"), "virtual")
 	checkError(ctx, err, "failed to start Metrics")
@@ -192,6 +204,7 @@ func initComponents(
 	components = append(components, []interface{}{
 		genesisDataProvider,
 		apiRunner,
+		callTrees,
 		metricsHandler,
 		cryptographyService,
 		keyProcessor,
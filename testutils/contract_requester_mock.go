@@ -35,6 +35,16 @@ type ContractRequesterMock struct {
 	CallMethodPreCounter uint64
 	CallMethodMock       mContractRequesterMockCallMethod
 
+	DryRunFunc       func(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}) (r insolar.Reply, r1 error)
+	DryRunCounter    uint64
+	DryRunPreCounter uint64
+	DryRunMock       mContractRequesterMockDryRun
+
+	DryRunMethodFunc       func(p context.Context, p1 insolar.Message) (r insolar.Reply, r1 error)
+	DryRunMethodCounter    uint64
+	DryRunMethodPreCounter uint64
+	DryRunMethodMock       mContractRequesterMockDryRunMethod
+
 	SendRequestFunc       func(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}) (r insolar.Reply, r1 error)
 	SendRequestCounter    uint64
 	SendRequestPreCounter uint64
@@ -52,6 +62,8 @@ func NewContractRequesterMock(t minimock.Tester) *ContractRequesterMock {
 	m.CallMock = mContractRequesterMockCall{mock: m}
 	m.CallConstructorMock = mContractRequesterMockCallConstructor{mock: m}
 	m.CallMethodMock = mContractRequesterMockCallMethod{mock: m}
+	m.DryRunMock = mContractRequesterMockDryRun{mock: m}
+	m.DryRunMethodMock = mContractRequesterMockDryRunMethod{mock: m}
 	m.SendRequestMock = mContractRequesterMockSendRequest{mock: m}
 
 	return m
@@ -510,6 +522,310 @@ func (m *ContractRequesterMock) CallMethodFinished() bool {
 	return true
 }
 
+type mContractRequesterMockDryRun struct {
+	mock              *ContractRequesterMock
+	mainExpectation   *ContractRequesterMockDryRunExpectation
+	expectationSeries []*ContractRequesterMockDryRunExpectation
+}
+
+type ContractRequesterMockDryRunExpectation struct {
+	input  *ContractRequesterMockDryRunInput
+	result *ContractRequesterMockDryRunResult
+}
+
+type ContractRequesterMockDryRunInput struct {
+	p  context.Context
+	p1 *insolar.Reference
+	p2 string
+	p3 []interface{}
+}
+
+type ContractRequesterMockDryRunResult struct {
+	r  insolar.Reply
+	r1 error
+}
+
+//Expect specifies that invocation of ContractRequester.DryRun is expected from 1 to Infinity times
+func (m *mContractRequesterMockDryRun) Expect(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}) *mContractRequesterMockDryRun {
+	m.mock.DryRunFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockDryRunExpectation{}
+	}
+	m.mainExpectation.input = &ContractRequesterMockDryRunInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of ContractRequester.DryRun
+func (m *mContractRequesterMockDryRun) Return(r insolar.Reply, r1 error) *ContractRequesterMock {
+	m.mock.DryRunFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockDryRunExpectation{}
+	}
+	m.mainExpectation.result = &ContractRequesterMockDryRunResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ContractRequester.DryRun is expected once
+func (m *mContractRequesterMockDryRun) ExpectOnce(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}) *ContractRequesterMockDryRunExpectation {
+	m.mock.DryRunFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ContractRequesterMockDryRunExpectation{}
+	expectation.input = &ContractRequesterMockDryRunInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ContractRequesterMockDryRunExpectation) Return(r insolar.Reply, r1 error) {
+	e.result = &ContractRequesterMockDryRunResult{r, r1}
+}
+
+//Set uses given function f as a mock of ContractRequester.DryRun method
+func (m *mContractRequesterMockDryRun) Set(f func(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}) (r insolar.Reply, r1 error)) *ContractRequesterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.DryRunFunc = f
+	return m.mock
+}
+
+//DryRun implements github.com/insolar/insolar/insolar.ContractRequester interface
+func (m *ContractRequesterMock) DryRun(p context.Context, p1 *insolar.Reference, p2 string, p3 []interface{}) (r insolar.Reply, r1 error) {
+	counter := atomic.AddUint64(&m.DryRunPreCounter, 1)
+	defer atomic.AddUint64(&m.DryRunCounter, 1)
+
+	if len(m.DryRunMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.DryRunMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ContractRequesterMock.DryRun. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.DryRunMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ContractRequesterMockDryRunInput{p, p1, p2, p3}, "ContractRequester.DryRun got unexpected parameters")
+
+		result := m.DryRunMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.DryRun")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.DryRunMock.mainExpectation != nil {
+
+		input := m.DryRunMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ContractRequesterMockDryRunInput{p, p1, p2, p3}, "ContractRequester.DryRun got unexpected parameters")
+		}
+
+		result := m.DryRunMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.DryRun")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.DryRunFunc == nil {
+		m.t.Fatalf("Unexpected call to ContractRequesterMock.DryRun. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.DryRunFunc(p, p1, p2, p3)
+}
+
+//DryRunMinimockCounter returns a count of ContractRequesterMock.DryRunFunc invocations
+func (m *ContractRequesterMock) DryRunMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.DryRunCounter)
+}
+
+//DryRunMinimockPreCounter returns the value of ContractRequesterMock.DryRun invocations
+func (m *ContractRequesterMock) DryRunMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.DryRunPreCounter)
+}
+
+//DryRunFinished returns true if mock invocations count is ok
+func (m *ContractRequesterMock) DryRunFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.DryRunMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.DryRunCounter) == uint64(len(m.DryRunMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.DryRunMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.DryRunCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.DryRunFunc != nil {
+		return atomic.LoadUint64(&m.DryRunCounter) > 0
+	}
+
+	return true
+}
+
+type mContractRequesterMockDryRunMethod struct {
+	mock              *ContractRequesterMock
+	mainExpectation   *ContractRequesterMockDryRunMethodExpectation
+	expectationSeries []*ContractRequesterMockDryRunMethodExpectation
+}
+
+type ContractRequesterMockDryRunMethodExpectation struct {
+	input  *ContractRequesterMockDryRunMethodInput
+	result *ContractRequesterMockDryRunMethodResult
+}
+
+type ContractRequesterMockDryRunMethodInput struct {
+	p  context.Context
+	p1 insolar.Message
+}
+
+type ContractRequesterMockDryRunMethodResult struct {
+	r  insolar.Reply
+	r1 error
+}
+
+//Expect specifies that invocation of ContractRequester.DryRunMethod is expected from 1 to Infinity times
+func (m *mContractRequesterMockDryRunMethod) Expect(p context.Context, p1 insolar.Message) *mContractRequesterMockDryRunMethod {
+	m.mock.DryRunMethodFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockDryRunMethodExpectation{}
+	}
+	m.mainExpectation.input = &ContractRequesterMockDryRunMethodInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ContractRequester.DryRunMethod
+func (m *mContractRequesterMockDryRunMethod) Return(r insolar.Reply, r1 error) *ContractRequesterMock {
+	m.mock.DryRunMethodFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockDryRunMethodExpectation{}
+	}
+	m.mainExpectation.result = &ContractRequesterMockDryRunMethodResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ContractRequester.DryRunMethod is expected once
+func (m *mContractRequesterMockDryRunMethod) ExpectOnce(p context.Context, p1 insolar.Message) *ContractRequesterMockDryRunMethodExpectation {
+	m.mock.DryRunMethodFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ContractRequesterMockDryRunMethodExpectation{}
+	expectation.input = &ContractRequesterMockDryRunMethodInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ContractRequesterMockDryRunMethodExpectation) Return(r insolar.Reply, r1 error) {
+	e.result = &ContractRequesterMockDryRunMethodResult{r, r1}
+}
+
+//Set uses given function f as a mock of ContractRequester.DryRunMethod method
+func (m *mContractRequesterMockDryRunMethod) Set(f func(p context.Context, p1 insolar.Message) (r insolar.Reply, r1 error)) *ContractRequesterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.DryRunMethodFunc = f
+	return m.mock
+}
+
+//DryRunMethod implements github.com/insolar/insolar/insolar.ContractRequester interface
+func (m *ContractRequesterMock) DryRunMethod(p context.Context, p1 insolar.Message) (r insolar.Reply, r1 error) {
+	counter := atomic.AddUint64(&m.DryRunMethodPreCounter, 1)
+	defer atomic.AddUint64(&m.DryRunMethodCounter, 1)
+
+	if len(m.DryRunMethodMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.DryRunMethodMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ContractRequesterMock.DryRunMethod. %v %v", p, p1)
+			return
+		}
+
+		input := m.DryRunMethodMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ContractRequesterMockDryRunMethodInput{p, p1}, "ContractRequester.DryRunMethod got unexpected parameters")
+
+		result := m.DryRunMethodMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.DryRunMethod")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.DryRunMethodMock.mainExpectation != nil {
+
+		input := m.DryRunMethodMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ContractRequesterMockDryRunMethodInput{p, p1}, "ContractRequester.DryRunMethod got unexpected parameters")
+		}
+
+		result := m.DryRunMethodMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.DryRunMethod")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.DryRunMethodFunc == nil {
+		m.t.Fatalf("Unexpected call to ContractRequesterMock.DryRunMethod. %v %v", p, p1)
+		return
+	}
+
+	return m.DryRunMethodFunc(p, p1)
+}
+
+//DryRunMethodMinimockCounter returns a count of ContractRequesterMock.DryRunMethodFunc invocations
+func (m *ContractRequesterMock) DryRunMethodMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.DryRunMethodCounter)
+}
+
+//DryRunMethodMinimockPreCounter returns the value of ContractRequesterMock.DryRunMethod invocations
+func (m *ContractRequesterMock) DryRunMethodMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.DryRunMethodPreCounter)
+}
+
+//DryRunMethodFinished returns true if mock invocations count is ok
+func (m *ContractRequesterMock) DryRunMethodFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.DryRunMethodMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.DryRunMethodCounter) == uint64(len(m.DryRunMethodMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.DryRunMethodMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.DryRunMethodCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.DryRunMethodFunc != nil {
+		return atomic.LoadUint64(&m.DryRunMethodCounter) > 0
+	}
+
+	return true
+}
+
 type mContractRequesterMockSendRequest struct {
 	mock              *ContractRequesterMock
 	mainExpectation   *ContractRequesterMockSendRequestExpectation
@@ -679,6 +995,14 @@ func (m *ContractRequesterMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallMethod")
 	}
 
+	if !m.DryRunFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.DryRun")
+	}
+
+	if !m.DryRunMethodFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.DryRunMethod")
+	}
+
 	if !m.SendRequestFinished() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequest")
 	}
@@ -712,6 +1036,14 @@ func (m *ContractRequesterMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ContractRequesterMock.CallMethod")
 	}
 
+	if !m.DryRunFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.DryRun")
+	}
+
+	if !m.DryRunMethodFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.DryRunMethod")
+	}
+
 	if !m.SendRequestFinished() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequest")
 	}
@@ -733,6 +1065,8 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.CallFinished()
 		ok = ok && m.CallConstructorFinished()
 		ok = ok && m.CallMethodFinished()
+		ok = ok && m.DryRunFinished()
+		ok = ok && m.DryRunMethodFinished()
 		ok = ok && m.SendRequestFinished()
 
 		if ok {
@@ -754,6 +1088,14 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ContractRequesterMock.CallMethod")
 			}
 
+			if !m.DryRunFinished() {
+				m.t.Error("Expected call to ContractRequesterMock.DryRun")
+			}
+
+			if !m.DryRunMethodFinished() {
+				m.t.Error("Expected call to ContractRequesterMock.DryRunMethod")
+			}
+
 			if !m.SendRequestFinished() {
 				m.t.Error("Expected call to ContractRequesterMock.SendRequest")
 			}
@@ -782,6 +1124,14 @@ func (m *ContractRequesterMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.DryRunFinished() {
+		return false
+	}
+
+	if !m.DryRunMethodFinished() {
+		return false
+	}
+
 	if !m.SendRequestFinished() {
 		return false
 	}